@@ -0,0 +1,4 @@
+data "genesyscloud_architect_schedulegroup_status" "christmas_eve" {
+  schedule_group_id = genesyscloud_architect_schedulegroups.sample_schedule_groups.id
+  instants          = ["2023-12-24T17:30:00", "2023-12-24T06:30:00Z"]
+}
@@ -0,0 +1,9 @@
+data "genesyscloud_auth_role_analysis" "supervisor_vs_agent" {
+  role_id         = genesyscloud_auth_role.supervisor.id
+  compare_role_id = genesyscloud_auth_role.agent.id
+}
+
+# Compare a modified default role with its default permissions
+data "genesyscloud_auth_role_analysis" "employee_changes" {
+  role_id = genesyscloud_auth_role.employee.id
+}
@@ -0,0 +1,48 @@
+data "genesyscloud_recording_media_retention_policy_simulation" "sales-call" {
+  policy {
+    name  = "Retain sales calls"
+    order = 1
+    media_policies {
+      call_policy {
+        conditions {
+          for_queue_ids = [genesyscloud_routing_queue.sales-queue.id]
+          directions    = ["INBOUND"]
+          time_allowed {
+            time_zone_id = "America/New_York"
+            time_slots {
+              start_time = "09:00:00.000"
+              stop_time  = "17:00:00.000"
+              day        = 1
+            }
+          }
+        }
+        actions {
+          retain_recording = true
+        }
+      }
+    }
+  }
+
+  policy {
+    name  = "Delete everything else"
+    order = 2
+    conditions {
+      media_types = ["CALL"]
+    }
+    actions {
+      delete_recording = true
+    }
+  }
+
+  conversation {
+    media_type       = "CALL"
+    direction        = "INBOUND"
+    queue_id         = genesyscloud_routing_queue.sales-queue.id
+    time             = "2023-05-15T14:30:00Z"
+    duration_seconds = 300
+  }
+}
+
+output "sales_call_recording_outcome" {
+  value = data.genesyscloud_recording_media_retention_policy_simulation.sales-call.recording_outcome
+}
@@ -0,0 +1,31 @@
+data "genesyscloud_routing_queue_simulation" "support-45s" {
+  queue_id     = genesyscloud_routing_queue.support.id
+  skill_ids    = [genesyscloud_routing_skill.spanish.id]
+  wait_seconds = 45
+}
+
+data "genesyscloud_routing_queue_simulation" "overflow" {
+  conditional_group_routing_rules {
+    operator        = "GreaterThan"
+    condition_value = 0
+    wait_seconds    = 10
+    groups {
+      member_group_id   = genesyscloud_routing_skill_group.tier1.id
+      member_group_type = "SKILLGROUP"
+    }
+  }
+  conditional_group_routing_rules {
+    queue_id        = genesyscloud_routing_queue.overflow.id
+    operator        = "LessThan"
+    condition_value = 60
+    groups {
+      member_group_id   = genesyscloud_group.overflow.id
+      member_group_type = "GROUP"
+    }
+  }
+  wait_seconds           = 30
+  estimated_wait_seconds = 120
+  queue_estimated_wait_seconds = {
+    (genesyscloud_routing_queue.overflow.id) = 20
+  }
+}
@@ -0,0 +1,4 @@
+data "genesyscloud_telephony_did_inventory" "inventory" {
+  did_pool_ids       = [genesyscloud_telephony_providers_edges_did_pool.main_pool.id]
+  free_numbers_limit = 10
+}
@@ -0,0 +1,21 @@
+data "genesyscloud_telephony_site_dial_plan_test" "dial_plan" {
+  site_id        = genesyscloud_telephony_providers_edges_site.site.id
+  dialed_numbers = ["3175551212", "+44 20 7123 4567", "*123"]
+}
+
+data "genesyscloud_telephony_site_dial_plan_test" "inline" {
+  number_plans {
+    name              = "Speed dial"
+    match_type        = "regex"
+    match_format      = "^\\*([0-9]{3})$"
+    normalized_format = "+1317555$1"
+    classification    = "speed"
+  }
+  outbound_routes {
+    name                    = "Speed route"
+    classification_types    = ["speed"]
+    enabled                 = true
+    external_trunk_base_ids = [genesyscloud_telephony_providers_edges_trunkbasesettings.carrier.id]
+  }
+  dialed_numbers = ["*123"]
+}
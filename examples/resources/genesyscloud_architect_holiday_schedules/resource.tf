@@ -0,0 +1,11 @@
+resource "genesyscloud_architect_holiday_schedules" "uk_holidays" {
+  schedule_group_id = genesyscloud_architect_schedulegroups.sample_schedule_groups.id
+  regions           = ["GB-ENG", "GB-SCT"]
+  start_year        = 2024
+  end_year          = 2026
+  exclusions        = ["St. Andrew's Day"]
+  additions {
+    name = "Company Day"
+    date = "2024-09-13"
+  }
+}
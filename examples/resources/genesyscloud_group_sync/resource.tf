@@ -0,0 +1,15 @@
+resource "genesyscloud_group_sync" "sales_from_export" {
+  group_id    = genesyscloud_group.sales.id
+  source_file = "${path.module}/members.csv"
+  sync_owners = true
+}
+
+resource "genesyscloud_group_sync" "support_from_okta" {
+  group_id = genesyscloud_group.support.id
+  mode     = "additive"
+  scim {
+    base_url = "https://example.okta.com/scim/v2"
+    group_id = "00g1emaKYZTWRYYRRTSK"
+    token    = var.okta_scim_token
+  }
+}
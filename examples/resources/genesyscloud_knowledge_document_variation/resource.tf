@@ -57,4 +57,14 @@ resource "genesyscloud_knowledge_document_variation" "example_document_variation
       }
     }
   }
-}
\ No newline at end of file
+}
+
+resource "genesyscloud_knowledge_document_variation" "example_document_variation_from_file" {
+  knowledge_base_id     = genesyscloud_knowledge_knowledgebase.example_knowledgebase.id
+  knowledge_document_id = genesyscloud_knowledge_document.examle_document.id
+  published             = true
+  knowledge_document_variation {
+    body_filepath          = "${path.module}/variation.md"
+    body_file_content_hash = filesha256("${path.module}/variation.md")
+  }
+}
@@ -0,0 +1,5 @@
+resource "genesyscloud_knowledge_import" "example_import" {
+  knowledge_base_id = genesyscloud_knowledge_knowledgebase.example_knowledgebase.id
+  source_directory  = "${path.module}/articles"
+  published         = true
+}
@@ -65,3 +65,11 @@ resource "genesyscloud_quality_forms_evaluation" "example-evaluation-form" {
     }
   }
 }
+
+resource "genesyscloud_quality_forms_evaluation" "example-evaluation-form-from-file" {
+  name                         = "Example Evaluation Form From File"
+  published                    = true
+  definition_filepath          = "${path.module}/evaluation_form.yaml"
+  definition_file_content_hash = filesha256("${path.module}/evaluation_form.yaml")
+  delete_unpublished_drafts    = true
+}
@@ -68,4 +68,11 @@ resource "genesyscloud_quality_forms_survey" "example-survey-form" {
       predicates          = ["/form/questionGroup/0/question/2/answer/1"]
     }
   }
-}
\ No newline at end of file
+}
+
+resource "genesyscloud_quality_forms_survey" "example-survey-form-from-file" {
+  name                         = "Example Survey Form From File"
+  language                     = "en-US"
+  definition_filepath          = "${path.module}/survey_form.json"
+  definition_file_content_hash = filesha256("${path.module}/survey_form.json")
+}
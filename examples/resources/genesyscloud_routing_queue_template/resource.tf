@@ -0,0 +1,31 @@
+resource "genesyscloud_routing_queue_template" "support" {
+  name              = "Support Queue Template"
+  acw_wrapup_prompt = "MANDATORY_TIMEOUT"
+  acw_timeout_ms    = 300000
+  media_settings_call {
+    alerting_timeout_sec      = 30
+    service_level_percentage  = 0.8
+    service_level_duration_ms = 20000
+  }
+  media_settings_chat {
+    alerting_timeout_sec      = 30
+    service_level_percentage  = 0.8
+    service_level_duration_ms = 60000
+  }
+  routing_rules {
+    operator     = "MEETS_THRESHOLD"
+    threshold    = 9
+    wait_seconds = 300
+  }
+}
+
+resource "genesyscloud_routing_queue" "support_tier_1" {
+  name        = "Support Tier 1"
+  template_id = genesyscloud_routing_queue_template.support.id
+}
+
+resource "genesyscloud_routing_queue" "support_tier_2" {
+  name           = "Support Tier 2"
+  template_id    = genesyscloud_routing_queue_template.support.id
+  acw_timeout_ms = 600000
+}
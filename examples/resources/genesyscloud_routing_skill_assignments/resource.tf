@@ -0,0 +1,7 @@
+resource "genesyscloud_routing_skill_assignments" "spanish_support" {
+  skill_id = genesyscloud_routing_skill.spanish_support.id
+  user_proficiencies = {
+    (genesyscloud_user.example_user1.id) = 4
+    (genesyscloud_user.example_user2.id) = 2.5
+  }
+}
@@ -20,4 +20,32 @@ resource "genesyscloud_routing_skill_group" "skillgroup" {
         "operation" : "And"
     }]
   )
-}
\ No newline at end of file
+}
+
+resource "genesyscloud_routing_skill_group" "spanish_sales" {
+  name            = "Spanish Sales"
+  description     = "Agents selling in Spanish"
+  preview_members = true
+  skill_condition {
+    operation = "And"
+    routing_skill_conditions {
+      skill_id    = genesyscloud_routing_skill.sales.id
+      comparator  = "GreaterThanOrEqualTo"
+      proficiency = 3
+      child_conditions {
+        language_skill_conditions {
+          language_id = genesyscloud_routing_language.spanish.id
+          comparator  = "GreaterThan"
+          proficiency = 2
+        }
+      }
+    }
+  }
+
+  lifecycle {
+    postcondition {
+      condition     = self.member_count > 0
+      error_message = "The skill conditions of the Spanish Sales skill group match no users."
+    }
+  }
+}
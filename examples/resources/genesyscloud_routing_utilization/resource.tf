@@ -1,26 +1,36 @@
 resource "genesyscloud_routing_utilization" "org-utililzation" {
-  call {
+  media_utilization {
+    media_type       = "call"
     maximum_capacity = 1
     include_non_acd  = true
   }
-  callback {
+  media_utilization {
+    media_type                = "callback"
     maximum_capacity          = 2
     include_non_acd           = false
     interruptible_media_types = ["call", "email"]
   }
-  chat {
+  media_utilization {
+    media_type                = "chat"
     maximum_capacity          = 3
     include_non_acd           = false
     interruptible_media_types = ["call"]
   }
-  email {
+  media_utilization {
+    media_type                = "email"
     maximum_capacity          = 2
     include_non_acd           = false
     interruptible_media_types = ["call", "chat"]
   }
-  message {
+  media_utilization {
+    media_type                = "message"
     maximum_capacity          = 4
     include_non_acd           = false
     interruptible_media_types = ["call", "chat"]
   }
-}
\ No newline at end of file
+  label_utilizations {
+    label_id                = "2ad4b5f6-1c5b-4bc4-a3f5-9b3a5c7e1d20"
+    maximum_capacity        = 1
+    interruptible_label_ids = ["6f1e2c3d-8a7b-4c5d-9e0f-1a2b3c4d5e6f"]
+  }
+}
@@ -0,0 +1,8 @@
+resource "genesyscloud_telephony_compliance_check" "e911" {
+  site_ids = [genesyscloud_telephony_providers_edges_site.branch.id]
+  site_ivrs {
+    site_id = genesyscloud_telephony_providers_edges_site.hq.id
+    ivr_ids = [genesyscloud_architect_ivr.main.id, genesyscloud_architect_ivr.support.id]
+  }
+  require_verified_address = true
+}
@@ -0,0 +1,6 @@
+resource "genesyscloud_telephony_did_assignment" "main_line" {
+  phone_number = "+13175550001"
+  owner_type   = "IVR_CONFIG"
+  owner_id     = genesyscloud_architect_ivr.main_ivr.id
+  did_pool_id  = genesyscloud_telephony_providers_edges_did_pool.main_pool.id
+}
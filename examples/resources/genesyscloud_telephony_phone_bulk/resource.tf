@@ -0,0 +1,6 @@
+resource "genesyscloud_telephony_phone_bulk" "indianapolis_phones" {
+  source_file            = "${path.module}/phones.csv"
+  site_id                = genesyscloud_telephony_providers_edges_site.site.id
+  phone_base_settings_id = genesyscloud_telephony_providers_edges_phonebasesettings.desk_phones.id
+  max_concurrency        = 5
+}
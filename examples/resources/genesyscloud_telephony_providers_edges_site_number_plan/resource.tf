@@ -0,0 +1,11 @@
+resource "genesyscloud_telephony_providers_edges_site_number_plan" "carrier_plan" {
+  site_id        = genesyscloud_telephony_providers_edges_site.site.id
+  name           = "Carrier plan"
+  classification = "carrier"
+  match_type     = "numberList"
+  priority       = 1
+  numbers {
+    start = "114"
+    end   = "115"
+  }
+}
@@ -0,0 +1,9 @@
+resource "genesyscloud_telephony_providers_edges_site_outbound_route" "carrier_route" {
+  site_id                 = genesyscloud_telephony_providers_edges_site.site.id
+  name                    = "Carrier route"
+  description             = "Route managed by the carrier team"
+  classification_types    = ["International", "National"]
+  external_trunk_base_ids = [genesyscloud_telephony_providers_edges_trunkbasesettings.trunk-base-settings1.id]
+  distribution            = "RANDOM"
+  enabled                 = true
+}
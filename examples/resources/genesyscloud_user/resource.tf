@@ -40,26 +40,31 @@ resource "genesyscloud_user" "example_user" {
     date_hire     = "2021-03-18"
   }
   routing_utilization {
-    call {
+    media_utilization {
+      media_type       = "call"
       maximum_capacity = 1
       include_non_acd  = true
     }
-    callback {
+    media_utilization {
+      media_type                = "callback"
       maximum_capacity          = 2
       include_non_acd           = false
       interruptible_media_types = ["call", "email"]
     }
-    chat {
+    media_utilization {
+      media_type                = "chat"
       maximum_capacity          = 3
       include_non_acd           = false
       interruptible_media_types = ["call"]
     }
-    email {
+    media_utilization {
+      media_type                = "email"
       maximum_capacity          = 2
       include_non_acd           = false
       interruptible_media_types = ["call", "chat"]
     }
-    message {
+    media_utilization {
+      media_type                = "message"
       maximum_capacity          = 4
       include_non_acd           = false
       interruptible_media_types = ["call", "chat"]
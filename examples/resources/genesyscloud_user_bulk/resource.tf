@@ -0,0 +1,4 @@
+resource "genesyscloud_user_bulk" "contact_center_agents" {
+  source_file     = "${path.module}/roster.csv"
+  max_concurrency = 5
+}
@@ -0,0 +1,4 @@
+resource "genesyscloud_user_station" "front_desk" {
+  user_id          = genesyscloud_user.test_user.id
+  default_phone_id = genesyscloud_telephony_providers_edges_phone.front_desk.id
+}
@@ -0,0 +1,277 @@
+package genesyscloud
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v109/platformclientv2"
+)
+
+var scheduleGroupStatusScheduleResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"name": {
+			Description: "Name of the schedule, returned in the results.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"start": {
+			Description:      "Date time is represented as an ISO-8601 string without a timezone. For example: 2006-01-02T15:04:05.000000.",
+			Type:             schema.TypeString,
+			Required:         true,
+			ValidateDiagFunc: validateLocalDateTimes,
+		},
+		"end": {
+			Description:      "Date time is represented as an ISO-8601 string without a timezone. For example: 2006-01-02T15:04:05.000000.",
+			Type:             schema.TypeString,
+			Required:         true,
+			ValidateDiagFunc: validateLocalDateTimes,
+		},
+		"rrule": {
+			Description:      "An iCal Recurrence Rule (RRULE) string. The supported frequencies are daily, weekly, monthly and yearly.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: validateRrule(architectScheduleFreqs...),
+		},
+	},
+}
+
+func dataSourceArchitectScheduleGroupStatus() *schema.Resource {
+	return &schema.Resource{
+		Description: `Data source for evaluating a Genesys Cloud Schedule Group. Reports whether the schedule group is open, closed or on holiday at each of a list of instants, without placing calls.
+
+Holiday schedules take precedence over closed schedules, which take precedence over open schedules. Instants outside of every schedule are closed. Schedules are evaluated in the time zone of the schedule group, or UTC if the group has no time zone. Schedules with an RRULE that cannot be evaluated, such as one using BYSETPOS or BYHOUR, are treated as never active and reported in a warning.`,
+		ReadContext: ReadWithPooledClient(dataSourceArchitectScheduleGroupStatusRead),
+		Schema: map[string]*schema.Schema{
+			"schedule_group_id": {
+				Description:  "ID of the schedule group to evaluate.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"schedule_group_id", "schedule_group"},
+			},
+			"schedule_group": {
+				Description: "Schedule group to evaluate without reading it from Genesys Cloud.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"time_zone": {
+							Description:      "The timezone the schedules are a part of.",
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validateTimeZone,
+						},
+						"open_schedules": {
+							Description: "The schedules defining the hours an organization is open.",
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        scheduleGroupStatusScheduleResource,
+						},
+						"closed_schedules": {
+							Description: "The schedules defining the hours an organization is closed.",
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        scheduleGroupStatusScheduleResource,
+						},
+						"holiday_schedules": {
+							Description: "The schedules defining the hours an organization is closed for the holidays.",
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        scheduleGroupStatusScheduleResource,
+						},
+					},
+				},
+			},
+			"instants": {
+				Description: "Instants to evaluate. Either RFC 3339 times such as 2023-12-24T17:30:00+11:00, or date times such as 2023-12-24T17:30:00 in the time zone of the schedule group.",
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"time_zone": {
+				Description: "The time zone the schedule group was evaluated in.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"results": {
+				Description: "Status of the schedule group at each instant, in the order of `instants`.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instant": {
+							Description: "The evaluated instant.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"local_date_time": {
+							Description: "The instant as a date time in the time zone of the schedule group.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"status": {
+							Description: "Status of the schedule group. Valid values: OPEN, CLOSED, HOLIDAY.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"schedule_id": {
+							Description: "ID of the schedule that set the status. Empty for schedule groups set with `schedule_group`, and when no schedule is active.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"schedule_name": {
+							Description: "Name of the schedule that set the status. Empty when no schedule is active.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArchitectScheduleGroupStatusRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var calendar *scheduleGroupCalendar
+	scheduleGroupId := d.Get("schedule_group_id").(string)
+	if scheduleGroupId != "" {
+		sdkConfig := m.(*ProviderMeta).ClientConfig
+		var diagErr diag.Diagnostics
+		if calendar, diagErr = getScheduleGroupCalendar(ctx, scheduleGroupId, sdkConfig); diagErr != nil {
+			return diagErr
+		}
+	} else {
+		var err error
+		if calendar, err = buildScheduleGroupCalendar(d.Get("schedule_group").([]interface{})); err != nil {
+			return diag.Errorf("Invalid schedule_group: %v", err)
+		}
+	}
+
+	results := make([]interface{}, 0)
+	for _, instant := range d.Get("instants").([]interface{}) {
+		result, err := calendar.evaluate(instant.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		results = append(results, flattenScheduleGroupStatusResult(result))
+	}
+	d.Set("time_zone", calendar.location.String())
+	d.Set("results", results)
+
+	if scheduleGroupId == "" {
+		// Schedule groups set in the configuration are identified by their time zone
+		scheduleGroupId = calendar.location.String()
+	}
+	d.SetId(scheduleGroupId)
+
+	var warnings diag.Diagnostics
+	for _, schedule := range calendar.skippedSchedules() {
+		if schedule.name == "" {
+			schedule.name = "starting " + formatLocalDateTime(schedule.start)
+		}
+		warnings = append(warnings, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Schedule %s is not evaluated", schedule.name),
+			Detail:   fmt.Sprintf("The windows of the rrule of schedule %s cannot be computed, so it is treated as never active: %v", schedule.name, schedule.ruleErr),
+		})
+	}
+	return warnings
+}
+
+// getScheduleGroupCalendar reads a schedule group and its schedules
+func getScheduleGroupCalendar(ctx context.Context, scheduleGroupId string, sdkConfig *platformclientv2.Configuration) (*scheduleGroupCalendar, diag.Diagnostics) {
+	archAPI := platformclientv2.NewArchitectApiWithConfig(sdkConfig)
+
+	var calendar *scheduleGroupCalendar
+	diagErr := WithRetries(ctx, 15*time.Second, func() *retry.RetryError {
+		scheduleGroup, _, getErr := archAPI.GetArchitectSchedulegroup(scheduleGroupId)
+		if getErr != nil {
+			return retry.NonRetryableError(fmt.Errorf("Failed to read schedule group %s: %s", scheduleGroupId, getErr))
+		}
+
+		calendar = &scheduleGroupCalendar{location: time.UTC}
+		if scheduleGroup.TimeZone != nil && *scheduleGroup.TimeZone != "" {
+			location, err := time.LoadLocation(*scheduleGroup.TimeZone)
+			if err != nil {
+				return retry.NonRetryableError(fmt.Errorf("Schedule group %s has an unknown time zone %s", scheduleGroupId, *scheduleGroup.TimeZone))
+			}
+			calendar.location = location
+		}
+
+		for _, schedules := range []struct {
+			refs   *[]platformclientv2.Domainentityref
+			target *[]groupSchedule
+		}{
+			{scheduleGroup.OpenSchedules, &calendar.open},
+			{scheduleGroup.ClosedSchedules, &calendar.closed},
+			{scheduleGroup.HolidaySchedules, &calendar.holiday},
+		} {
+			if schedules.refs == nil {
+				continue
+			}
+			for _, ref := range *schedules.refs {
+				schedule, _, getErr := archAPI.GetArchitectSchedule(*ref.Id)
+				if getErr != nil {
+					return retry.NonRetryableError(fmt.Errorf("Failed to read schedule %s of schedule group %s: %s", *ref.Id, scheduleGroupId, getErr))
+				}
+				groupSchedule, err := newGroupScheduleFromSdk(*schedule)
+				if err != nil {
+					return retry.NonRetryableError(fmt.Errorf("Failed to evaluate schedule group %s: %v", scheduleGroupId, err))
+				}
+				*schedules.target = append(*schedules.target, groupSchedule)
+			}
+		}
+		return nil
+	})
+	if diagErr != nil {
+		return nil, diagErr
+	}
+	return calendar, nil
+}
+
+func buildScheduleGroupCalendar(scheduleGroupList []interface{}) (*scheduleGroupCalendar, error) {
+	if len(scheduleGroupList) == 0 || scheduleGroupList[0] == nil {
+		return nil, fmt.Errorf("time_zone is required")
+	}
+	scheduleGroupMap := scheduleGroupList[0].(map[string]interface{})
+
+	location, err := time.LoadLocation(scheduleGroupMap["time_zone"].(string))
+	if err != nil {
+		return nil, err
+	}
+	calendar := &scheduleGroupCalendar{location: location}
+	for attr, target := range map[string]*[]groupSchedule{
+		"open_schedules":    &calendar.open,
+		"closed_schedules":  &calendar.closed,
+		"holiday_schedules": &calendar.holiday,
+	} {
+		scheduleList, _ := scheduleGroupMap[attr].([]interface{})
+		for _, scheduleItem := range scheduleList {
+			scheduleMap := scheduleItem.(map[string]interface{})
+			schedule, err := newGroupSchedule("", scheduleMap["name"].(string), scheduleMap["start"].(string), scheduleMap["end"].(string), scheduleMap["rrule"].(string))
+			if err != nil {
+				return nil, err
+			}
+			*target = append(*target, schedule)
+		}
+	}
+	return calendar, nil
+}
+
+func flattenScheduleGroupStatusResult(result scheduleGroupStatusResult) map[string]interface{} {
+	resultMap := map[string]interface{}{
+		"instant":         result.instant,
+		"local_date_time": formatLocalDateTime(result.local),
+		"status":          result.status,
+	}
+	if result.schedule != nil {
+		resultMap["schedule_id"] = result.schedule.id
+		resultMap["schedule_name"] = result.schedule.name
+	}
+	return resultMap
+}
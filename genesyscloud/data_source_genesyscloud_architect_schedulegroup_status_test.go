@@ -0,0 +1,126 @@
+package genesyscloud
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceArchitectScheduleGroupStatus(t *testing.T) {
+	var (
+		schedGroupResource = "arch-sched-group"
+		name               = "Schedule Group " + uuid.NewString()
+		time_zone          = "Australia/Sydney"
+		statusDataSource   = "sched-group-status"
+		configDataSource   = "sched-group-config-status"
+
+		openSchedResource    = "arch-sched-open"
+		holidaySchedResource = "arch-sched-holiday"
+		openSched            = "Open Schedule " + uuid.NewString()
+		holidaySched         = "Christmas Eve " + uuid.NewString()
+		schedDesc            = "Sample Schedule by CX as Code"
+
+		// Open 9:00-18:00 on weekdays, closing early on Christmas Eve
+		openStart     = "2023-01-02T09:00:00.000000"
+		openEnd       = "2023-01-02T18:00:00.000000"
+		openRrule     = "FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR"
+		holidayStart  = "2023-12-24T17:00:00.000000"
+		holidayEnd    = "2023-12-25T00:00:00.000000"
+		holidayRrule  = "FREQ=YEARLY"
+		instants      = []string{"2023-12-22T17:30:00", "2024-12-24T17:30:00", "2024-12-24T06:30:00Z", "2023-12-23T10:00:00"}
+		expectedState = []string{scheduleGroupStatusOpen, scheduleGroupStatusHoliday, scheduleGroupStatusHoliday, scheduleGroupStatusClosed}
+	)
+
+	statusChecks := func(dataSource string) resource.TestCheckFunc {
+		var checks []resource.TestCheckFunc
+		for i, status := range expectedState {
+			checks = append(checks, resource.TestCheckResourceAttr(dataSource, fmt.Sprintf("results.%d.status", i), status))
+		}
+		checks = append(checks,
+			resource.TestCheckResourceAttr(dataSource, "time_zone", time_zone),
+			resource.TestCheckResourceAttr(dataSource, "results.2.local_date_time", "2024-12-24T17:30:00.000000"),
+		)
+		return resource.ComposeTestCheckFunc(checks...)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				Config: generateArchitectSchedulesResource(
+					openSchedResource,
+					openSched,
+					nullValue,
+					schedDesc,
+					openStart,
+					openEnd,
+					openRrule,
+				) + generateArchitectSchedulesResource(
+					holidaySchedResource,
+					holidaySched,
+					nullValue,
+					schedDesc,
+					holidayStart,
+					holidayEnd,
+					holidayRrule,
+				) + generateArchitectScheduleGroupsResource(
+					schedGroupResource,
+					name,
+					nullValue,
+					schedDesc,
+					time_zone,
+					generateSchedules("open_schedules_id", "genesyscloud_architect_schedules."+openSchedResource+".id"),
+					generateSchedules("holiday_schedules_id", "genesyscloud_architect_schedules."+holidaySchedResource+".id"),
+				) + generateScheduleGroupStatusDataSource(
+					statusDataSource,
+					"schedule_group_id = genesyscloud_architect_schedulegroups."+schedGroupResource+".id",
+					instants,
+				) + generateScheduleGroupStatusDataSource(
+					configDataSource,
+					fmt.Sprintf(`schedule_group {
+						time_zone = "%s"
+						%s
+						%s
+					}`, time_zone,
+						generateScheduleGroupStatusSchedule("open_schedules", openSched, openStart, openEnd, openRrule),
+						generateScheduleGroupStatusSchedule("holiday_schedules", holidaySched, holidayStart, holidayEnd, holidayRrule),
+					),
+					instants,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					statusChecks("data.genesyscloud_architect_schedulegroup_status."+statusDataSource),
+					resource.TestCheckResourceAttrPair("data.genesyscloud_architect_schedulegroup_status."+statusDataSource, "results.1.schedule_id", "genesyscloud_architect_schedules."+holidaySchedResource, "id"),
+					statusChecks("data.genesyscloud_architect_schedulegroup_status."+configDataSource),
+					resource.TestCheckResourceAttr("data.genesyscloud_architect_schedulegroup_status."+configDataSource, "results.1.schedule_name", holidaySched),
+				),
+			},
+		},
+	})
+}
+
+func generateScheduleGroupStatusDataSource(resourceID string, scheduleGroup string, instants []string) string {
+	quotedInstants := make([]string, 0, len(instants))
+	for _, instant := range instants {
+		quotedInstants = append(quotedInstants, strconv.Quote(instant))
+	}
+	return fmt.Sprintf(`data "genesyscloud_architect_schedulegroup_status" "%s" {
+		%s
+		instants = [%s]
+	}
+	`, resourceID, scheduleGroup, strings.Join(quotedInstants, ", "))
+}
+
+func generateScheduleGroupStatusSchedule(blockName string, name string, start string, end string, rrule string) string {
+	return fmt.Sprintf(`%s {
+		name  = "%s"
+		start = "%s"
+		end   = "%s"
+		rrule = "%s"
+	}
+	`, blockName, name, start, end, rrule)
+}
@@ -0,0 +1,170 @@
+package genesyscloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	lists "terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v109/platformclientv2"
+)
+
+// rolePermissionComparison is the result of comparing the permission policies of a role with those of another role
+type rolePermissionComparison struct {
+	added       []string
+	removed     []string
+	same        []string
+	wildcards   []string
+	conditional []string
+}
+
+func dataSourceAuthRoleAnalysis() *schema.Resource {
+	return &schema.Resource{
+		Description: `Data source for Genesys Cloud Role Analysis. Compares the permission policies of a role with another role, or with the default version of a default role, for access reviews.
+
+Permissions are listed as ` + "`domain:entity:action`" + `. Wildcards are expanded using the org's permission catalog. Policy conditions are not compared, but the permissions of the role that only apply under conditions are listed.`,
+		ReadContext: ReadWithPooledClient(dataSourceAuthRoleAnalysisRead),
+		Schema: map[string]*schema.Schema{
+			"role_id": {
+				Description: "ID of the role to analyze.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"compare_role_id": {
+				Description: "ID of the role to compare with. If not set, `role_id` must be a default role and is compared with its default version.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"added_permissions": {
+				Description: "Permissions of the role that the compared role does not have.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"removed_permissions": {
+				Description: "Permissions of the compared role that the role does not have.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"same_permissions": {
+				Description: "Permissions both roles have.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"wildcard_policies": {
+				Description: "Policies of the role that grant a wildcard entity or action, e.g. `routing:*:view`. These usually grant more than needed.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"conditional_permissions": {
+				Description: "Permissions of the role that only apply under conditions.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceAuthRoleAnalysisRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sdkConfig := m.(*ProviderMeta).ClientConfig
+	authAPI := platformclientv2.NewAuthorizationApiWithConfig(sdkConfig)
+
+	roleId := d.Get("role_id").(string)
+	compareRoleId := d.Get("compare_role_id").(string)
+
+	role, _, getErr := authAPI.GetAuthorizationRole(roleId, false, nil)
+	if getErr != nil {
+		return diag.Errorf("Failed to read role %s: %s", roleId, getErr)
+	}
+
+	var comparePolicies *[]platformclientv2.Domainpermissionpolicy
+	if compareRoleId != "" {
+		compareRole, _, getErr := authAPI.GetAuthorizationRole(compareRoleId, false, nil)
+		if getErr != nil {
+			return diag.Errorf("Failed to read role %s: %s", compareRoleId, getErr)
+		}
+		comparePolicies = compareRole.PermissionPolicies
+	} else {
+		if role.DefaultRoleId == nil || *role.DefaultRoleId == "" {
+			return diag.Errorf("Role %s is not a default role. Set compare_role_id to compare it with another role", roleId)
+		}
+		difference, _, getErr := authAPI.GetAuthorizationRoleComparedefaultRightRoleId(*role.DefaultRoleId, roleId)
+		if getErr != nil {
+			return diag.Errorf("Failed to compare role %s with its default version: %s", roleId, getErr)
+		}
+		if difference.RoleFromDefault != nil {
+			comparePolicies = difference.RoleFromDefault.PermissionPolicies
+		}
+		compareRoleId = "default"
+	}
+
+	catalog, err := getPermissionCatalog(sdkConfig)
+	if err != nil {
+		log.Printf("Wildcards are not expanded: %v", err)
+		catalog = permissionCatalog{}
+	}
+
+	comparison := compareRolePermissions(catalog, *lists.NilToEmptyList(role.PermissionPolicies), *lists.NilToEmptyList(comparePolicies))
+	d.Set("added_permissions", comparison.added)
+	d.Set("removed_permissions", comparison.removed)
+	d.Set("same_permissions", comparison.same)
+	d.Set("wildcard_policies", comparison.wildcards)
+	d.Set("conditional_permissions", comparison.conditional)
+
+	d.SetId(fmt.Sprintf("%s/%s", roleId, compareRoleId))
+	return nil
+}
+
+// compareRolePermissions compares the permissions granted by the policies of a role with those of another role
+func compareRolePermissions(catalog permissionCatalog, policies []platformclientv2.Domainpermissionpolicy, comparePolicies []platformclientv2.Domainpermissionpolicy) rolePermissionComparison {
+	permissions, wildcards, conditional := expandRolePermissions(catalog, policies)
+	comparePermissions, _, _ := expandRolePermissions(catalog, comparePolicies)
+
+	return rolePermissionComparison{
+		added:       sortedPermissions(lists.SliceDifference(permissions, comparePermissions)),
+		removed:     sortedPermissions(lists.SliceDifference(comparePermissions, permissions)),
+		same:        sortedPermissions(lists.SliceIntersection(permissions, comparePermissions)),
+		wildcards:   sortedPermissions(wildcards),
+		conditional: sortedPermissions(conditional),
+	}
+}
+
+func expandRolePermissions(catalog permissionCatalog, policies []platformclientv2.Domainpermissionpolicy) (permissions []string, wildcards []string, conditional []string) {
+	for _, policy := range policies {
+		if policy.Domain == nil || policy.EntityName == nil || policy.ActionSet == nil {
+			continue
+		}
+		policyPermissions := catalog.expand(*policy.Domain, *policy.EntityName, *policy.ActionSet)
+		permissions = append(permissions, policyPermissions...)
+		if policy.ResourceConditionNode != nil {
+			conditional = append(conditional, policyPermissions...)
+		}
+
+		for _, action := range *policy.ActionSet {
+			if *policy.EntityName == permissionWildcard || action == permissionWildcard {
+				wildcards = append(wildcards, strings.Join([]string{*policy.Domain, *policy.EntityName, action}, ":"))
+			}
+		}
+	}
+	return permissions, wildcards, conditional
+}
+
+func sortedPermissions(permissions []string) []string {
+	unique := make([]string, 0, len(permissions))
+	for _, permission := range permissions {
+		if !lists.ItemInSlice(permission, unique) {
+			unique = append(unique, permission)
+		}
+	}
+	sort.Strings(unique)
+	return unique
+}
@@ -0,0 +1,97 @@
+package genesyscloud
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	lists "terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/mypurecloud/platform-client-sdk-go/v109/platformclientv2"
+)
+
+func TestAccDataSourceAuthRoleAnalysis(t *testing.T) {
+	var (
+		roleResource1    = "analysis-role1"
+		roleResource2    = "analysis-role2"
+		analysisResource = "role-analysis"
+		roleName1        = "Terraform Role Analysis-" + uuid.NewString()
+		roleName2        = "Terraform Role Analysis-" + uuid.NewString()
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				Config: generateAuthRoleResource(
+					roleResource1,
+					roleName1,
+					"Terraform role analysis",
+					generateRolePermPolicy("directory", "user", strconv.Quote("add"), strconv.Quote("edit")),
+					generateRolePermPolicy("routing", "queue", strconv.Quote("view")),
+				) + generateAuthRoleResource(
+					roleResource2,
+					roleName2,
+					"Terraform role analysis",
+					generateRolePermPolicy("directory", "user", strconv.Quote("add")),
+					generateRolePermPolicy("directory", "group", strconv.Quote("view")),
+				) + generateAuthRoleAnalysisDataSource(
+					analysisResource,
+					"genesyscloud_auth_role."+roleResource1+".id",
+					"genesyscloud_auth_role."+roleResource2+".id",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.genesyscloud_auth_role_analysis."+analysisResource, "added_permissions.#", "2"),
+					resource.TestCheckTypeSetElemAttr("data.genesyscloud_auth_role_analysis."+analysisResource, "added_permissions.*", "directory:user:edit"),
+					resource.TestCheckTypeSetElemAttr("data.genesyscloud_auth_role_analysis."+analysisResource, "added_permissions.*", "routing:queue:view"),
+					resource.TestCheckResourceAttr("data.genesyscloud_auth_role_analysis."+analysisResource, "removed_permissions.0", "directory:group:view"),
+					resource.TestCheckResourceAttr("data.genesyscloud_auth_role_analysis."+analysisResource, "same_permissions.0", "directory:user:add"),
+					resource.TestCheckResourceAttr("data.genesyscloud_auth_role_analysis."+analysisResource, "wildcard_policies.#", "0"),
+				),
+			},
+		},
+		CheckDestroy: testVerifyRolesDestroyed,
+	})
+}
+
+func TestCompareRolePermissions(t *testing.T) {
+	catalog := permissionCatalog{}
+	catalog.add("routing", "queue", "view", true)
+	catalog.add("routing", "queue", "edit", false)
+	catalog.add("routing", "skill", "view", false)
+
+	policy := func(domain string, entity string, conditional bool, actions ...string) platformclientv2.Domainpermissionpolicy {
+		policy := platformclientv2.Domainpermissionpolicy{Domain: &domain, EntityName: &entity, ActionSet: &actions}
+		if conditional {
+			conjunction := "AND"
+			policy.ResourceConditionNode = &platformclientv2.Domainresourceconditionnode{Conjunction: &conjunction}
+		}
+		return policy
+	}
+
+	comparison := compareRolePermissions(catalog,
+		[]platformclientv2.Domainpermissionpolicy{
+			policy("routing", "*", false, "view"),
+			policy("directory", "user", true, "add"),
+		},
+		[]platformclientv2.Domainpermissionpolicy{
+			policy("routing", "queue", false, "*"),
+		},
+	)
+	assertStringList(t, lists.StringListToInterfaceList(comparison.added), []string{"directory:user:add", "routing:skill:view"})
+	assertStringList(t, lists.StringListToInterfaceList(comparison.removed), []string{"routing:queue:edit"})
+	assertStringList(t, lists.StringListToInterfaceList(comparison.same), []string{"routing:queue:view"})
+	assertStringList(t, lists.StringListToInterfaceList(comparison.wildcards), []string{"routing:*:view"})
+	assertStringList(t, lists.StringListToInterfaceList(comparison.conditional), []string{"directory:user:add"})
+}
+
+func generateAuthRoleAnalysisDataSource(resourceID string, roleID string, compareRoleID string) string {
+	return fmt.Sprintf(`data "genesyscloud_auth_role_analysis" "%s" {
+		role_id         = %s
+		compare_role_id = %s
+	}
+	`, resourceID, roleID, compareRoleID)
+}
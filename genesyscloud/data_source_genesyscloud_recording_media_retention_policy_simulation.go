@@ -0,0 +1,793 @@
+package genesyscloud
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mypurecloud/platform-client-sdk-go/v109/platformclientv2"
+)
+
+var (
+	simulatedRetentionPolicy = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "The policy name.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"order": {
+				Description: "The ordinal number for the policy. Policies are evaluated in ascending order, ties keep the order of the blocks.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"enabled": {
+				Description: "Disabled policies are reported but never match.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"media_policies": {
+				Description: "Conditions and actions per media type. Same as `media_policies` on `genesyscloud_recording_media_retention_policy`.",
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Optional:    true,
+				Elem:        mediaPolicies,
+			},
+			"conditions": {
+				Description: "Conditions. Same as `conditions` on `genesyscloud_recording_media_retention_policy`.",
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Optional:    true,
+				Elem:        policyConditions,
+			},
+			"actions": {
+				Description: "Actions. Same as `actions` on `genesyscloud_recording_media_retention_policy`.",
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Optional:    true,
+				Elem:        policyActions,
+			},
+		},
+	}
+
+	simulatedRetentionConversation = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"media_type": {
+				Description:  "Media type of the conversation.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"CALL", "CHAT", "EMAIL", "MESSAGE"}, true),
+			},
+			"direction": {
+				Description:  "Direction of the conversation.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"INBOUND", "OUTBOUND"}, true),
+			},
+			"queue_id": {
+				Description: "ID of the queue the conversation was routed through.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"user_id": {
+				Description: "ID of the user who handled the conversation.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"wrapup_code_id": {
+				Description: "ID of the wrap-up code applied to the conversation.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"language_id": {
+				Description: "ID of the language of the conversation.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"time": {
+				Description:  "Start of the conversation as an RFC 3339 timestamp, e.g. 2023-05-12T14:30:00Z.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"duration_seconds": {
+				Description:  "Duration of the conversation in seconds. Policies with a duration condition never match when this is not set.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+		},
+	}
+
+	simulatedRetentionPolicyResult = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "The policy name.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"order": {
+				Description: "The ordinal number for the policy.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"matched": {
+				Description: "Whether the policy applies to the conversation.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"matched_by": {
+				Description: "The block that matched, e.g. `media_policies.call_policy` or `conditions`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"reason": {
+				Description: "Why the policy did not match.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"actions": {
+				Description: "Names of the actions that would be applied, e.g. `retain_recording` or `assign_evaluations`.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+
+	durationRangeBoundRegex = regexp.MustCompile(`^PT(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?$`)
+)
+
+// retentionConversation is the synthetic conversation a set of retention policies is evaluated against
+type retentionConversation struct {
+	mediaType    string
+	direction    string
+	queueId      string
+	userId       string
+	wrapupCodeId string
+	languageId   string
+	time         time.Time
+	duration     *time.Duration
+}
+
+// retentionConditions holds the criteria shared by the media specific and the generic policy conditions
+type retentionConditions struct {
+	userIds       []string
+	queueIds      []string
+	wrapupCodeIds []string
+	languageIds   []string
+	directions    []string
+	mediaTypes    []string
+	dateRanges    []string
+	timeAllowed   *platformclientv2.Timeallowed
+	duration      *platformclientv2.Durationcondition
+}
+
+type retentionPolicyResult struct {
+	name      string
+	order     int
+	matched   bool
+	matchedBy string
+	reason    string
+	actions   *platformclientv2.Policyactions
+}
+
+func dataSourceRecordingMediaRetentionPolicySimulation() *schema.Resource {
+	return &schema.Resource{
+		Description: "Evaluates media retention policies locally against a synthetic conversation to show which policies and actions would apply. No API calls are made.",
+		ReadContext: ReadWithPooledClient(dataSourceRecordingMediaRetentionPolicySimulationRead),
+		Schema: map[string]*schema.Schema{
+			"policy": {
+				Description: "Media retention policies to evaluate.",
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem:        simulatedRetentionPolicy,
+			},
+			"conversation": {
+				Description: "The conversation to evaluate the policies against.",
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Elem:        simulatedRetentionConversation,
+			},
+			"results": {
+				Description: "Evaluation result of every policy, in priority order.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        simulatedRetentionPolicyResult,
+			},
+			"matched_policy_names": {
+				Description: "Names of the policies that apply to the conversation, in priority order.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"recording_outcome": {
+				Description: "What happens to the recording once all matched policies are applied: `delete` if any policy sets `always_delete`, otherwise `retain` if any sets `retain_recording`, otherwise `delete` if any sets `delete_recording`, otherwise `none`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceRecordingMediaRetentionPolicySimulationRead(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	conversation, err := buildRetentionConversation(d.Get("conversation").([]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	policies := buildSimulatedRetentionPolicies(d.Get("policy").([]interface{}))
+	results, err := simulateRetentionPolicies(policies, conversation)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	matchedPolicyNames := make([]interface{}, 0)
+	matchedActions := make([]*platformclientv2.Policyactions, 0)
+	for _, result := range results {
+		if result.matched {
+			matchedPolicyNames = append(matchedPolicyNames, result.name)
+			matchedActions = append(matchedActions, result.actions)
+		}
+	}
+
+	d.SetId(retentionSimulationId(d))
+	d.Set("results", flattenRetentionPolicyResults(results))
+	d.Set("matched_policy_names", matchedPolicyNames)
+	d.Set("recording_outcome", getRecordingOutcome(matchedActions))
+	return nil
+}
+
+func buildRetentionConversation(conversations []interface{}) (*retentionConversation, error) {
+	conversationMap := conversations[0].(map[string]interface{})
+
+	conversationTime, err := time.Parse(time.RFC3339, conversationMap["time"].(string))
+	if err != nil {
+		return nil, fmt.Errorf("conversation time %s is not a valid RFC 3339 timestamp: %v", conversationMap["time"], err)
+	}
+
+	conversation := &retentionConversation{
+		mediaType:    conversationMap["media_type"].(string),
+		direction:    conversationMap["direction"].(string),
+		queueId:      conversationMap["queue_id"].(string),
+		userId:       conversationMap["user_id"].(string),
+		wrapupCodeId: conversationMap["wrapup_code_id"].(string),
+		languageId:   conversationMap["language_id"].(string),
+		time:         conversationTime,
+	}
+	if durationSeconds := conversationMap["duration_seconds"].(int); durationSeconds > 0 {
+		duration := time.Duration(durationSeconds) * time.Second
+		conversation.duration = &duration
+	}
+	return conversation, nil
+}
+
+func buildSimulatedRetentionPolicies(policies []interface{}) []platformclientv2.Policy {
+	sdkPolicies := make([]platformclientv2.Policy, 0)
+	for _, policy := range policies {
+		policyMap := policy.(map[string]interface{})
+		name := policyMap["name"].(string)
+		order := policyMap["order"].(int)
+		enabled := policyMap["enabled"].(bool)
+
+		sdkPolicy := platformclientv2.Policy{
+			Name:       &name,
+			Order:      &order,
+			Enabled:    &enabled,
+			Conditions: buildPolicyConditions(policyMap["conditions"].([]interface{})),
+			Actions:    buildPolicyActions(policyMap["actions"].([]interface{})),
+		}
+		if mediaPolicies := policyMap["media_policies"].([]interface{}); len(mediaPolicies) > 0 {
+			sdkPolicy.MediaPolicies = buildSdkMediaPolicies(mediaPolicies)
+		}
+		sdkPolicies = append(sdkPolicies, sdkPolicy)
+	}
+	return sdkPolicies
+}
+
+// simulateRetentionPolicies evaluates every policy against the conversation and returns the results sorted by policy order
+func simulateRetentionPolicies(policies []platformclientv2.Policy, conversation *retentionConversation) ([]retentionPolicyResult, error) {
+	sortedPolicies := make([]platformclientv2.Policy, len(policies))
+	copy(sortedPolicies, policies)
+	sort.SliceStable(sortedPolicies, func(i, j int) bool {
+		return *sortedPolicies[i].Order < *sortedPolicies[j].Order
+	})
+
+	results := make([]retentionPolicyResult, 0)
+	for _, policy := range sortedPolicies {
+		result := retentionPolicyResult{
+			name:  *policy.Name,
+			order: *policy.Order,
+		}
+		if policy.Enabled != nil && !*policy.Enabled {
+			result.reason = "policy is disabled"
+			results = append(results, result)
+			continue
+		}
+
+		blockName, conditions, actions, hasMediaPolicy := getMediaPolicyForConversation(policy.MediaPolicies, conversation.mediaType)
+		if hasMediaPolicy {
+			reason, err := matchRetentionConditions(conditions, conversation)
+			if err != nil {
+				return nil, fmt.Errorf("policy %s %s: %v", *policy.Name, blockName, err)
+			}
+			if reason == "" {
+				result.matched = true
+				result.matchedBy = blockName
+				result.actions = actions
+			} else {
+				result.reason = fmt.Sprintf("%s: %s", blockName, reason)
+			}
+		}
+
+		if !result.matched && policy.Conditions != nil {
+			reason, err := matchRetentionConditions(policyConditionsToRetentionConditions(policy.Conditions), conversation)
+			if err != nil {
+				return nil, fmt.Errorf("policy %s conditions: %v", *policy.Name, err)
+			}
+			if reason == "" {
+				result.matched = true
+				result.matchedBy = "conditions"
+				result.actions = policy.Actions
+				result.reason = ""
+			} else if result.reason == "" {
+				result.reason = "conditions: " + reason
+			}
+		}
+
+		if !result.matched && result.reason == "" {
+			result.reason = fmt.Sprintf("no %s media policy or conditions", strings.ToLower(conversation.mediaType))
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// getMediaPolicyForConversation returns the media specific conditions and actions that apply to the conversation media type
+func getMediaPolicyForConversation(mediaPolicies *platformclientv2.Mediapolicies, mediaType string) (string, *retentionConditions, *platformclientv2.Policyactions, bool) {
+	if mediaPolicies == nil {
+		return "", nil, nil, false
+	}
+
+	switch strings.ToUpper(mediaType) {
+	case "CALL":
+		if policy := mediaPolicies.CallPolicy; policy != nil {
+			var conditions *retentionConditions
+			if c := policy.Conditions; c != nil {
+				conditions = &retentionConditions{
+					userIds:       userIdsOf(c.ForUsers),
+					queueIds:      queueIdsOf(c.ForQueues),
+					wrapupCodeIds: wrapupCodeIdsOf(c.WrapupCodes),
+					languageIds:   languageIdsOf(c.Languages),
+					directions:    stringsOf(c.Directions),
+					dateRanges:    stringsOf(c.DateRanges),
+					timeAllowed:   c.TimeAllowed,
+					duration:      c.Duration,
+				}
+			}
+			return "media_policies.call_policy", conditions, policy.Actions, true
+		}
+	case "CHAT":
+		if policy := mediaPolicies.ChatPolicy; policy != nil {
+			var conditions *retentionConditions
+			if c := policy.Conditions; c != nil {
+				conditions = &retentionConditions{
+					userIds:       userIdsOf(c.ForUsers),
+					queueIds:      queueIdsOf(c.ForQueues),
+					wrapupCodeIds: wrapupCodeIdsOf(c.WrapupCodes),
+					languageIds:   languageIdsOf(c.Languages),
+					dateRanges:    stringsOf(c.DateRanges),
+					timeAllowed:   c.TimeAllowed,
+					duration:      c.Duration,
+				}
+			}
+			return "media_policies.chat_policy", conditions, policy.Actions, true
+		}
+	case "EMAIL":
+		if policy := mediaPolicies.EmailPolicy; policy != nil {
+			var conditions *retentionConditions
+			if c := policy.Conditions; c != nil {
+				conditions = &retentionConditions{
+					userIds:       userIdsOf(c.ForUsers),
+					queueIds:      queueIdsOf(c.ForQueues),
+					wrapupCodeIds: wrapupCodeIdsOf(c.WrapupCodes),
+					languageIds:   languageIdsOf(c.Languages),
+					dateRanges:    stringsOf(c.DateRanges),
+					timeAllowed:   c.TimeAllowed,
+				}
+			}
+			return "media_policies.email_policy", conditions, policy.Actions, true
+		}
+	case "MESSAGE":
+		if policy := mediaPolicies.MessagePolicy; policy != nil {
+			var conditions *retentionConditions
+			if c := policy.Conditions; c != nil {
+				conditions = &retentionConditions{
+					userIds:       userIdsOf(c.ForUsers),
+					queueIds:      queueIdsOf(c.ForQueues),
+					wrapupCodeIds: wrapupCodeIdsOf(c.WrapupCodes),
+					languageIds:   languageIdsOf(c.Languages),
+					dateRanges:    stringsOf(c.DateRanges),
+					timeAllowed:   c.TimeAllowed,
+				}
+			}
+			return "media_policies.message_policy", conditions, policy.Actions, true
+		}
+	}
+	return "", nil, nil, false
+}
+
+func policyConditionsToRetentionConditions(conditions *platformclientv2.Policyconditions) *retentionConditions {
+	return &retentionConditions{
+		userIds:       userIdsOf(conditions.ForUsers),
+		queueIds:      queueIdsOf(conditions.ForQueues),
+		wrapupCodeIds: wrapupCodeIdsOf(conditions.WrapupCodes),
+		directions:    stringsOf(conditions.Directions),
+		mediaTypes:    stringsOf(conditions.MediaTypes),
+		dateRanges:    stringsOf(conditions.DateRanges),
+		timeAllowed:   conditions.TimeAllowed,
+		duration:      conditions.Duration,
+	}
+}
+
+// matchRetentionConditions returns an empty string if the conversation meets every condition, otherwise the first condition that failed.
+// An error is returned if a condition itself is malformed.
+func matchRetentionConditions(conditions *retentionConditions, conversation *retentionConversation) (string, error) {
+	if conditions == nil {
+		return "", nil
+	}
+
+	if !matchesIdCondition(conditions.userIds, conversation.userId, false) {
+		return "user is not in for_user_ids", nil
+	}
+	if !matchesIdCondition(conditions.queueIds, conversation.queueId, false) {
+		return "queue is not in for_queue_ids", nil
+	}
+	if !matchesIdCondition(conditions.wrapupCodeIds, conversation.wrapupCodeId, false) {
+		return "wrap-up code is not in wrapup_code_ids", nil
+	}
+	if !matchesIdCondition(conditions.languageIds, conversation.languageId, false) {
+		return "language is not in language_ids", nil
+	}
+	if !matchesIdCondition(conditions.directions, conversation.direction, true) {
+		return "direction is not in directions", nil
+	}
+	if !matchesIdCondition(conditions.mediaTypes, conversation.mediaType, true) {
+		return "media type is not in media_types", nil
+	}
+
+	if len(conditions.dateRanges) > 0 {
+		inRange, err := timeInDateRanges(conditions.dateRanges, conversation.time)
+		if err != nil {
+			return "", err
+		}
+		if !inRange {
+			return "time is outside date_ranges", nil
+		}
+	}
+
+	if conditions.timeAllowed != nil {
+		allowed, err := timeAllowedAt(conditions.timeAllowed, conversation.time)
+		if err != nil {
+			return "", err
+		}
+		if !allowed {
+			return "time is outside time_allowed", nil
+		}
+	}
+
+	if conditions.duration != nil && conditions.duration.DurationRange != nil && *conditions.duration.DurationRange != "" {
+		if conversation.duration == nil {
+			return "duration condition set but the conversation has no duration_seconds", nil
+		}
+		matches, err := durationMatches(conditions.duration, *conversation.duration)
+		if err != nil {
+			return "", err
+		}
+		if !matches {
+			return "duration does not meet the duration condition", nil
+		}
+	}
+
+	return "", nil
+}
+
+// matchesIdCondition returns true if the condition is empty or contains the value
+func matchesIdCondition(ids []string, value string, ignoreCase bool) bool {
+	if len(ids) == 0 {
+		return true
+	}
+	for _, id := range ids {
+		if id == value || (ignoreCase && strings.EqualFold(id, value)) {
+			return true
+		}
+	}
+	return false
+}
+
+// timeInDateRanges checks the time against ISO-8601 intervals in the form start/end
+func timeInDateRanges(dateRanges []string, t time.Time) (bool, error) {
+	for _, dateRange := range dateRanges {
+		bounds := strings.Split(dateRange, "/")
+		if len(bounds) != 2 {
+			return false, fmt.Errorf("date range %s must be in the format start/end", dateRange)
+		}
+		start, err := time.Parse(time.RFC3339, bounds[0])
+		if err != nil {
+			return false, fmt.Errorf("date range %s has an invalid start: %v", dateRange, err)
+		}
+		end, err := time.Parse(time.RFC3339, bounds[1])
+		if err != nil {
+			return false, fmt.Errorf("date range %s has an invalid end: %v", dateRange, err)
+		}
+		if !t.Before(start) && t.Before(end) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// timeAllowedAt checks whether the time falls into one of the weekly time slots, evaluated in the time zone of the condition
+func timeAllowedAt(timeAllowed *platformclientv2.Timeallowed, t time.Time) (bool, error) {
+	if timeAllowed.TimeSlots == nil || len(*timeAllowed.TimeSlots) == 0 {
+		return true, nil
+	}
+
+	location := time.UTC
+	if timeAllowed.TimeZoneId != nil && *timeAllowed.TimeZoneId != "" {
+		var err error
+		if location, err = time.LoadLocation(*timeAllowed.TimeZoneId); err != nil {
+			return false, fmt.Errorf("unknown time_zone_id %s: %v", *timeAllowed.TimeZoneId, err)
+		}
+	}
+	localTime := t.In(location)
+
+	// Time slots use Monday = 1 ... Sunday = 7
+	day := int(localTime.Weekday())
+	if day == 0 {
+		day = 7
+	}
+	timeOfDay := time.Duration(localTime.Hour())*time.Hour + time.Duration(localTime.Minute())*time.Minute +
+		time.Duration(localTime.Second())*time.Second + time.Duration(localTime.Nanosecond())
+
+	for _, slot := range *timeAllowed.TimeSlots {
+		if slot.Day == nil || *slot.Day != day {
+			continue
+		}
+		start, err := parseTimeSlotTime(slot.StartTime)
+		if err != nil {
+			return false, err
+		}
+		stop, err := parseTimeSlotTime(slot.StopTime)
+		if err != nil {
+			return false, err
+		}
+		if timeOfDay >= start && timeOfDay < stop {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// parseTimeSlotTime converts a time slot time in xx:xx:xx.xxx format to the duration since midnight
+func parseTimeSlotTime(slotTime *string) (time.Duration, error) {
+	if slotTime == nil {
+		return 0, fmt.Errorf("time slot is missing a start or stop time")
+	}
+	for _, layout := range []string{"15:04:05.000", "15:04:05", "15:04"} {
+		if parsed, err := time.Parse(layout, *slotTime); err == nil {
+			return parsed.Sub(time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC)), nil
+		}
+	}
+	return 0, fmt.Errorf("time slot time %s must be in xx:xx:xx.xxx format", *slotTime)
+}
+
+// durationMatches evaluates a duration condition. The range holds one bound for Over/Under and min-max for Between.
+// Bounds are seconds or ISO-8601 durations such as PT5M.
+func durationMatches(condition *platformclientv2.Durationcondition, duration time.Duration) (bool, error) {
+	mode := "Between"
+	if condition.DurationMode != nil && *condition.DurationMode != "" {
+		mode = *condition.DurationMode
+	}
+
+	bounds := strings.Split(*condition.DurationRange, "-")
+	parsedBounds := make([]time.Duration, 0)
+	for _, bound := range bounds {
+		parsed, err := parseDurationRangeBound(strings.TrimSpace(bound))
+		if err != nil {
+			return false, fmt.Errorf("duration_range %s: %v", *condition.DurationRange, err)
+		}
+		parsedBounds = append(parsedBounds, parsed)
+	}
+
+	switch mode {
+	case "Over":
+		return duration > parsedBounds[0], nil
+	case "Under":
+		return duration < parsedBounds[0], nil
+	case "Between":
+		if len(parsedBounds) != 2 {
+			return false, fmt.Errorf("duration_range %s must be in the format min-max for duration_mode Between", *condition.DurationRange)
+		}
+		return duration >= parsedBounds[0] && duration <= parsedBounds[1], nil
+	}
+	return false, fmt.Errorf("unsupported duration_mode %s", mode)
+}
+
+func parseDurationRangeBound(bound string) (time.Duration, error) {
+	if seconds, err := strconv.Atoi(bound); err == nil {
+		return time.Duration(seconds) * time.Second, nil
+	}
+	matches := durationRangeBoundRegex.FindStringSubmatch(bound)
+	if matches == nil || bound == "PT" {
+		return 0, fmt.Errorf("%s is neither a number of seconds nor an ISO-8601 duration", bound)
+	}
+	var duration time.Duration
+	for i, unit := range []time.Duration{time.Hour, time.Minute, time.Second} {
+		if matches[i+1] != "" {
+			value, _ := strconv.Atoi(matches[i+1])
+			duration += time.Duration(value) * unit
+		}
+	}
+	return duration, nil
+}
+
+// getRecordingOutcome combines the recording actions of all matched policies
+func getRecordingOutcome(actions []*platformclientv2.Policyactions) string {
+	retain, remove := false, false
+	for _, action := range actions {
+		if action == nil {
+			continue
+		}
+		if action.AlwaysDelete != nil && *action.AlwaysDelete {
+			return "delete"
+		}
+		if action.RetainRecording != nil && *action.RetainRecording {
+			retain = true
+		}
+		if action.DeleteRecording != nil && *action.DeleteRecording {
+			remove = true
+		}
+	}
+	if retain {
+		return "retain"
+	}
+	if remove {
+		return "delete"
+	}
+	return "none"
+}
+
+// getPolicyActionNames returns the schema names of the actions that are set
+func getPolicyActionNames(actions *platformclientv2.Policyactions) []interface{} {
+	names := make([]interface{}, 0)
+	if actions == nil {
+		return names
+	}
+
+	isTrue := func(b *bool) bool { return b != nil && *b }
+	if isTrue(actions.RetainRecording) {
+		names = append(names, "retain_recording")
+	}
+	if isTrue(actions.DeleteRecording) {
+		names = append(names, "delete_recording")
+	}
+	if isTrue(actions.AlwaysDelete) {
+		names = append(names, "always_delete")
+	}
+	if actions.AssignEvaluations != nil && len(*actions.AssignEvaluations) > 0 {
+		names = append(names, "assign_evaluations")
+	}
+	if actions.AssignMeteredEvaluations != nil && len(*actions.AssignMeteredEvaluations) > 0 {
+		names = append(names, "assign_metered_evaluations")
+	}
+	if actions.AssignMeteredAssignmentByAgent != nil && len(*actions.AssignMeteredAssignmentByAgent) > 0 {
+		names = append(names, "assign_metered_assignment_by_agent")
+	}
+	if actions.AssignCalibrations != nil && len(*actions.AssignCalibrations) > 0 {
+		names = append(names, "assign_calibrations")
+	}
+	if actions.AssignSurveys != nil && len(*actions.AssignSurveys) > 0 {
+		names = append(names, "assign_surveys")
+	}
+	if actions.RetentionDuration != nil {
+		names = append(names, "retention_duration")
+	}
+	if actions.InitiateScreenRecording != nil {
+		names = append(names, "initiate_screen_recording")
+	}
+	if actions.MediaTranscriptions != nil && len(*actions.MediaTranscriptions) > 0 {
+		names = append(names, "media_transcriptions")
+	}
+	if actions.IntegrationExport != nil {
+		names = append(names, "integration_export")
+	}
+	return names
+}
+
+func flattenRetentionPolicyResults(results []retentionPolicyResult) []interface{} {
+	resultList := make([]interface{}, 0)
+	for _, result := range results {
+		resultList = append(resultList, map[string]interface{}{
+			"name":       result.name,
+			"order":      result.order,
+			"matched":    result.matched,
+			"matched_by": result.matchedBy,
+			"reason":     result.reason,
+			"actions":    getPolicyActionNames(result.actions),
+		})
+	}
+	return resultList
+}
+
+// retentionSimulationId derives a stable ID from the inputs of the simulation
+func retentionSimulationId(d *schema.ResourceData) string {
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%v|%v", d.Get("policy"), d.Get("conversation"))))
+	return fmt.Sprintf("%x", hash[:8])
+}
+
+func userIdsOf(users *[]platformclientv2.User) []string {
+	ids := make([]string, 0)
+	if users != nil {
+		for _, user := range *users {
+			if user.Id != nil {
+				ids = append(ids, *user.Id)
+			}
+		}
+	}
+	return ids
+}
+
+func queueIdsOf(queues *[]platformclientv2.Queue) []string {
+	ids := make([]string, 0)
+	if queues != nil {
+		for _, queue := range *queues {
+			if queue.Id != nil {
+				ids = append(ids, *queue.Id)
+			}
+		}
+	}
+	return ids
+}
+
+func wrapupCodeIdsOf(wrapupCodes *[]platformclientv2.Wrapupcode) []string {
+	ids := make([]string, 0)
+	if wrapupCodes != nil {
+		for _, wrapupCode := range *wrapupCodes {
+			if wrapupCode.Id != nil {
+				ids = append(ids, *wrapupCode.Id)
+			}
+		}
+	}
+	return ids
+}
+
+func languageIdsOf(languages *[]platformclientv2.Language) []string {
+	ids := make([]string, 0)
+	if languages != nil {
+		for _, language := range *languages {
+			if language.Id != nil {
+				ids = append(ids, *language.Id)
+			}
+		}
+	}
+	return ids
+}
+
+func stringsOf(values *[]string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return *values
+}
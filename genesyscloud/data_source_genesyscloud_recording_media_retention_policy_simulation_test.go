@@ -0,0 +1,211 @@
+package genesyscloud
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestRecordingMediaRetentionPolicySimulation(t *testing.T) {
+	policies := []interface{}{
+		map[string]interface{}{
+			"name":    "delete-everything",
+			"order":   3,
+			"enabled": true,
+			"conditions": []interface{}{
+				map[string]interface{}{
+					"media_types": []interface{}{"CALL", "CHAT"},
+				},
+			},
+			"actions": []interface{}{
+				map[string]interface{}{
+					"delete_recording": true,
+				},
+			},
+		},
+		map[string]interface{}{
+			"name":    "retain-sales-calls",
+			"order":   1,
+			"enabled": true,
+			"media_policies": []interface{}{
+				map[string]interface{}{
+					"call_policy": []interface{}{
+						map[string]interface{}{
+							"conditions": []interface{}{
+								map[string]interface{}{
+									"for_queue_ids": []interface{}{"sales-queue"},
+									"directions":    []interface{}{"INBOUND"},
+									"date_ranges":   []interface{}{"2023-01-01T00:00:00.000Z/2024-01-01T00:00:00.000Z"},
+									"time_allowed": []interface{}{
+										map[string]interface{}{
+											"time_zone_id": "UTC",
+											"time_slots": []interface{}{
+												map[string]interface{}{"start_time": "09:00:00.000", "stop_time": "17:00:00.000", "day": 5},
+											},
+										},
+									},
+								},
+							},
+							"actions": []interface{}{
+								map[string]interface{}{
+									"retain_recording": true,
+									"retention_duration": []interface{}{
+										map[string]interface{}{
+											"archive_retention": []interface{}{map[string]interface{}{"days": 30, "storage_medium": "CLOUDARCHIVE"}},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		map[string]interface{}{
+			"name":    "disabled",
+			"order":   2,
+			"enabled": false,
+			"actions": []interface{}{
+				map[string]interface{}{
+					"always_delete": true,
+				},
+			},
+		},
+		map[string]interface{}{
+			"name":    "long-chats",
+			"order":   4,
+			"enabled": true,
+			"media_policies": []interface{}{
+				map[string]interface{}{
+					"chat_policy": []interface{}{
+						map[string]interface{}{
+							"conditions": []interface{}{
+								map[string]interface{}{
+									"duration": []interface{}{
+										map[string]interface{}{"duration_target": "DURATION", "duration_range": "PT5M", "duration_mode": "Over"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	simulate := func(conversation map[string]interface{}) *schema.ResourceData {
+		d := schema.TestResourceDataRaw(t, dataSourceRecordingMediaRetentionPolicySimulation().Schema, map[string]interface{}{
+			"policy":       policies,
+			"conversation": []interface{}{conversation},
+		})
+		if diagErr := dataSourceRecordingMediaRetentionPolicySimulationRead(context.Background(), d, nil); diagErr != nil {
+			t.Fatalf("simulation failed: %v", diagErr)
+		}
+		return d
+	}
+
+	// Friday 2023-05-12 10:00 UTC, inbound call on the sales queue
+	d := simulate(map[string]interface{}{
+		"media_type": "call",
+		"direction":  "inbound",
+		"queue_id":   "sales-queue",
+		"time":       "2023-05-12T10:00:00Z",
+	})
+	assertStringList(t, d.Get("matched_policy_names"), []string{"retain-sales-calls", "delete-everything"})
+	if outcome := d.Get("recording_outcome").(string); outcome != "retain" {
+		t.Errorf("expected recording outcome retain, got %s", outcome)
+	}
+	if matchedBy := d.Get("results.0.matched_by").(string); matchedBy != "media_policies.call_policy" {
+		t.Errorf("expected the first policy to match on the call policy, got %s", matchedBy)
+	}
+	assertStringList(t, d.Get("results.0.actions"), []string{"retain_recording", "retention_duration"})
+	if reason := d.Get("results.1.reason").(string); reason != "policy is disabled" {
+		t.Errorf("expected disabled policy to be reported, got %s", reason)
+	}
+
+	// Same call on a Saturday only hits the catch-all policy
+	d = simulate(map[string]interface{}{
+		"media_type": "CALL",
+		"direction":  "INBOUND",
+		"queue_id":   "sales-queue",
+		"time":       "2023-05-13T10:00:00Z",
+	})
+	assertStringList(t, d.Get("matched_policy_names"), []string{"delete-everything"})
+	if reason := d.Get("results.0.reason").(string); reason != "media_policies.call_policy: time is outside time_allowed" {
+		t.Errorf("unexpected reason %s", reason)
+	}
+	if outcome := d.Get("recording_outcome").(string); outcome != "delete" {
+		t.Errorf("expected recording outcome delete, got %s", outcome)
+	}
+
+	// Chat durations
+	d = simulate(map[string]interface{}{
+		"media_type":       "CHAT",
+		"time":             "2023-05-13T10:00:00Z",
+		"duration_seconds": 600,
+	})
+	assertStringList(t, d.Get("matched_policy_names"), []string{"delete-everything", "long-chats"})
+
+	d = simulate(map[string]interface{}{
+		"media_type":       "EMAIL",
+		"time":             "2023-05-13T10:00:00Z",
+		"duration_seconds": 60,
+	})
+	assertStringList(t, d.Get("matched_policy_names"), []string{})
+	if outcome := d.Get("recording_outcome").(string); outcome != "none" {
+		t.Errorf("expected recording outcome none, got %s", outcome)
+	}
+}
+
+func TestRetentionDurationCondition(t *testing.T) {
+	testCases := []struct {
+		durationRange string
+		mode          string
+		seconds       int
+		expected      bool
+	}{
+		{"30-120", "Between", 60, true},
+		{"30-120", "Between", 121, false},
+		{"PT1M-PT2M", "Between", 90, true},
+		{"PT1H", "Under", 3599, true},
+		{"45", "Over", 45, false},
+	}
+	for _, testCase := range testCases {
+		matches, err := durationMatches(buildDurationCondition([]interface{}{map[string]interface{}{
+			"duration_target":   "DURATION",
+			"duration_operator": "",
+			"duration_range":    testCase.durationRange,
+			"duration_mode":     testCase.mode,
+		}}), secondsToDuration(testCase.seconds))
+		if err != nil {
+			t.Errorf("%s %s: unexpected error %v", testCase.mode, testCase.durationRange, err)
+		} else if matches != testCase.expected {
+			t.Errorf("%s %s with %ds: expected %v", testCase.mode, testCase.durationRange, testCase.seconds, testCase.expected)
+		}
+	}
+
+	if _, err := parseDurationRangeBound("5 minutes"); err == nil {
+		t.Errorf("expected an error for an invalid duration bound")
+	}
+}
+
+func secondsToDuration(seconds int) time.Duration {
+	return time.Duration(seconds) * time.Second
+}
+
+func assertStringList(t *testing.T, value interface{}, expected []string) {
+	t.Helper()
+	list := value.([]interface{})
+	if len(list) != len(expected) {
+		t.Errorf("expected %v, got %v", expected, list)
+		return
+	}
+	for i := range expected {
+		if list[i].(string) != expected[i] {
+			t.Errorf("expected %v, got %v", expected, list)
+			return
+		}
+	}
+}
@@ -0,0 +1,470 @@
+package genesyscloud
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"log"
+	"time"
+
+	lists "terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mypurecloud/platform-client-sdk-go/v109/platformclientv2"
+)
+
+const (
+	queueSimulationSourceBullseyeRing = "BULLSEYE_RING"
+	queueSimulationSourceDefaultRing  = "DEFAULT_RING"
+	queueSimulationSourceRule         = "CONDITIONAL_GROUP_ROUTING_RULE"
+)
+
+var (
+	simulatedQueueMemberGroup = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"member_group_id": {
+				Description: "ID of the group, skill group or team.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"member_group_type": {
+				Description: "Type of the member group (TEAM | GROUP | SKILLGROUP).",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+
+	simulatedQueueStep = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"start_seconds": {
+				Description: "Seconds into the wait at which the step starts.",
+				Type:        schema.TypeFloat,
+				Computed:    true,
+			},
+			"end_seconds": {
+				Description: "Seconds into the wait at which the step ends. -1 when the step lasts until the conversation is answered.",
+				Type:        schema.TypeFloat,
+				Computed:    true,
+			},
+			"source": {
+				Description: "What drives the step (BULLSEYE_RING | DEFAULT_RING | CONDITIONAL_GROUP_ROUTING_RULE). The default ring follows the last bullseye ring and is the only step of a queue without bullseye rings or conditional group routing rules.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"index": {
+				Description: "Number of the bullseye ring or conditional group routing rule, starting at 1.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"condition_met": {
+				Description: "Whether the conditional group routing rule's condition holds. Always true for bullseye rings.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"all_members": {
+				Description: "Whether every member of the queue is eligible, in addition to the member groups.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"member_groups": {
+				Description: "Member groups that are eligible during the step.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        simulatedQueueMemberGroup,
+			},
+			"skill_ids": {
+				Description: "IDs of the required skills that still apply during the step.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"removed_skill_ids": {
+				Description: "IDs of the skills removed by the bullseye rings before the step.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+)
+
+// queueSimulationStep is one stage of a conversation's wait in a queue
+type queueSimulationStep struct {
+	start           float64
+	end             float64
+	source          string
+	index           int
+	conditionMet    bool
+	allMembers      bool
+	memberGroups    []map[string]interface{}
+	skillIds        []string
+	removedSkillIds []string
+}
+
+// queueSimulationInput holds the routing settings and conversation a queue simulation is run with
+type queueSimulationInput struct {
+	queueId                   string
+	bullseyeRings             []interface{}
+	conditionalRules          []interface{}
+	skillIds                  []string
+	estimatedWaitSeconds      float64
+	queueEstimatedWaitSeconds map[string]float64
+	waitSeconds               float64
+}
+
+func dataSourceRoutingQueueSimulation() *schema.Resource {
+	queueSchema := ResourceRoutingQueue().Schema
+	return &schema.Resource{
+		Description: `Simulates locally how a conversation's routing expands while it waits in a queue, following the queue's bullseye rings or conditional group routing rules. The result is a timeline of which member groups and skills are eligible at each step.
+
+Conditional group routing rules take precedence over bullseye rings, as in Genesys Cloud. Estimated wait times do not change during the simulation, so a rule whose condition does not hold stops the expansion.`,
+		ReadContext: ReadWithPooledClient(dataSourceRoutingQueueSimulationRead),
+		Schema: map[string]*schema.Schema{
+			"queue_id": {
+				Description:   "ID of a queue to read the routing settings from. Conflicts with `bullseye_rings` and `conditional_group_routing_rules`.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"bullseye_rings", "conditional_group_routing_rules"},
+			},
+			"bullseye_rings": {
+				Description: "Bullseye rings to simulate. Same as `bullseye_rings` on `genesyscloud_routing_queue`.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    5,
+				Elem:        queueSchema["bullseye_rings"].Elem,
+			},
+			"conditional_group_routing_rules": {
+				Description: "Conditional group routing rules to simulate. Same as `conditional_group_routing_rules` on `genesyscloud_routing_queue`.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    5,
+				Elem:        queueSchema["conditional_group_routing_rules"].Elem,
+			},
+			"skill_ids": {
+				Description: "IDs of the skills the conversation requires when it enters the queue.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"wait_seconds": {
+				Description:  "How long the conversation has been waiting. The timeline ends with the step active at this time.",
+				Type:         schema.TypeFloat,
+				Required:     true,
+				ValidateFunc: validation.FloatBetween(0, 259200),
+			},
+			"estimated_wait_seconds": {
+				Description:  "Estimated wait time of the queue, compared against the conditions of conditional group routing rules.",
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.FloatAtLeast(0),
+			},
+			"queue_estimated_wait_seconds": {
+				Description: "Estimated wait times of the other queues referenced by conditional group routing rules, by queue ID. Queues that are not listed use `estimated_wait_seconds`.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeFloat},
+			},
+			"timeline": {
+				Description: "Steps of the conversation's wait, up to the step active at `wait_seconds`.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        simulatedQueueStep,
+			},
+			"eligible_member_groups": {
+				Description: "Member groups that are eligible at `wait_seconds`.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        simulatedQueueMemberGroup,
+			},
+			"eligible_skill_ids": {
+				Description: "IDs of the required skills that still apply at `wait_seconds`.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"all_members_eligible": {
+				Description: "Whether every member of the queue is eligible at `wait_seconds`.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceRoutingQueueSimulationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	input := &queueSimulationInput{
+		queueId:                   d.Get("queue_id").(string),
+		bullseyeRings:             d.Get("bullseye_rings").([]interface{}),
+		conditionalRules:          d.Get("conditional_group_routing_rules").([]interface{}),
+		skillIds:                  lists.InterfaceListToStrings(d.Get("skill_ids").([]interface{})),
+		estimatedWaitSeconds:      d.Get("estimated_wait_seconds").(float64),
+		queueEstimatedWaitSeconds: make(map[string]float64),
+		waitSeconds:               d.Get("wait_seconds").(float64),
+	}
+	for queueId, seconds := range d.Get("queue_estimated_wait_seconds").(map[string]interface{}) {
+		input.queueEstimatedWaitSeconds[queueId] = seconds.(float64)
+	}
+
+	if input.queueId != "" {
+		sdkConfig := meta.(*ProviderMeta).ClientConfig
+		routingAPI := platformclientv2.NewRoutingApiWithConfig(sdkConfig)
+
+		diagErr := WithRetries(ctx, 15*time.Second, func() *retry.RetryError {
+			queue, resp, getErr := routingAPI.GetRoutingQueue(input.queueId)
+			if getErr != nil {
+				if IsStatus404(resp) {
+					return retry.RetryableError(fmt.Errorf("Failed to find queue %s: %s", input.queueId, getErr))
+				}
+				return retry.NonRetryableError(fmt.Errorf("Failed to read queue %s: %s", input.queueId, getErr))
+			}
+			if queue.Bullseye != nil && queue.Bullseye.Rings != nil && len(*queue.Bullseye.Rings) > 0 {
+				input.bullseyeRings = flattenBullseyeRings(*queue.Bullseye.Rings)
+			}
+			input.conditionalRules = flattenConditionalGroupRoutingRules(queue)
+			return nil
+		})
+		if diagErr != nil {
+			return diagErr
+		}
+	}
+
+	log.Printf("Simulating a wait of %v seconds in queue %s", input.waitSeconds, input.queueId)
+	timeline := simulateQueueRouting(input)
+	if len(timeline) == 0 {
+		// Only happens when none of the conditional group routing rules can be read
+		return diag.Errorf("Failed to simulate queue %s: none of its conditional group routing rules could be read", input.queueId)
+	}
+	current := timeline[len(timeline)-1]
+
+	d.SetId(queueSimulationId(d))
+	d.Set("timeline", flattenQueueSimulationSteps(timeline))
+	d.Set("eligible_member_groups", flattenSimulatedMemberGroups(current.memberGroups))
+	d.Set("eligible_skill_ids", current.skillIds)
+	d.Set("all_members_eligible", current.allMembers)
+	return nil
+}
+
+// simulateQueueRouting returns the steps of a conversation's wait up to and including the step active at the wait time.
+// The timeline is empty if none of the conditional group routing rules can be read.
+func simulateQueueRouting(input *queueSimulationInput) []queueSimulationStep {
+	if len(input.conditionalRules) > 0 {
+		return simulateConditionalGroupRouting(input)
+	}
+	return simulateBullseyeRouting(input)
+}
+
+// simulateBullseyeRouting expands the conversation ring by ring. Each ring adds its member groups to those of the
+// earlier rings and removes its skills from the conversation when it ends. The default ring after the last ring
+// includes every member of the queue.
+func simulateBullseyeRouting(input *queueSimulationInput) []queueSimulationStep {
+	var timeline []queueSimulationStep
+	var memberGroups []map[string]interface{}
+	removedSkillIds := make([]string, 0)
+	start := 0.0
+
+	for i, ring := range input.bullseyeRings {
+		ringSettings, ok := ring.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		memberGroups = addSimulatedMemberGroups(memberGroups, ringSettings["member_groups"])
+		timeout, _ := ringSettings["expansion_timeout_seconds"].(float64)
+
+		timeline = append(timeline, queueSimulationStep{
+			start:           start,
+			end:             start + timeout,
+			source:          queueSimulationSourceBullseyeRing,
+			index:           i + 1,
+			conditionMet:    true,
+			memberGroups:    memberGroups,
+			skillIds:        remainingSkillIds(input.skillIds, removedSkillIds),
+			removedSkillIds: removedSkillIds,
+		})
+		start += timeout
+		if start > input.waitSeconds {
+			return timeline
+		}
+
+		if skillsToRemove, ok := ringSettings["skills_to_remove"].(*schema.Set); ok {
+			removedSkillIds = appendMissing(removedSkillIds, lists.InterfaceListToStrings(skillsToRemove.List()))
+		}
+	}
+
+	return append(timeline, queueSimulationStep{
+		start:           start,
+		end:             -1,
+		source:          queueSimulationSourceDefaultRing,
+		index:           len(input.bullseyeRings) + 1,
+		conditionMet:    true,
+		allMembers:      true,
+		memberGroups:    memberGroups,
+		skillIds:        remainingSkillIds(input.skillIds, removedSkillIds),
+		removedSkillIds: removedSkillIds,
+	})
+}
+
+// simulateConditionalGroupRouting evaluates the rules in order. A rule whose condition holds adds its groups and the next
+// rule is evaluated after its wait time. The first rule always applies to the simulated queue.
+func simulateConditionalGroupRouting(input *queueSimulationInput) []queueSimulationStep {
+	var timeline []queueSimulationStep
+	var memberGroups []map[string]interface{}
+	start := 0.0
+
+	for i, rule := range input.conditionalRules {
+		ruleSettings, ok := rule.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		estimatedWait := input.estimatedWaitSeconds
+		if queueId, _ := ruleSettings["queue_id"].(string); i > 0 && queueId != "" && queueId != input.queueId {
+			if queueWait, ok := input.queueEstimatedWaitSeconds[queueId]; ok {
+				estimatedWait = queueWait
+			}
+		}
+		operator, _ := ruleSettings["operator"].(string)
+		conditionValue, _ := ruleSettings["condition_value"].(float64)
+		conditionMet := compareQueueMetric(operator, estimatedWait, conditionValue)
+		if conditionMet {
+			memberGroups = addSimulatedMemberGroups(memberGroups, ruleSettings["groups"])
+		}
+
+		step := queueSimulationStep{
+			start:           start,
+			end:             -1,
+			source:          queueSimulationSourceRule,
+			index:           i + 1,
+			conditionMet:    conditionMet,
+			memberGroups:    memberGroups,
+			skillIds:        remainingSkillIds(input.skillIds, nil),
+			removedSkillIds: make([]string, 0),
+		}
+		// The wait time of the last rule is ignored and a rule that never holds is evaluated until the conversation is answered
+		if !conditionMet || i == len(input.conditionalRules)-1 {
+			return append(timeline, step)
+		}
+
+		waitSeconds, _ := ruleSettings["wait_seconds"].(int)
+		step.end = start + float64(waitSeconds)
+		timeline = append(timeline, step)
+		start = step.end
+		if start > input.waitSeconds {
+			return timeline
+		}
+	}
+	return timeline
+}
+
+func compareQueueMetric(operator string, actual float64, conditionValue float64) bool {
+	switch operator {
+	case "GreaterThan":
+		return actual > conditionValue
+	case "GreaterThanOrEqualTo":
+		return actual >= conditionValue
+	case "LessThan":
+		return actual < conditionValue
+	case "LessThanOrEqualTo":
+		return actual <= conditionValue
+	}
+	return false
+}
+
+// addSimulatedMemberGroups returns a new list with the groups of a ring or rule added to the groups already eligible
+func addSimulatedMemberGroups(memberGroups []map[string]interface{}, groups interface{}) []map[string]interface{} {
+	var groupList []interface{}
+	switch g := groups.(type) {
+	case *schema.Set:
+		groupList = g.List()
+	case []interface{}:
+		groupList = g
+	}
+
+	added := make([]map[string]interface{}, len(memberGroups), len(memberGroups)+len(groupList))
+	copy(added, memberGroups)
+	for _, group := range groupList {
+		groupMap, ok := group.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		found := false
+		for _, existing := range added {
+			if existing["member_group_id"] == groupMap["member_group_id"] && existing["member_group_type"] == groupMap["member_group_type"] {
+				found = true
+				break
+			}
+		}
+		if !found {
+			added = append(added, map[string]interface{}{
+				"member_group_id":   groupMap["member_group_id"],
+				"member_group_type": groupMap["member_group_type"],
+			})
+		}
+	}
+	return added
+}
+
+func remainingSkillIds(skillIds []string, removedSkillIds []string) []string {
+	remaining := make([]string, 0)
+	for _, skillId := range skillIds {
+		if !lists.ItemInSlice(skillId, removedSkillIds) {
+			remaining = append(remaining, skillId)
+		}
+	}
+	return remaining
+}
+
+// appendMissing returns a new list with the values that are not in the list yet added to it
+func appendMissing(values []string, newValues []string) []string {
+	result := append([]string{}, values...)
+	for _, value := range newValues {
+		if !lists.ItemInSlice(value, result) {
+			result = append(result, value)
+		}
+	}
+	return result
+}
+
+func flattenQueueSimulationSteps(steps []queueSimulationStep) []interface{} {
+	stepList := make([]interface{}, 0)
+	for _, step := range steps {
+		stepList = append(stepList, map[string]interface{}{
+			"start_seconds":     step.start,
+			"end_seconds":       step.end,
+			"source":            step.source,
+			"index":             step.index,
+			"condition_met":     step.conditionMet,
+			"all_members":       step.allMembers,
+			"member_groups":     flattenSimulatedMemberGroups(step.memberGroups),
+			"skill_ids":         step.skillIds,
+			"removed_skill_ids": step.removedSkillIds,
+		})
+	}
+	return stepList
+}
+
+func flattenSimulatedMemberGroups(memberGroups []map[string]interface{}) []interface{} {
+	groupList := make([]interface{}, 0)
+	for _, group := range memberGroups {
+		groupList = append(groupList, group)
+	}
+	return groupList
+}
+
+// queueSimulationId derives a stable ID from the inputs of the simulation
+func queueSimulationId(d *schema.ResourceData) string {
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%v|%v|%v|%v|%v|%v|%v",
+		d.Get("queue_id"),
+		setsToLists(d.Get("bullseye_rings")),
+		d.Get("conditional_group_routing_rules"),
+		d.Get("skill_ids"),
+		d.Get("wait_seconds"),
+		d.Get("estimated_wait_seconds"),
+		d.Get("queue_estimated_wait_seconds"),
+	)))
+	return fmt.Sprintf("%x", hash[:8])
+}
@@ -0,0 +1,169 @@
+package genesyscloud
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestRoutingQueueSimulationBullseye(t *testing.T) {
+	rings := []interface{}{
+		map[string]interface{}{
+			"expansion_timeout_seconds": 15.0,
+			"skills_to_remove":          []interface{}{"skill-spanish"},
+			"member_groups": []interface{}{
+				map[string]interface{}{"member_group_id": "group-tier1", "member_group_type": "GROUP"},
+			},
+		},
+		map[string]interface{}{
+			"expansion_timeout_seconds": 30.0,
+			"skills_to_remove":          []interface{}{"skill-billing"},
+			"member_groups": []interface{}{
+				map[string]interface{}{"member_group_id": "team-tier2", "member_group_type": "TEAM"},
+			},
+		},
+	}
+
+	simulate := func(waitSeconds float64) *schema.ResourceData {
+		d := schema.TestResourceDataRaw(t, dataSourceRoutingQueueSimulation().Schema, map[string]interface{}{
+			"bullseye_rings": rings,
+			"skill_ids":      []interface{}{"skill-spanish", "skill-billing"},
+			"wait_seconds":   waitSeconds,
+		})
+		if diagErr := dataSourceRoutingQueueSimulationRead(context.Background(), d, nil); diagErr != nil {
+			t.Fatalf("simulation failed: %v", diagErr)
+		}
+		return d
+	}
+
+	d := simulate(10)
+	if steps := d.Get("timeline.#").(int); steps != 1 {
+		t.Fatalf("expected 1 step at 10 seconds, got %d", steps)
+	}
+	assertStringList(t, d.Get("eligible_skill_ids"), []string{"skill-spanish", "skill-billing"})
+	if groupId := d.Get("eligible_member_groups.0.member_group_id").(string); groupId != "group-tier1" {
+		t.Errorf("expected ring 1 group to be eligible, got %s", groupId)
+	}
+
+	// Second 45 is the first second of the default ring
+	d = simulate(45)
+	if steps := d.Get("timeline.#").(int); steps != 3 {
+		t.Fatalf("expected 3 steps at 45 seconds, got %d", steps)
+	}
+	if start, end := d.Get("timeline.1.start_seconds").(float64), d.Get("timeline.1.end_seconds").(float64); start != 15 || end != 45 {
+		t.Errorf("expected ring 2 from 15 to 45 seconds, got %v to %v", start, end)
+	}
+	assertStringList(t, d.Get("timeline.1.skill_ids"), []string{"skill-billing"})
+	if source := d.Get("timeline.2.source").(string); source != queueSimulationSourceDefaultRing {
+		t.Errorf("expected the default ring, got %s", source)
+	}
+	if end := d.Get("timeline.2.end_seconds").(float64); end != -1 {
+		t.Errorf("expected the default ring to be open ended, got %v", end)
+	}
+	if !d.Get("all_members_eligible").(bool) {
+		t.Errorf("expected all members to be eligible in the default ring")
+	}
+	assertStringList(t, d.Get("eligible_skill_ids"), []string{})
+	assertStringList(t, d.Get("timeline.2.removed_skill_ids"), []string{"skill-spanish", "skill-billing"})
+	if groups := d.Get("eligible_member_groups.#").(int); groups != 2 {
+		t.Errorf("expected the groups of both rings to be eligible, got %d", groups)
+	}
+
+	// A queue without rings only has the default ring
+	d = schema.TestResourceDataRaw(t, dataSourceRoutingQueueSimulation().Schema, map[string]interface{}{
+		"wait_seconds": 5.0,
+	})
+	if diagErr := dataSourceRoutingQueueSimulationRead(context.Background(), d, nil); diagErr != nil {
+		t.Fatalf("simulation failed: %v", diagErr)
+	}
+	if source := d.Get("timeline.0.source").(string); d.Get("timeline.#").(int) != 1 || source != queueSimulationSourceDefaultRing {
+		t.Errorf("expected a single default ring step, got %s", source)
+	}
+}
+
+func TestRoutingQueueSimulationConditionalGroupRouting(t *testing.T) {
+	rules := []interface{}{
+		map[string]interface{}{
+			"operator":        "GreaterThan",
+			"condition_value": 0.0,
+			"wait_seconds":    10,
+			"groups": []interface{}{
+				map[string]interface{}{"member_group_id": "skillgroup-1", "member_group_type": "SKILLGROUP"},
+			},
+		},
+		map[string]interface{}{
+			"queue_id":        "overflow-queue",
+			"operator":        "LessThan",
+			"condition_value": 60.0,
+			"wait_seconds":    20,
+			"groups": []interface{}{
+				map[string]interface{}{"member_group_id": "group-overflow", "member_group_type": "GROUP"},
+			},
+		},
+		map[string]interface{}{
+			"operator":        "GreaterThanOrEqualTo",
+			"condition_value": 30.0,
+			"groups": []interface{}{
+				map[string]interface{}{"member_group_id": "group-all", "member_group_type": "GROUP"},
+			},
+		},
+	}
+
+	simulate := func(waitSeconds float64, overflowWait float64) *schema.ResourceData {
+		d := schema.TestResourceDataRaw(t, dataSourceRoutingQueueSimulation().Schema, map[string]interface{}{
+			"conditional_group_routing_rules": rules,
+			"wait_seconds":                    waitSeconds,
+			"estimated_wait_seconds":          45.0,
+			"queue_estimated_wait_seconds":    map[string]interface{}{"overflow-queue": overflowWait},
+		})
+		if diagErr := dataSourceRoutingQueueSimulationRead(context.Background(), d, nil); diagErr != nil {
+			t.Fatalf("simulation failed: %v", diagErr)
+		}
+		return d
+	}
+
+	d := simulate(45, 20)
+	if steps := d.Get("timeline.#").(int); steps != 3 {
+		t.Fatalf("expected 3 steps, got %d", steps)
+	}
+	if start := d.Get("timeline.2.start_seconds").(float64); start != 30 {
+		t.Errorf("expected rule 3 to be evaluated at 30 seconds, got %v", start)
+	}
+	if groups := d.Get("eligible_member_groups.#").(int); groups != 3 {
+		t.Errorf("expected the groups of all rules to be eligible, got %d", groups)
+	}
+	if d.Get("all_members_eligible").(bool) {
+		t.Errorf("conditional group routing never makes all members eligible")
+	}
+
+	// The overflow queue is too busy, so routing stays with the first rule's group
+	d = simulate(45, 90)
+	if steps := d.Get("timeline.#").(int); steps != 2 {
+		t.Fatalf("expected 2 steps, got %d", steps)
+	}
+	if d.Get("timeline.1.condition_met").(bool) {
+		t.Errorf("expected rule 2 not to hold")
+	}
+	if end := d.Get("timeline.1.end_seconds").(float64); end != -1 {
+		t.Errorf("expected rule 2 to be evaluated until the conversation is answered, got %v", end)
+	}
+	if groups := d.Get("eligible_member_groups.#").(int); groups != 1 {
+		t.Errorf("expected only the first rule's group to be eligible, got %d", groups)
+	}
+
+	// Conditional group routing takes precedence over bullseye rings
+	timeline := simulateQueueRouting(&queueSimulationInput{
+		bullseyeRings:    []interface{}{map[string]interface{}{"expansion_timeout_seconds": 5.0}},
+		conditionalRules: rules[:1],
+		waitSeconds:      60,
+	})
+	if len(timeline) != 1 || timeline[0].source != queueSimulationSourceRule || timeline[0].conditionMet {
+		t.Errorf("expected a single rule step that does not hold, got %+v", timeline)
+	}
+
+	// Rules that cannot be read leave the timeline empty
+	if timeline := simulateQueueRouting(&queueSimulationInput{conditionalRules: []interface{}{nil}, waitSeconds: 60}); len(timeline) != 0 {
+		t.Errorf("expected an empty timeline, got %+v", timeline)
+	}
+}
@@ -0,0 +1,241 @@
+package genesyscloud
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	lists "terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mypurecloud/platform-client-sdk-go/v109/platformclientv2"
+)
+
+func dataSourceDidInventory() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for the inventory of Genesys Cloud DID pools. Lists the free and used numbers of each pool.",
+		ReadContext: ReadWithPooledClient(dataSourceDidInventoryRead),
+		Schema: map[string]*schema.Schema{
+			"did_pool_ids": {
+				Description: "IDs of the DID pools. If not set, all DID pools are listed.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"free_numbers_limit": {
+				Description:  "Maximum number of free numbers listed for each pool. Free numbers are listed in ascending order.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      100,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"pools": {
+				Description: "Inventory of each DID pool, ordered by the start phone number of the pool.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"did_pool_id": {
+							Description: "ID of the DID pool.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"start_phone_number": {
+							Description: "Starting phone number of the DID pool.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"end_phone_number": {
+							Description: "Ending phone number of the DID pool.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"total_count": {
+							Description: "Number of phone numbers in the DID pool.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"used_count": {
+							Description: "Number of phone numbers in the DID pool that are assigned.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"free_count": {
+							Description: "Number of phone numbers in the DID pool that are not assigned.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"free_numbers": {
+							Description: "Phone numbers in the DID pool that are not assigned, up to `free_numbers_limit`.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"used_numbers": {
+							Description: "Phone numbers in the DID pool that are assigned.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"phone_number": {
+										Description: "The assigned phone number.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"owner_type": {
+										Description: "Type of the owner of the phone number.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"owner_id": {
+										Description: "ID of the owner of the phone number.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDidInventoryRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sdkConfig := m.(*ProviderMeta).ClientConfig
+	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
+
+	didPoolIds := lists.InterfaceListToStrings(d.Get("did_pool_ids").([]interface{}))
+	freeNumbersLimit := d.Get("free_numbers_limit").(int)
+
+	pools := make([]interface{}, 0)
+	diagErr := WithRetries(ctx, 15*time.Second, func() *retry.RetryError {
+		didPools, err := getDidPools(didPoolIds, edgesAPI)
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+		if len(didPoolIds) > 0 && len(didPools) < len(didPoolIds) {
+			return retry.RetryableError(fmt.Errorf("found %d of %d DID pools", len(didPools), len(didPoolIds)))
+		}
+		sort.SliceStable(didPools, func(i, j int) bool {
+			return compareDigits(getDidPoolStart(didPools[i]), getDidPoolStart(didPools[j])) < 0
+		})
+
+		pools = make([]interface{}, 0)
+		for _, didPool := range didPools {
+			dids, err := getDidPoolDids(*didPool.Id, edgesAPI)
+			if err != nil {
+				return retry.NonRetryableError(err)
+			}
+			pool, err := flattenDidPoolInventory(didPool, dids, freeNumbersLimit)
+			if err != nil {
+				return retry.NonRetryableError(err)
+			}
+			pools = append(pools, pool)
+		}
+		return nil
+	})
+	if diagErr != nil {
+		return diagErr
+	}
+
+	d.Set("pools", pools)
+	d.SetId(fmt.Sprintf("%v", didPoolIds))
+	return nil
+}
+
+// getDidPoolDids returns the assigned DIDs of a pool
+func getDidPoolDids(didPoolId string, edgesAPI *platformclientv2.TelephonyProvidersEdgeApi) ([]platformclientv2.Did, error) {
+	var dids []platformclientv2.Did
+	const pageSize = 100
+	for pageNum := 1; ; pageNum++ {
+		didPage, _, getErr := edgesAPI.GetTelephonyProvidersEdgesDids(pageSize, pageNum, "number", "ASC", "", "", didPoolId, nil)
+		if getErr != nil {
+			return nil, fmt.Errorf("Failed to get DIDs of DID pool %s: %v", didPoolId, getErr)
+		}
+		if didPage.Entities == nil || len(*didPage.Entities) == 0 {
+			break
+		}
+		dids = append(dids, *didPage.Entities...)
+		if didPage.PageCount == nil || pageNum >= *didPage.PageCount {
+			break
+		}
+	}
+	return dids, nil
+}
+
+// flattenDidPoolInventory counts the used and free numbers of a DID pool. Only DIDs within the range of the pool are
+// counted as used.
+func flattenDidPoolInventory(didPool platformclientv2.Didpool, dids []platformclientv2.Did, freeNumbersLimit int) (map[string]interface{}, error) {
+	start, digitCount, err := parseDidNumber(getDidPoolStart(didPool))
+	if err != nil {
+		return nil, fmt.Errorf("DID pool %s has an invalid start phone number: %v", *didPool.Id, err)
+	}
+	end, _, err := parseDidNumber(getDidPoolEnd(didPool))
+	if err != nil {
+		return nil, fmt.Errorf("DID pool %s has an invalid end phone number: %v", *didPool.Id, err)
+	}
+
+	usedValues := make(map[int64]bool)
+	usedNumbers := make([]interface{}, 0)
+	for _, did := range dids {
+		if did.PhoneNumber == nil || !didPoolContains(didPool, *did.PhoneNumber) {
+			continue
+		}
+		value, _, err := parseDidNumber(*did.PhoneNumber)
+		if err != nil || usedValues[value] {
+			continue
+		}
+		usedValues[value] = true
+
+		usedNumber := map[string]interface{}{
+			"phone_number": *did.PhoneNumber,
+			"owner_type":   getDidOwnerType(did),
+		}
+		if did.Owner != nil && did.Owner.Id != nil {
+			usedNumber["owner_id"] = *did.Owner.Id
+		}
+		usedNumbers = append(usedNumbers, usedNumber)
+	}
+	sort.SliceStable(usedNumbers, func(i, j int) bool {
+		return usedNumbers[i].(map[string]interface{})["phone_number"].(string) < usedNumbers[j].(map[string]interface{})["phone_number"].(string)
+	})
+
+	totalCount := int(end - start + 1)
+	freeNumbers := make([]interface{}, 0)
+	for value := start; value <= end && len(freeNumbers) < freeNumbersLimit; value++ {
+		if !usedValues[value] {
+			freeNumbers = append(freeNumbers, formatDidNumber(value, digitCount))
+		}
+	}
+
+	return map[string]interface{}{
+		"did_pool_id":        *didPool.Id,
+		"start_phone_number": getDidPoolStart(didPool),
+		"end_phone_number":   getDidPoolEnd(didPool),
+		"total_count":        totalCount,
+		"used_count":         len(usedNumbers),
+		"free_count":         totalCount - len(usedNumbers),
+		"free_numbers":       freeNumbers,
+		"used_numbers":       usedNumbers,
+	}, nil
+}
+
+func getDidPoolStart(didPool platformclientv2.Didpool) string {
+	if didPool.StartPhoneNumber == nil {
+		return ""
+	}
+	return *didPool.StartPhoneNumber
+}
+
+func getDidPoolEnd(didPool platformclientv2.Didpool) string {
+	if didPool.EndPhoneNumber == nil {
+		return ""
+	}
+	return *didPool.EndPhoneNumber
+}
@@ -0,0 +1,112 @@
+package genesyscloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/mypurecloud/platform-client-sdk-go/v109/platformclientv2"
+)
+
+func TestAccDataSourceDidInventory(t *testing.T) {
+	var (
+		didInventoryRes = "did-inventory"
+		didPoolRes      = "did-pool"
+		ivrRes          = "ivr"
+		startNumber     = "+14175540030"
+		endNumber       = "+14175540032"
+		phoneNumber     = "+14175540031"
+	)
+	if _, err := AuthorizeSdk(); err != nil {
+		t.Fatal(err)
+	}
+	if err := deleteDidPoolWithNumber(startNumber); err != nil {
+		t.Fatalf("error deleting did pool start number: %v", err)
+	}
+
+	config := generateDidPoolResource(&didPoolStruct{
+		didPoolRes,
+		startNumber,
+		endNumber,
+		nullValue, // No description
+		nullValue, // No comments
+		nullValue, // No provider
+	}) + generateIvrConfigResource(&ivrConfigStruct{
+		resourceID: ivrRes,
+		name:       "tf test ivr " + uuid.NewString(),
+		depends_on: "genesyscloud_telephony_providers_edges_did_pool." + didPoolRes,
+	}) + generateDidAssignmentResource(
+		"did-assignment",
+		phoneNumber,
+		didOwnerTypeIvr,
+		"genesyscloud_architect_ivr."+ivrRes+".id",
+		"genesyscloud_telephony_providers_edges_did_pool."+didPoolRes+".id",
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				Config: config + generateDidInventoryDataSource(
+					didInventoryRes,
+					"genesyscloud_telephony_providers_edges_did_pool."+didPoolRes+".id",
+					"genesyscloud_telephony_did_assignment.did-assignment",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.genesyscloud_telephony_did_inventory."+didInventoryRes, "pools.#", "1"),
+					resource.TestCheckResourceAttr("data.genesyscloud_telephony_did_inventory."+didInventoryRes, "pools.0.total_count", "3"),
+					resource.TestCheckResourceAttr("data.genesyscloud_telephony_did_inventory."+didInventoryRes, "pools.0.used_count", "1"),
+					resource.TestCheckResourceAttr("data.genesyscloud_telephony_did_inventory."+didInventoryRes, "pools.0.free_count", "2"),
+					resource.TestCheckResourceAttr("data.genesyscloud_telephony_did_inventory."+didInventoryRes, "pools.0.free_numbers.0", startNumber),
+					resource.TestCheckResourceAttr("data.genesyscloud_telephony_did_inventory."+didInventoryRes, "pools.0.free_numbers.1", endNumber),
+					resource.TestCheckResourceAttr("data.genesyscloud_telephony_did_inventory."+didInventoryRes, "pools.0.used_numbers.0.phone_number", phoneNumber),
+					resource.TestCheckResourceAttrPair("data.genesyscloud_telephony_did_inventory."+didInventoryRes, "pools.0.used_numbers.0.owner_id", "genesyscloud_architect_ivr."+ivrRes, "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestDidPoolInventory(t *testing.T) {
+	didPool := platformclientv2.Didpool{
+		Id:               platformclientv2.String("pool-1"),
+		StartPhoneNumber: platformclientv2.String("+13175550098"),
+		EndPhoneNumber:   platformclientv2.String("+13175550103"),
+	}
+	dids := []platformclientv2.Did{
+		{PhoneNumber: platformclientv2.String("+13175550100"), OwnerType: platformclientv2.String("USER"), Owner: &platformclientv2.Domainentityref{Id: platformclientv2.String("user-1")}},
+		{PhoneNumber: platformclientv2.String("+13175550099"), OwnerType: platformclientv2.String("IVR_CONFIG"), Owner: &platformclientv2.Domainentityref{Id: platformclientv2.String("ivr-1")}},
+		// Outside of the pool
+		{PhoneNumber: platformclientv2.String("+13175550200"), OwnerType: platformclientv2.String("USER")},
+	}
+
+	inventory, err := flattenDidPoolInventory(didPool, dids, 3)
+	if err != nil {
+		t.Fatalf("failed to flatten inventory: %v", err)
+	}
+	if inventory["total_count"] != 6 || inventory["used_count"] != 2 || inventory["free_count"] != 4 {
+		t.Errorf("unexpected counts %v", inventory)
+	}
+	// Free numbers keep the leading zeros of the pool and are limited
+	assertStringList(t, inventory["free_numbers"], []string{"+13175550098", "+13175550101", "+13175550102"})
+
+	usedNumbers := inventory["used_numbers"].([]interface{})
+	if len(usedNumbers) != 2 || usedNumbers[0].(map[string]interface{})["owner_id"] != "ivr-1" {
+		t.Errorf("unexpected used numbers %v", usedNumbers)
+	}
+
+	didPool.EndPhoneNumber = platformclientv2.String("invalid")
+	if _, err := flattenDidPoolInventory(didPool, dids, 3); err == nil {
+		t.Errorf("expected an invalid pool to fail")
+	}
+}
+
+func generateDidInventoryDataSource(resourceID string, didPoolID string, dependsOnResource string) string {
+	return fmt.Sprintf(`data "genesyscloud_telephony_did_inventory" "%s" {
+		did_pool_ids = [%s]
+		depends_on   = [%s]
+	}
+	`, resourceID, didPoolID, dependsOnResource)
+}
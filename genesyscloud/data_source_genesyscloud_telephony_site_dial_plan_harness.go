@@ -0,0 +1,229 @@
+package genesyscloud
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v109/platformclientv2"
+)
+
+func dataSourceSiteDialPlanTest() *schema.Resource {
+	return &schema.Resource{
+		Description: `Data source for testing the dial plan of a Genesys Cloud Site. Evaluates dialed numbers against the number plans and outbound routes of a site, or against number plans and outbound routes set inline, without placing calls.
+
+Number plans are evaluated in priority order and the first matching plan classifies the number. The number is then routed by the first enabled outbound route for the classification. Number plans with an ` + "`interCountryCode`" + ` or ` + "`intraCountryCode`" + ` match type depend on the location of the site and are not evaluated. Inline number plans are evaluated in the order they are listed, as on ` + "`genesyscloud_telephony_providers_edges_site`" + `, and have no IDs.`,
+		ReadContext: ReadWithPooledClient(dataSourceSiteDialPlanTestRead),
+		Schema: map[string]*schema.Schema{
+			"site_id": {
+				Description:  "ID of a site to read the number plans and outbound routes from. Conflicts with `number_plans` and `outbound_routes`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"site_id", "number_plans"},
+			},
+			"number_plans": {
+				Description:  "Number plans to evaluate. Same as `number_plans` on `genesyscloud_telephony_providers_edges_site`.",
+				Type:         schema.TypeList,
+				Optional:     true,
+				ExactlyOneOf: []string{"site_id", "number_plans"},
+				Elem:         &schema.Resource{Schema: siteNumberPlanSchema()},
+			},
+			"outbound_routes": {
+				Description:   "Outbound routes to select from. Same as `outbound_routes` on `genesyscloud_telephony_providers_edges_site`.",
+				Type:          schema.TypeList,
+				Optional:      true,
+				ConflictsWith: []string{"site_id"},
+				Elem:          &schema.Resource{Schema: siteOutboundRouteSchema()},
+			},
+			"dialed_numbers": {
+				Description: "Numbers to dial, as a user would dial them.",
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"unevaluated_number_plans": {
+				Description: "Names of the number plans that were not evaluated.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"results": {
+				Description: "Result of dialing each number, in the order of `dialed_numbers`.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dialed_number": {
+							Description: "The dialed number.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"number_plan_id": {
+							Description: "ID of the number plan that matched the number. Empty if no plan matched.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"number_plan_name": {
+							Description: "Name of the number plan that matched the number.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"classification": {
+							Description: "Classification of the number plan that matched the number.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"normalized_number": {
+							Description: "The number after normalization by the number plan.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"outbound_route_id": {
+							Description: "ID of the outbound route selected for the classification. Empty if no enabled route has the classification.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"outbound_route_name": {
+							Description: "Name of the outbound route selected for the classification.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"distribution": {
+							Description: "Distribution of the calls over the trunks of the outbound route. Valid values: SEQUENTIAL, RANDOM.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"external_trunk_base_ids": {
+							Description: "Trunk base settings of the outbound route, in the order they are tried if the distribution is SEQUENTIAL.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSiteDialPlanTestRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	siteId := d.Get("site_id").(string)
+
+	var (
+		numberPlans    []platformclientv2.Numberplan
+		outboundRoutes []platformclientv2.Outboundroutebase
+	)
+	if siteId != "" {
+		sdkConfig := m.(*ProviderMeta).ClientConfig
+		edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
+
+		diagErr := WithRetries(ctx, 15*time.Second, func() *retry.RetryError {
+			var getErr error
+			numberPlans, _, getErr = edgesAPI.GetTelephonyProvidersEdgesSiteNumberplans(siteId)
+			if getErr != nil {
+				return retry.NonRetryableError(fmt.Errorf("Failed to read number plans for site %s: %s", siteId, getErr))
+			}
+			if outboundRoutes, getErr = getSiteOutboundRoutes(siteId, edgesAPI); getErr != nil {
+				return retry.NonRetryableError(getErr)
+			}
+			return nil
+		})
+		if diagErr != nil {
+			return diagErr
+		}
+	} else {
+		numberPlans, outboundRoutes = buildSdkDialPlanTestRules(d)
+	}
+
+	plan, err := newDialPlan(numberPlans, outboundRoutes)
+	if err != nil {
+		if siteId == "" {
+			return diag.Errorf("Failed to evaluate inline dial plan: %s", err)
+		}
+		return diag.Errorf("Failed to evaluate dial plan of site %s: %s", siteId, err)
+	}
+
+	results := make([]interface{}, 0)
+	for _, number := range d.Get("dialed_numbers").([]interface{}) {
+		results = append(results, flattenDialPlanResult(plan.dial(number.(string))))
+	}
+	d.Set("results", results)
+	d.Set("unevaluated_number_plans", plan.unevaluatedPlans())
+
+	if siteId != "" {
+		d.SetId(siteId)
+	} else {
+		d.SetId(dialPlanTestId(d))
+	}
+	return nil
+}
+
+// buildSdkDialPlanTestRules builds the inline number plans and outbound routes. The plans are prioritized in the
+// order they are listed.
+func buildSdkDialPlanTestRules(d *schema.ResourceData) ([]platformclientv2.Numberplan, []platformclientv2.Outboundroutebase) {
+	var numberPlans []platformclientv2.Numberplan
+	for i, numberPlan := range d.Get("number_plans").([]interface{}) {
+		sdkNumberPlan := buildSdkSiteNumberPlan(numberPlan.(map[string]interface{}))
+		priority := i
+		sdkNumberPlan.Priority = &priority
+		numberPlans = append(numberPlans, sdkNumberPlan)
+	}
+	var outboundRoutes []platformclientv2.Outboundroutebase
+	for _, outboundRoute := range d.Get("outbound_routes").([]interface{}) {
+		outboundRoutes = append(outboundRoutes, buildSdkSiteOutboundRoute(outboundRoute.(map[string]interface{})))
+	}
+	return numberPlans, outboundRoutes
+}
+
+// dialPlanTestId derives a stable ID from the inline number plans and outbound routes
+func dialPlanTestId(d *schema.ResourceData) string {
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%v|%v|%v",
+		d.Get("number_plans"),
+		d.Get("outbound_routes"),
+		d.Get("dialed_numbers"),
+	)))
+	return fmt.Sprintf("%x", hash[:8])
+}
+
+func flattenDialPlanResult(result dialPlanResult) map[string]interface{} {
+	resultMap := map[string]interface{}{
+		"dialed_number":           result.number,
+		"normalized_number":       result.normalizedNumber,
+		"external_trunk_base_ids": []interface{}{},
+	}
+	if numberPlan := result.numberPlan; numberPlan != nil {
+		resultMap["number_plan_name"] = getNumberPlanName(*numberPlan)
+		if numberPlan.Id != nil {
+			resultMap["number_plan_id"] = *numberPlan.Id
+		}
+		if numberPlan.Classification != nil {
+			resultMap["classification"] = *numberPlan.Classification
+		}
+	}
+	if outboundRoute := result.outboundRoute; outboundRoute != nil {
+		if outboundRoute.Id != nil {
+			resultMap["outbound_route_id"] = *outboundRoute.Id
+		}
+		if outboundRoute.Name != nil {
+			resultMap["outbound_route_name"] = *outboundRoute.Name
+		}
+		if outboundRoute.Distribution != nil {
+			resultMap["distribution"] = *outboundRoute.Distribution
+		}
+		if outboundRoute.ExternalTrunkBases != nil {
+			trunkBaseIds := make([]interface{}, 0)
+			for _, trunkBase := range *outboundRoute.ExternalTrunkBases {
+				if trunkBase.Id != nil {
+					trunkBaseIds = append(trunkBaseIds, *trunkBase.Id)
+				}
+			}
+			resultMap["external_trunk_base_ids"] = trunkBaseIds
+		}
+	}
+	return resultMap
+}
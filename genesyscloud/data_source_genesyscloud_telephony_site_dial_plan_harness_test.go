@@ -0,0 +1,272 @@
+package genesyscloud
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	lists "terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v109/platformclientv2"
+)
+
+func TestAccDataSourceSiteDialPlanTest(t *testing.T) {
+	t.Parallel()
+	var (
+		siteRes         = "site"
+		dialPlanTestRes = "dial-plan-test"
+		name            = "site " + uuid.NewString()
+		locationRes     = "test-location1"
+		emergencyNumber = "+13173124745"
+	)
+
+	_, err := AuthorizeSdk()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = DeleteLocationWithNumber(emergencyNumber); err != nil {
+		t.Fatal(err)
+	}
+
+	location := GenerateLocationResource(
+		locationRes,
+		"Terraform location"+uuid.NewString(),
+		"HQ1",
+		[]string{},
+		GenerateLocationEmergencyNum(
+			emergencyNumber,
+			nullValue, // Default number type
+		), GenerateLocationAddress(
+			"7601 Interactive Way",
+			"Indianapolis",
+			"IN",
+			"US",
+			"46278",
+		))
+
+	site := GenerateSiteResourceWithCustomAttrs(
+		siteRes,
+		name,
+		"test site description",
+		"genesyscloud_location."+locationRes+".id",
+		"Cloud",
+		false,
+		"[\"us-west-2\"]",
+		strconv.Quote("+19205551212"),
+		strconv.Quote("Wilco plumbing"),
+		generateSiteNumberPlansWithCustomAttrs(
+			"Speed dial",
+			"speed",
+			`^\\*([0-9]{3})$`,
+			"regex",
+			"+1317555$1",
+		),
+		generateSiteOutboundRoutesWithCustomAttrs(
+			"Speed route",
+			"Route for speed dial",
+			strconv.Quote("speed"),
+			"",
+			"SEQUENTIAL",
+			true,
+		),
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				Config: location + site + generateSiteDialPlanTestDataSource(
+					dialPlanTestRes,
+					"genesyscloud_telephony_providers_edges_site."+siteRes+".id",
+					strconv.Quote("*123"),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.genesyscloud_telephony_site_dial_plan_test."+dialPlanTestRes, "results.0.number_plan_name", "Speed dial"),
+					resource.TestCheckResourceAttr("data.genesyscloud_telephony_site_dial_plan_test."+dialPlanTestRes, "results.0.classification", "speed"),
+					resource.TestCheckResourceAttr("data.genesyscloud_telephony_site_dial_plan_test."+dialPlanTestRes, "results.0.normalized_number", "+1317555123"),
+					resource.TestCheckResourceAttr("data.genesyscloud_telephony_site_dial_plan_test."+dialPlanTestRes, "results.0.outbound_route_name", "Speed route"),
+				),
+			},
+			{
+				// Inline number plans and outbound routes do not need a site
+				Config: `data "genesyscloud_telephony_site_dial_plan_test" "inline" {
+					number_plans {
+						name              = "Speed dial"
+						match_type        = "regex"
+						match_format      = "^\\*([0-9]{3})$"
+						normalized_format = "+1317555$1"
+						classification    = "speed"
+					}
+					outbound_routes {
+						name                 = "Speed route"
+						classification_types = ["speed"]
+						enabled              = true
+					}
+					dialed_numbers = ["*123"]
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.genesyscloud_telephony_site_dial_plan_test.inline", "results.0.normalized_number", "+1317555123"),
+					resource.TestCheckResourceAttr("data.genesyscloud_telephony_site_dial_plan_test.inline", "results.0.outbound_route_name", "Speed route"),
+				),
+			},
+		},
+	})
+}
+
+func TestSiteDialPlan(t *testing.T) {
+	numberPlans := []platformclientv2.Numberplan{
+		{
+			Name:             platformclientv2.String("National"),
+			MatchType:        platformclientv2.String("regex"),
+			Match:            platformclientv2.String(`(?:\+?1)?\(?([2-9]\d{2})\)?[-. ]?([2-9]\d{2})[-. ]?(\d{4})`),
+			NormalizedFormat: platformclientv2.String("+1$1$2$3"),
+			Classification:   platformclientv2.String("National"),
+			Priority:         platformclientv2.Int(5),
+		},
+		{
+			Name:           platformclientv2.String("Extension"),
+			MatchType:      platformclientv2.String("digitLength"),
+			DigitLength:    &platformclientv2.Digitlength{Start: platformclientv2.String("3"), End: platformclientv2.String("5")},
+			Classification: platformclientv2.String("Extension"),
+			Priority:       platformclientv2.Int(3),
+		},
+		{
+			Name:           platformclientv2.String("Toll free"),
+			MatchType:      platformclientv2.String("numberList"),
+			Numbers:        &[]platformclientv2.Number{{Start: platformclientv2.String("8005550000"), End: platformclientv2.String("8005559999")}},
+			Classification: platformclientv2.String("TollFree"),
+			Priority:       platformclientv2.Int(1),
+		},
+		{
+			Name:           platformclientv2.String("Partner"),
+			MatchType:      platformclientv2.String("e164NumberList"),
+			Numbers:        &[]platformclientv2.Number{{Start: platformclientv2.String("+442071234567")}},
+			Classification: platformclientv2.String("Partner"),
+			Priority:       platformclientv2.Int(2),
+		},
+		{
+			Name:           platformclientv2.String("International"),
+			MatchType:      platformclientv2.String("interCountryCode"),
+			Classification: platformclientv2.String("International"),
+			Priority:       platformclientv2.Int(4),
+		},
+	}
+	outboundRoutes := []platformclientv2.Outboundroutebase{
+		{
+			Id:                  platformclientv2.String("disabled-route"),
+			ClassificationTypes: &[]string{"National"},
+			Enabled:             platformclientv2.Bool(false),
+		},
+		{
+			Id:                  platformclientv2.String("carrier-route"),
+			ClassificationTypes: &[]string{"National", "TollFree"},
+			Enabled:             platformclientv2.Bool(true),
+			Distribution:        platformclientv2.String("SEQUENTIAL"),
+			ExternalTrunkBases:  &[]platformclientv2.Domainentityref{{Id: platformclientv2.String("trunk-1")}, {Id: platformclientv2.String("trunk-2")}},
+		},
+	}
+
+	plan, err := newDialPlan(numberPlans, outboundRoutes)
+	if err != nil {
+		t.Fatalf("failed to build dial plan: %v", err)
+	}
+	assertStringList(t, lists.StringListToInterfaceList(plan.unevaluatedPlans()), []string{"International"})
+
+	testCases := []struct {
+		number           string
+		numberPlan       string
+		normalizedNumber string
+		outboundRoute    string
+	}{
+		{number: "(317) 555-1212", numberPlan: "National", normalizedNumber: "+13175551212", outboundRoute: "carrier-route"},
+		{number: "800-555-1234", numberPlan: "Toll free", normalizedNumber: "8005551234", outboundRoute: "carrier-route"},
+		{number: "1234", numberPlan: "Extension", normalizedNumber: "1234"},
+		{number: "+44 20 7123 4567", numberPlan: "Partner", normalizedNumber: "+442071234567"},
+		{number: "12", numberPlan: ""},
+	}
+	for _, testCase := range testCases {
+		result := plan.dial(testCase.number)
+		resultMap := flattenDialPlanResult(result)
+		if numberPlanName, _ := resultMap["number_plan_name"].(string); numberPlanName != testCase.numberPlan {
+			t.Errorf("%s: expected number plan %q, got %v", testCase.number, testCase.numberPlan, resultMap["number_plan_name"])
+		}
+		if result.normalizedNumber != testCase.normalizedNumber {
+			t.Errorf("%s: expected normalized number %q, got %q", testCase.number, testCase.normalizedNumber, result.normalizedNumber)
+		}
+		if routeId, _ := resultMap["outbound_route_id"].(string); routeId != testCase.outboundRoute {
+			t.Errorf("%s: expected outbound route %q, got %q", testCase.number, testCase.outboundRoute, routeId)
+		}
+	}
+	assertStringList(t, flattenDialPlanResult(plan.dial("3175551212"))["external_trunk_base_ids"], []string{"trunk-1", "trunk-2"})
+
+	if _, err := newDialPlan([]platformclientv2.Numberplan{{
+		Name:      platformclientv2.String("Lookahead"),
+		MatchType: platformclientv2.String("regex"),
+		Match:     platformclientv2.String(`(?=1)\d+`),
+	}}, nil); err == nil {
+		t.Errorf("expected an unsupported match format to fail")
+	}
+}
+
+func TestSiteDialPlanInline(t *testing.T) {
+	numberPlan := func(name string, matchType string, numbers []interface{}, classification string) map[string]interface{} {
+		return map[string]interface{}{
+			"name":           name,
+			"match_type":     matchType,
+			"numbers":        numbers,
+			"classification": classification,
+		}
+	}
+	tenDigits := numberPlan("Ten digits", "digitLength", nil, "National")
+	tenDigits["digit_length"] = []interface{}{map[string]interface{}{"start": "10", "end": "10"}}
+
+	d := schema.TestResourceDataRaw(t, dataSourceSiteDialPlanTest().Schema, map[string]interface{}{
+		"number_plans": []interface{}{
+			numberPlan("Premium", "numberList", []interface{}{map[string]interface{}{"start": "9005550000", "end": "9005559999"}}, "Premium"),
+			tenDigits,
+			numberPlan("Abroad", "interCountryCode", nil, "International"),
+		},
+		"outbound_routes": []interface{}{
+			map[string]interface{}{
+				"name":                    "Carrier",
+				"classification_types":    []interface{}{"National", "Premium"},
+				"enabled":                 true,
+				"external_trunk_base_ids": []interface{}{"trunk-1"},
+			},
+		},
+		"dialed_numbers": []interface{}{"900-555-1234", "3175551212"},
+	})
+	if diagErr := dataSourceSiteDialPlanTestRead(context.Background(), d, nil); diagErr != nil {
+		t.Fatalf("failed to read dial plan test: %v", diagErr)
+	}
+
+	// The first listed plan has the highest priority
+	if name := d.Get("results.0.number_plan_name").(string); name != "Premium" {
+		t.Errorf("expected the premium plan to match first, got %q", name)
+	}
+	if name := d.Get("results.1.number_plan_name").(string); name != "Ten digits" {
+		t.Errorf("expected the digit length plan to match, got %q", name)
+	}
+	if route := d.Get("results.1.outbound_route_name").(string); route != "Carrier" {
+		t.Errorf("expected the carrier route, got %q", route)
+	}
+	assertStringList(t, d.Get("unevaluated_number_plans"), []string{"Abroad"})
+	if d.Id() == "" {
+		t.Errorf("expected an ID to be set")
+	}
+}
+
+func generateSiteDialPlanTestDataSource(resourceID string, siteID string, dialedNumbers ...string) string {
+	return fmt.Sprintf(`data "genesyscloud_telephony_site_dial_plan_test" "%s" {
+		site_id        = %s
+		dialed_numbers = [%s]
+	}
+	`, resourceID, siteID, strings.Join(dialedNumbers, ", "))
+}
@@ -0,0 +1,522 @@
+package genesyscloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+	"terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mypurecloud/platform-client-sdk-go/v109/platformclientv2"
+)
+
+const (
+	// Maximum number of years generated by one resource
+	holidayScheduleMaxYears = 10
+
+	holidayScheduleConcurrency = 5
+)
+
+func ResourceArchitectHolidaySchedules() *schema.Resource {
+	return &schema.Resource{
+		Description: `Genesys Cloud Architect Holiday Schedules. Generates a schedule for each public holiday of a list of countries and subdivisions from a built-in holiday calendar, and adds them to the holiday schedules of a schedule group.
+
+Each holiday is an all day schedule named after its region, holiday and year, such as "GB Christmas Day 2024". Holidays that fall on a weekend are generated on the day they are observed in their country.
+Holidays are added and removed as the configuration changes and all of them are deleted with the resource. Holidays that fail to apply are reported and retried on the next apply. Do not set ` + "`holiday_schedules_id`" + ` on the schedule group, as it would remove the generated schedules from the group.`,
+
+		CreateContext: CreateWithPooledClient(createArchitectHolidaySchedules),
+		ReadContext:   ReadWithPooledClient(readArchitectHolidaySchedules),
+		UpdateContext: UpdateWithPooledClient(updateArchitectHolidaySchedules),
+		DeleteContext: DeleteWithPooledClient(deleteArchitectHolidaySchedules),
+		CustomizeDiff: customizeArchitectHolidaySchedulesDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"schedule_group_id": {
+				Description: "ID of the schedule group the holiday schedules are added to. Changing the schedule group recreates the schedules.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"regions": {
+				Description: "ISO 3166 codes of the countries and subdivisions to generate holidays for, such as US or GB-SCT. Subdivisions include the holidays of their country. Supported countries are AU, CA, DE, ES, FR, GB, IE, IT, MX, NL, NZ and US.",
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateHolidayRegion,
+				},
+			},
+			"start_year": {
+				Description:  "First year to generate holidays for.",
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(2000, 2099),
+			},
+			"end_year": {
+				Description:  fmt.Sprintf("Last year to generate holidays for. At most %d years can be generated.", holidayScheduleMaxYears),
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(2000, 2099),
+			},
+			"name_prefix": {
+				Description: "Prefix of the names of the schedules. Schedule names must be unique, so set a prefix when generating the same holidays more than once.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"division_id": {
+				Description: "The division of the schedules. If not set, the home division will be used.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"exclusions": {
+				Description: "Holidays to leave out, either by name such as `Boxing Day` or by date such as `2024-12-26`.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"additions": {
+				Description: "Custom holidays generated in addition to the holidays of the regions.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description: "Name of the holiday.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"date": {
+							Description:      "Date of the holiday. Dates are represented as an ISO-8601 string. For example: yyyy-MM-dd.",
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validateDate,
+						},
+					},
+				},
+			},
+			"schedule_ids": {
+				Description: "ID of the schedule of each holiday, keyed by date, region and name, such as `2024-12-25 GB Christmas Day`.",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func validateHolidayRegion(value interface{}, _ cty.Path) diag.Diagnostics {
+	region, ok := value.(string)
+	if !ok {
+		return diag.Errorf("Region %v is not a string", value)
+	}
+	for _, code := range getHolidayRegionCodes() {
+		if code == region {
+			return nil
+		}
+	}
+	return diag.Errorf("No holidays for %s. Supported regions are %s", region, strings.Join(getHolidayRegionCodes(), ", "))
+}
+
+// customizeArchitectHolidaySchedulesDiff generates the holidays during plan so holidays that were added, removed or
+// deleted outside of Terraform produce a diff
+func customizeArchitectHolidaySchedulesDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	for _, attr := range []string{"regions", "start_year", "end_year", "exclusions", "additions"} {
+		if !diff.NewValueKnown(attr) {
+			return diff.SetNewComputed("schedule_ids")
+		}
+	}
+
+	holidays, err := getConfiguredHolidays(diff)
+	if err != nil {
+		return err
+	}
+
+	var keys, oldKeys []string
+	for _, h := range holidays {
+		keys = append(keys, getHolidayKey(h))
+	}
+	for key := range diff.Get("schedule_ids").(map[string]interface{}) {
+		oldKeys = append(oldKeys, key)
+	}
+	sort.Strings(oldKeys)
+	if reflect.DeepEqual(keys, oldKeys) {
+		return nil
+	}
+	return diff.SetNewComputed("schedule_ids")
+}
+
+// holidayConfig is implemented by both schema.ResourceData and schema.ResourceDiff
+type holidayConfig interface {
+	Get(key string) interface{}
+}
+
+// getConfiguredHolidays generates the holidays of the regions and additions, without the exclusions. Holidays shared
+// by several subdivisions of a country are generated once.
+func getConfiguredHolidays(d holidayConfig) ([]holiday, error) {
+	startYear := d.Get("start_year").(int)
+	endYear := d.Get("end_year").(int)
+	if endYear < startYear {
+		return nil, fmt.Errorf("end_year %d must not be before start_year %d", endYear, startYear)
+	}
+	if endYear-startYear >= holidayScheduleMaxYears {
+		return nil, fmt.Errorf("at most %d years of holidays can be generated, got %d to %d", holidayScheduleMaxYears, startYear, endYear)
+	}
+
+	exclusions := make(map[string]bool)
+	for _, exclusion := range d.Get("exclusions").(*schema.Set).List() {
+		exclusions[strings.ToLower(strings.TrimSpace(exclusion.(string)))] = true
+	}
+	isExcluded := func(h holiday) bool {
+		return exclusions[strings.ToLower(h.Name)] || exclusions[h.Date.Format(resourcedata.DateParseFormat)]
+	}
+
+	holidays := make(map[string]holiday)
+	for _, region := range d.Get("regions").(*schema.Set).List() {
+		regionHolidays, err := getRegionHolidays(region.(string), startYear, endYear)
+		if err != nil {
+			return nil, err
+		}
+		for _, h := range regionHolidays {
+			if !isExcluded(h) {
+				holidays[getHolidayKey(h)] = h
+			}
+		}
+	}
+	for _, addition := range d.Get("additions").(*schema.Set).List() {
+		additionMap := addition.(map[string]interface{})
+		date, err := time.Parse(resourcedata.DateParseFormat, additionMap["date"].(string))
+		if err != nil {
+			// Unknown dates are validated during apply
+			continue
+		}
+		h := holiday{Name: additionMap["name"].(string), Date: date}
+		if !isExcluded(h) {
+			holidays[getHolidayKey(h)] = h
+		}
+	}
+
+	var keys []string
+	for key := range holidays {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	result := make([]holiday, 0, len(keys))
+	for _, key := range keys {
+		result = append(result, holidays[key])
+	}
+	return result, nil
+}
+
+func createArchitectHolidaySchedules(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(uuid.NewString())
+
+	log.Printf("Creating holiday schedules %s for schedule group %s", d.Id(), d.Get("schedule_group_id").(string))
+	holidayErr, diagErr := syncArchitectHolidaySchedules(ctx, d, meta, map[string]interface{}{})
+	if diagErr.HasError() {
+		return append(holidayErr, diagErr...)
+	}
+	if holidayErr.HasError() {
+		if len(d.Get("schedule_ids").(map[string]interface{})) == 0 {
+			d.SetId("")
+			return holidayErr
+		}
+		// Failing the create would taint the resource and replacing it would delete every schedule. The holidays that
+		// failed are missing from schedule_ids so the next plan retries them.
+		holidayErr = diagErrorsToWarnings(holidayErr)
+	}
+
+	log.Printf("Created holiday schedules %s", d.Id())
+	return append(holidayErr, readArchitectHolidaySchedules(ctx, d, meta)...)
+}
+
+func readArchitectHolidaySchedules(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+
+	log.Printf("Reading holiday schedules %s", d.Id())
+	return WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, ResourceArchitectHolidaySchedules())
+
+		schedules, diagErr := getAllArchitectSchedules(ctx, sdkConfig)
+		if diagErr != nil {
+			return retry.NonRetryableError(diagErrorsToError(diagErr))
+		}
+		scheduleIds := d.Get("schedule_ids").(map[string]interface{})
+		for key, scheduleId := range scheduleIds {
+			if _, ok := schedules[scheduleId.(string)]; !ok {
+				// Schedules deleted outside of Terraform are dropped so the next apply recreates them
+				log.Printf("Holiday schedule %s of %s no longer exists", key, d.Id())
+				delete(scheduleIds, key)
+			}
+		}
+		d.Set("schedule_ids", scheduleIds)
+
+		log.Printf("Read holiday schedules %s", d.Id())
+		return cc.CheckState()
+	})
+}
+
+func updateArchitectHolidaySchedules(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	oldScheduleIds, _ := d.GetChange("schedule_ids")
+
+	log.Printf("Updating holiday schedules %s", d.Id())
+	holidayErr, diagErr := syncArchitectHolidaySchedules(ctx, d, meta, oldScheduleIds.(map[string]interface{}))
+	if diagErr = append(holidayErr, diagErr...); diagErr.HasError() {
+		return diagErr
+	}
+
+	log.Printf("Updated holiday schedules %s", d.Id())
+	return readArchitectHolidaySchedules(ctx, d, meta)
+}
+
+func deleteArchitectHolidaySchedules(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	scheduleIds := d.Get("schedule_ids").(map[string]interface{})
+
+	log.Printf("Deleting %d holiday schedules of %s", len(scheduleIds), d.Id())
+	var removedIds []string
+	for _, scheduleId := range scheduleIds {
+		removedIds = append(removedIds, scheduleId.(string))
+	}
+	if diagErr := updateScheduleGroupHolidays(d.Get("schedule_group_id").(string), nil, removedIds, sdkConfig); diagErr != nil {
+		return diagErr
+	}
+	return deleteHolidaySchedules(ctx, sortedKeys(scheduleIds), scheduleIds, sdkConfig)
+}
+
+// syncArchitectHolidaySchedules creates the schedules of new holidays, renames existing schedules when the prefix or
+// division changes and deletes the schedules of removed holidays. The schedule group is updated before removed
+// schedules are deleted, as schedules cannot be deleted while they are in use. Holidays that failed to apply are
+// returned separately from the other errors.
+func syncArchitectHolidaySchedules(ctx context.Context, d *schema.ResourceData, meta interface{}, oldScheduleIds map[string]interface{}) (diag.Diagnostics, diag.Diagnostics) {
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	archAPI := platformclientv2.NewArchitectApiWithConfig(sdkConfig)
+
+	holidays, err := getConfiguredHolidays(d)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	scheduleIds := make(map[string]interface{})
+	for key, scheduleId := range oldScheduleIds {
+		scheduleIds[key] = scheduleId
+	}
+	defer d.Set("schedule_ids", scheduleIds)
+
+	var (
+		holidayErr diag.Diagnostics
+		mutex      sync.Mutex
+		changed    []holiday
+	)
+	newKeys := make(map[string]bool)
+	reapply := d.HasChanges("name_prefix", "division_id")
+	for _, h := range holidays {
+		key := getHolidayKey(h)
+		newKeys[key] = true
+		if reapply || scheduleIds[key] == nil {
+			changed = append(changed, h)
+		}
+	}
+
+	runWithConcurrency(holidayScheduleConcurrency, changed, func(h holiday) {
+		key := getHolidayKey(h)
+		mutex.Lock()
+		scheduleId, _ := scheduleIds[key].(string)
+		mutex.Unlock()
+
+		scheduleId, err := putHolidaySchedule(h, scheduleId, d.Get("name_prefix").(string), d.Get("division_id").(string), archAPI)
+		mutex.Lock()
+		defer mutex.Unlock()
+		if err != nil {
+			holidayErr = append(holidayErr, diag.Diagnostic{Severity: diag.Error, Summary: fmt.Sprintf("Failed to apply holiday schedule %s: %v", key, err)})
+			return
+		}
+		scheduleIds[key] = scheduleId
+	})
+
+	var removedKeys, addedIds, removedIds []string
+	for key, scheduleId := range scheduleIds {
+		if newKeys[key] {
+			addedIds = append(addedIds, scheduleId.(string))
+		} else {
+			removedKeys = append(removedKeys, key)
+			removedIds = append(removedIds, scheduleId.(string))
+		}
+	}
+	sort.Strings(removedKeys)
+
+	// Schedules that were created are added to the group even if other holidays failed
+	if groupErr := updateScheduleGroupHolidays(d.Get("schedule_group_id").(string), addedIds, removedIds, sdkConfig); groupErr != nil {
+		return holidayErr, groupErr
+	}
+
+	return holidayErr, deleteHolidaySchedules(ctx, removedKeys, scheduleIds, sdkConfig)
+}
+
+// putHolidaySchedule creates the all day schedule of a holiday, or updates it if it already exists
+func putHolidaySchedule(h holiday, scheduleId string, namePrefix string, divisionId string, archAPI *platformclientv2.ArchitectApi) (string, error) {
+	name := fmt.Sprintf("%s%s %d", namePrefix, h.Name, h.Date.Year())
+	if h.Region != "" {
+		name = fmt.Sprintf("%s%s %s %d", namePrefix, h.Region, h.Name, h.Date.Year())
+	}
+	start := h.Date
+	end := h.Date.AddDate(0, 0, 1)
+	rrule := ""
+	description := "Generated by genesyscloud_architect_holiday_schedules"
+	schedule := platformclientv2.Schedule{
+		Name:        &name,
+		Description: &description,
+		Start:       &start,
+		End:         &end,
+		Rrule:       &rrule,
+	}
+	if divisionId != "" {
+		schedule.Division = &platformclientv2.Writabledivision{Id: &divisionId}
+	}
+
+	if scheduleId == "" {
+		created, _, err := archAPI.PostArchitectSchedules(schedule)
+		if err != nil {
+			return "", err
+		}
+		log.Printf("Created holiday schedule %s %s", name, *created.Id)
+		return *created.Id, nil
+	}
+
+	diagErr := RetryWhen(IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+		existing, resp, getErr := archAPI.GetArchitectSchedule(scheduleId)
+		if getErr != nil {
+			return resp, diag.Errorf("Failed to read schedule %s: %s", scheduleId, getErr)
+		}
+		schedule.Version = existing.Version
+		if schedule.Division == nil && existing.Division != nil {
+			schedule.Division = &platformclientv2.Writabledivision{Id: existing.Division.Id}
+		}
+		_, resp, putErr := archAPI.PutArchitectSchedule(scheduleId, schedule)
+		if putErr != nil {
+			return resp, diag.Errorf("Failed to update schedule %s: %s", scheduleId, putErr)
+		}
+		return resp, nil
+	})
+	if diagErr != nil {
+		return scheduleId, diagErrorsToError(diagErr)
+	}
+	return scheduleId, nil
+}
+
+// updateScheduleGroupHolidays adds and removes holiday schedules of a schedule group, keeping its other holidays
+func updateScheduleGroupHolidays(scheduleGroupId string, addedIds []string, removedIds []string, sdkConfig *platformclientv2.Configuration) diag.Diagnostics {
+	archAPI := platformclientv2.NewArchitectApiWithConfig(sdkConfig)
+
+	return RetryWhen(IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+		scheduleGroup, resp, getErr := archAPI.GetArchitectSchedulegroup(scheduleGroupId)
+		if getErr != nil {
+			if IsStatus404(resp) && len(addedIds) == 0 {
+				// Nothing to remove from a deleted schedule group
+				return nil, nil
+			}
+			return resp, diag.Errorf("Failed to read schedule group %s: %s", scheduleGroupId, getErr)
+		}
+
+		holidayIds := make(map[string]bool)
+		if scheduleGroup.HolidaySchedules != nil {
+			for _, ref := range *scheduleGroup.HolidaySchedules {
+				holidayIds[*ref.Id] = true
+			}
+		}
+		changed := false
+		for _, id := range removedIds {
+			changed = changed || holidayIds[id]
+			delete(holidayIds, id)
+		}
+		for _, id := range addedIds {
+			changed = changed || !holidayIds[id]
+			holidayIds[id] = true
+		}
+		if !changed {
+			return resp, nil
+		}
+
+		holidaySchedules := make([]platformclientv2.Domainentityref, 0, len(holidayIds))
+		for _, id := range sortedKeys(holidayIds) {
+			id := id
+			holidaySchedules = append(holidaySchedules, platformclientv2.Domainentityref{Id: &id})
+		}
+		scheduleGroup.HolidaySchedules = &holidaySchedules
+
+		update := platformclientv2.Schedulegroup{
+			Name:             scheduleGroup.Name,
+			Version:          scheduleGroup.Version,
+			Description:      scheduleGroup.Description,
+			TimeZone:         scheduleGroup.TimeZone,
+			OpenSchedules:    scheduleGroup.OpenSchedules,
+			ClosedSchedules:  scheduleGroup.ClosedSchedules,
+			HolidaySchedules: &holidaySchedules,
+		}
+		if scheduleGroup.Division != nil {
+			update.Division = &platformclientv2.Writabledivision{Id: scheduleGroup.Division.Id}
+		}
+
+		log.Printf("Updating holiday schedules of schedule group %s", scheduleGroupId)
+		_, resp, putErr := archAPI.PutArchitectSchedulegroup(scheduleGroupId, update)
+		if putErr != nil {
+			return resp, diag.Errorf("Failed to update holiday schedules of schedule group %s: %s", scheduleGroupId, putErr)
+		}
+		return resp, nil
+	})
+}
+
+// deleteHolidaySchedules deletes the schedules of holidays and removes them from the schedule IDs
+func deleteHolidaySchedules(ctx context.Context, keys []string, scheduleIds map[string]interface{}, sdkConfig *platformclientv2.Configuration) diag.Diagnostics {
+	archAPI := platformclientv2.NewArchitectApiWithConfig(sdkConfig)
+
+	var (
+		diagErr diag.Diagnostics
+		mutex   sync.Mutex
+	)
+	runWithConcurrency(holidayScheduleConcurrency, keys, func(key string) {
+		mutex.Lock()
+		scheduleId := scheduleIds[key].(string)
+		mutex.Unlock()
+
+		err := WithRetries(ctx, 30*time.Second, func() *retry.RetryError {
+			resp, err := archAPI.DeleteArchitectSchedule(scheduleId)
+			if err != nil && !IsStatus404(resp) {
+				if IsStatus400(resp, http.StatusConflict) {
+					// The schedule group may still reference the schedule
+					return retry.RetryableError(fmt.Errorf("Failed to delete schedule %s: %s", scheduleId, err))
+				}
+				return retry.NonRetryableError(fmt.Errorf("Failed to delete schedule %s: %s", scheduleId, err))
+			}
+			return nil
+		})
+
+		mutex.Lock()
+		defer mutex.Unlock()
+		if err != nil {
+			diagErr = append(diagErr, diag.Diagnostic{Severity: diag.Error, Summary: fmt.Sprintf("Failed to delete holiday schedule %s: %v", key, err[0].Summary)})
+			return
+		}
+		log.Printf("Deleted holiday schedule %s %s", key, scheduleId)
+		delete(scheduleIds, key)
+	})
+	return diagErr
+}
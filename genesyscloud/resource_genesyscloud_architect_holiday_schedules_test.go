@@ -0,0 +1,176 @@
+package genesyscloud
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v109/platformclientv2"
+)
+
+func TestAccResourceArchitectHolidaySchedules(t *testing.T) {
+	var (
+		schedGroupResource = "arch-sched-group"
+		schedGroupName     = "Schedule Group " + uuid.NewString()
+		openSchedResource  = "arch-sched-open"
+		openSchedName      = "Open Schedule " + uuid.NewString()
+		holidaysResource   = "holidays"
+		namePrefix         = "tf " + uuid.NewString()[:8] + " "
+
+		addition = generateHolidayAddition("Company Day", "2024-09-13")
+	)
+
+	config := func(regions []string, exclusions []string) string {
+		return generateArchitectSchedulesResource(
+			openSchedResource,
+			openSchedName,
+			nullValue,
+			"Open schedule",
+			"2024-01-01T09:00:00.000000",
+			"2024-01-01T17:00:00.000000",
+			"FREQ=DAILY;INTERVAL=1",
+		) + generateArchitectScheduleGroupsResource(
+			schedGroupResource,
+			schedGroupName,
+			nullValue,
+			"Schedule group with generated holidays",
+			"Europe/London",
+			generateSchedules("open_schedules_id", "genesyscloud_architect_schedules."+openSchedResource+".id"),
+		) + generateArchitectHolidaySchedulesResource(
+			holidaysResource,
+			"genesyscloud_architect_schedulegroups."+schedGroupResource+".id",
+			regions,
+			2024,
+			2024,
+			namePrefix,
+			exclusions,
+			addition,
+		)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// Scotland has 9 holidays in 2024
+				Config: config([]string{"GB-SCT"}, []string{"St. Andrew's Day"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_architect_holiday_schedules."+holidaysResource, "schedule_ids.%", "9"),
+					resource.TestCheckResourceAttrSet("genesyscloud_architect_holiday_schedules."+holidaysResource, "schedule_ids.2024-01-02 GB-SCT 2nd January"),
+					resource.TestCheckResourceAttrSet("genesyscloud_architect_holiday_schedules."+holidaysResource, "schedule_ids.2024-09-13 Company Day"),
+					testVerifyHolidaySchedulesInGroup("genesyscloud_architect_holiday_schedules."+holidaysResource),
+				),
+			},
+			{
+				// England adds Easter Monday and its own Summer Bank Holiday
+				Config: config([]string{"GB-SCT", "GB-ENG"}, []string{"St. Andrew's Day", "2024-01-02"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_architect_holiday_schedules."+holidaysResource, "schedule_ids.%", "10"),
+					resource.TestCheckNoResourceAttr("genesyscloud_architect_holiday_schedules."+holidaysResource, "schedule_ids.2024-01-02 GB-SCT 2nd January"),
+					resource.TestCheckResourceAttrSet("genesyscloud_architect_holiday_schedules."+holidaysResource, "schedule_ids.2024-04-01 GB-ENG Easter Monday"),
+					testVerifyHolidaySchedulesInGroup("genesyscloud_architect_holiday_schedules."+holidaysResource),
+				),
+			},
+		},
+		CheckDestroy: testVerifyHolidaySchedulesDestroyed,
+	})
+}
+
+func generateArchitectHolidaySchedulesResource(
+	resourceID string,
+	scheduleGroupId string,
+	regions []string,
+	startYear int,
+	endYear int,
+	namePrefix string,
+	exclusions []string,
+	otherAttrs ...string) string {
+	quote := func(values []string) string {
+		quoted := make([]string, 0, len(values))
+		for _, value := range values {
+			quoted = append(quoted, strconv.Quote(value))
+		}
+		return strings.Join(quoted, ", ")
+	}
+	return fmt.Sprintf(`resource "genesyscloud_architect_holiday_schedules" "%s" {
+		schedule_group_id = %s
+		regions = [%s]
+		start_year = %d
+		end_year = %d
+		name_prefix = "%s"
+		exclusions = [%s]
+		%s
+	}
+	`, resourceID, scheduleGroupId, quote(regions), startYear, endYear, namePrefix, quote(exclusions), strings.Join(otherAttrs, "\n"))
+}
+
+func generateHolidayAddition(name string, date string) string {
+	return fmt.Sprintf(`additions {
+		name = "%s"
+		date = "%s"
+	}
+	`, name, date)
+}
+
+func testVerifyHolidaySchedulesInGroup(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		holidaysResource, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Failed to find %s in state", resourceName)
+		}
+
+		archAPI := platformclientv2.NewArchitectApi()
+		scheduleGroup, _, err := archAPI.GetArchitectSchedulegroup(holidaysResource.Primary.Attributes["schedule_group_id"])
+		if err != nil {
+			return fmt.Errorf("Failed to read schedule group: %v", err)
+		}
+		holidayIds := make(map[string]bool)
+		if scheduleGroup.HolidaySchedules != nil {
+			for _, ref := range *scheduleGroup.HolidaySchedules {
+				holidayIds[*ref.Id] = true
+			}
+		}
+
+		for key, value := range holidaysResource.Primary.Attributes {
+			if !strings.HasPrefix(key, "schedule_ids.") || key == "schedule_ids.%" {
+				continue
+			}
+			if !holidayIds[value] {
+				return fmt.Errorf("Holiday schedule %s (%s) is not a holiday schedule of the schedule group", key, value)
+			}
+			delete(holidayIds, value)
+		}
+		if len(holidayIds) > 0 {
+			return fmt.Errorf("Schedule group has unexpected holiday schedules %v", holidayIds)
+		}
+		return nil
+	}
+}
+
+func testVerifyHolidaySchedulesDestroyed(state *terraform.State) error {
+	archAPI := platformclientv2.NewArchitectApi()
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != "genesyscloud_architect_holiday_schedules" {
+			continue
+		}
+
+		for key, scheduleId := range rs.Primary.Attributes {
+			if !strings.HasPrefix(key, "schedule_ids.") || key == "schedule_ids.%" {
+				continue
+			}
+			schedule, resp, err := archAPI.GetArchitectSchedule(scheduleId)
+			if schedule != nil {
+				return fmt.Errorf("Holiday schedule %s (%s) still exists", key, scheduleId)
+			} else if !IsStatus404(resp) {
+				return fmt.Errorf("Unexpected error: %s", err)
+			}
+		}
+	}
+	// Success. All holiday schedules destroyed
+	return nil
+}
@@ -100,9 +100,10 @@ func ResourceArchitectScheduleGroups() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 			"holiday_schedules_id": {
-				Description: "The schedules defining the hours an organization is closed for the holidays.",
+				Description: "The schedules defining the hours an organization is closed for the holidays. If not set, holiday schedules added by `genesyscloud_architect_holiday_schedules` are kept.",
 				Type:        schema.TypeSet,
 				Optional:    true,
+				Computed:    true,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 		},
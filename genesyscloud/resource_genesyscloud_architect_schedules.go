@@ -48,9 +48,12 @@ func ArchitectSchedulesExporter() *resourceExporter.ResourceExporter {
 		RefAttrs: map[string]*resourceExporter.RefAttrSettings{
 			"division_id": {RefType: "genesyscloud_auth_division"},
 		},
+		ExcludedAttributes: []string{"next_occurrences"},
 	}
 }
 
+var architectScheduleFreqs = []string{rruleFreqDaily, rruleFreqWeekly, rruleFreqMonthly, rruleFreqYearly}
+
 func ResourceArchitectSchedules() *schema.Resource {
 	return &schema.Resource{
 		Description: "Genesys Cloud Architect Schedules",
@@ -62,6 +65,7 @@ func ResourceArchitectSchedules() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: validateArchitectScheduleDiff,
 		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -93,10 +97,12 @@ func ResourceArchitectSchedules() *schema.Resource {
 				ValidateDiagFunc: validateLocalDateTimes,
 			},
 			"rrule": {
-				Description: "An iCal Recurrence Rule (RRULE) string.",
-				Type:        schema.TypeString,
-				Optional:    true,
+				Description:      "An iCal Recurrence Rule (RRULE) string. The supported frequencies are daily, weekly, monthly and yearly.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateRrule(architectScheduleFreqs...),
 			},
+			"next_occurrences": nextOccurrencesSchema("The next windows in which the schedule is active. Empty if the windows of the rrule cannot be computed, for example when it uses BYSETPOS or BYHOUR."),
 		},
 	}
 }
@@ -160,7 +166,8 @@ func readArchitectSchedules(ctx context.Context, d *schema.ResourceData, meta in
 
 	log.Printf("Reading schedule %s", d.Id())
 
-	return WithRetriesForRead(ctx, d, func() *retry.RetryError {
+	var warnings diag.Diagnostics
+	diagErr := WithRetriesForRead(ctx, d, func() *retry.RetryError {
 		schedule, resp, getErr := archAPI.GetArchitectSchedule(d.Id())
 		if getErr != nil {
 			if IsStatus404(resp) {
@@ -198,10 +205,17 @@ func readArchitectSchedules(ctx context.Context, d *schema.ResourceData, meta in
 		if schedule.Rrule != nil {
 			d.Set("rrule", *schedule.Rrule)
 		}
+		var nextOccurrences []interface{}
+		nextOccurrences, warnings = flattenArchitectScheduleNextOccurrences(schedule)
+		d.Set("next_occurrences", nextOccurrences)
 
 		log.Printf("Read schedule %s %s", d.Id(), *schedule.Name)
 		return cc.CheckState()
 	})
+	if diagErr != nil {
+		return diagErr
+	}
+	return warnings
 }
 
 func updateArchitectSchedules(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -289,3 +303,52 @@ func deleteArchitectSchedules(ctx context.Context, d *schema.ResourceData, meta
 		return retry.RetryableError(fmt.Errorf("Schedule %s still exists", d.Id()))
 	})
 }
+
+// validateArchitectScheduleDiff checks the schedule ends after it starts and its recurrences do not overlap. Overlaps
+// are not checked for rules whose windows cannot be computed.
+func validateArchitectScheduleDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if !diff.NewValueKnown("start") || !diff.NewValueKnown("end") || !diff.NewValueKnown("rrule") {
+		return nil
+	}
+	start, err := parseLocalDateTime(diff.Get("start").(string))
+	if err != nil {
+		return nil
+	}
+	end, err := parseLocalDateTime(diff.Get("end").(string))
+	if err != nil {
+		return nil
+	}
+
+	var rule *recurrenceRule
+	if rrule := diff.Get("rrule").(string); rrule != "" {
+		if rule, err = parseRecurrenceRule(rrule, architectScheduleFreqs...); err != nil {
+			log.Printf("[WARN] Not checking rrule %s for overlapping windows: %v", rrule, err)
+			rule = nil
+		}
+	}
+	if err := validateRecurrenceWindows(rule, start, end); err != nil {
+		return fmt.Errorf("invalid schedule: %v", err)
+	}
+	return nil
+}
+
+// flattenArchitectScheduleNextOccurrences lists the next windows of a schedule. Schedules have no time zone of their
+// own, so the windows are computed against the current UTC time. Rules whose windows cannot be computed are reported
+// as a warning and list no windows.
+func flattenArchitectScheduleNextOccurrences(schedule *platformclientv2.Schedule) ([]interface{}, diag.Diagnostics) {
+	if schedule.Start == nil || schedule.End == nil {
+		return nil, nil
+	}
+	var rule *recurrenceRule
+	if schedule.Rrule != nil && *schedule.Rrule != "" {
+		var err error
+		if rule, err = parseRecurrenceRule(*schedule.Rrule, architectScheduleFreqs...); err != nil {
+			return nil, diag.Diagnostics{{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Not computing next_occurrences of schedule %s", *schedule.Id),
+				Detail:   fmt.Sprintf("The windows of rrule %s cannot be computed: %v", *schedule.Rrule, err),
+			}}
+		}
+	}
+	return flattenRecurrenceWindows(getRecurrenceWindows(rule, *schedule.Start, *schedule.End, localNow(time.UTC), nextOccurrencesCount)), nil
+}
@@ -51,6 +51,7 @@ func TestAccResourceArchitectSchedules(t *testing.T) {
 					resource.TestCheckResourceAttr("genesyscloud_architect_schedules."+schedResource1, "start", start),
 					resource.TestCheckResourceAttr("genesyscloud_architect_schedules."+schedResource1, "end", end),
 					resource.TestCheckResourceAttr("genesyscloud_architect_schedules."+schedResource1, "rrule", rrule),
+					resource.TestCheckResourceAttr("genesyscloud_architect_schedules."+schedResource1, "next_occurrences.#", "5"),
 					TestDefaultHomeDivision("genesyscloud_architect_schedules."+schedResource1),
 				),
 			},
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -159,6 +161,7 @@ func ResourceAuthRole() *schema.Resource {
 		ReadContext:   ReadWithPooledClient(readAuthRole),
 		UpdateContext: UpdateWithPooledClient(updateAuthRole),
 		DeleteContext: DeleteWithPooledClient(deleteAuthRole),
+		CustomizeDiff: validateRolePermissionPoliciesDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -175,13 +178,13 @@ func ResourceAuthRole() *schema.Resource {
 				Optional:    true,
 			},
 			"permissions": {
-				Description: "General role permissions. e.g. 'group_creation'",
+				Description: "General role permissions. e.g. 'group_creation'. Permissions written as domain:entity:action are checked against the org's permission catalog during plan.",
 				Type:        schema.TypeSet,
 				Optional:    true,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 			"permission_policies": {
-				Description: "Role permission policies.",
+				Description: "Role permission policies. Domains, entities and actions are checked against the org's permission catalog during plan.",
 				Type:        schema.TypeSet,
 				Optional:    true,
 				Elem:        rolePermPolicyResource,
@@ -196,6 +199,71 @@ func ResourceAuthRole() *schema.Resource {
 	}
 }
 
+// validateRolePermissionPoliciesDiff checks the permission policies and permissions against the org's permission
+// catalog so typos fail at plan time. Validation is skipped if the catalog cannot be loaded, and create and update warn
+// about it.
+func validateRolePermissionPoliciesDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChanges("permission_policies", "permissions") || !diff.NewValueKnown("permission_policies") || !diff.NewValueKnown("permissions") {
+		return nil
+	}
+
+	catalog, err := getPermissionCatalog(meta.(*ProviderMeta).ClientConfig)
+	if err != nil {
+		log.Printf("[WARN] Skipping validation of permission policies and permissions: %v", err)
+		return nil
+	}
+	return validateRolePermissionPolicies(catalog, diff.Get("permission_policies").(*schema.Set), diff.Get("permissions").(*schema.Set))
+}
+
+// validateRolePermissionPolicies checks policies and permissions against the catalog. Only permissions written as
+// domain:entity:action are in the catalog, general permissions such as group_creation are not checked.
+func validateRolePermissionPolicies(catalog permissionCatalog, policies *schema.Set, permissions *schema.Set) error {
+	var messages []string
+	for _, policy := range policies.List() {
+		policyMap := policy.(map[string]interface{})
+		domain := policyMap["domain"].(string)
+		entityName := policyMap["entity_name"].(string)
+		if domain == "" || entityName == "" {
+			// Not known until apply
+			continue
+		}
+		conditions, _ := policyMap["conditions"].([]interface{})
+		if err := catalog.validatePolicy(domain, entityName, *buildSdkPermPolicyActions(policyMap), len(conditions) > 0); err != nil {
+			messages = append(messages, err.Error())
+		}
+	}
+	for _, permission := range *lists.SetToStringList(permissions) {
+		parts := strings.Split(permission, ":")
+		if len(parts) != 3 {
+			continue
+		}
+		if err := catalog.validatePolicy(parts[0], parts[1], []string{parts[2]}, false); err != nil {
+			messages = append(messages, fmt.Sprintf("permission %s: %v", permission, err))
+		}
+	}
+	if len(messages) > 0 {
+		sort.Strings(messages)
+		return fmt.Errorf("invalid permission policies or permissions:\n%s", strings.Join(messages, "\n"))
+	}
+	return nil
+}
+
+// rolePermissionCatalogWarning warns that the permissions of a role were not validated during plan because the
+// permission catalog could not be loaded
+func rolePermissionCatalogWarning(d *schema.ResourceData, sdkConfig *platformclientv2.Configuration) diag.Diagnostics {
+	if d.Get("permission_policies").(*schema.Set).Len() == 0 && d.Get("permissions").(*schema.Set).Len() == 0 {
+		return nil
+	}
+	if _, err := getPermissionCatalog(sdkConfig); err != nil {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Permissions of role %s were not validated", d.Get("name").(string)),
+			Detail:   fmt.Sprintf("The permission catalog could not be loaded: %v", err),
+		}}
+	}
+	return nil
+}
+
 func createAuthRole(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	name := d.Get("name").(string)
 	description := d.Get("description").(string)
@@ -227,7 +295,7 @@ func createAuthRole(ctx context.Context, d *schema.ResourceData, meta interface{
 
 	d.SetId(*role.Id)
 	log.Printf("Created role %s %s", name, *role.Id)
-	return readAuthRole(ctx, d, meta)
+	return append(readAuthRole(ctx, d, meta), rolePermissionCatalogWarning(d, sdkConfig)...)
 }
 
 func readAuthRole(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -299,7 +367,7 @@ func updateAuthRole(ctx context.Context, d *schema.ResourceData, meta interface{
 
 	log.Printf("Updated role %s", name)
 
-	return readAuthRole(ctx, d, meta)
+	return append(readAuthRole(ctx, d, meta), rolePermissionCatalogWarning(d, sdkConfig)...)
 }
 
 func deleteAuthRole(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -10,6 +10,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/mypurecloud/platform-client-sdk-go/v109/platformclientv2"
 )
@@ -578,3 +579,67 @@ func validatePermPolicyCondition(
 		return fmt.Errorf("Missing expected permission policy for role %s in state: %s %s", roleResource.Primary.ID, domain, entityName)
 	}
 }
+
+func TestValidateRolePermissionPolicies(t *testing.T) {
+	catalog := permissionCatalog{}
+	catalog.add("directory", "user", "add", false)
+	catalog.add("directory", "user", "view", true)
+	catalog.add("directory", "group", "view", false)
+
+	validate := func(policies ...map[string]interface{}) error {
+		policySet := schema.NewSet(schema.HashResource(rolePermPolicyResource), nil)
+		for _, policy := range policies {
+			if _, ok := policy["conditions"]; !ok {
+				policy["conditions"] = []interface{}{}
+			}
+			policySet.Add(policy)
+		}
+		return validateRolePermissionPolicies(catalog, policySet, schema.NewSet(schema.HashString, nil))
+	}
+	policy := func(domain string, entity string, actions ...string) map[string]interface{} {
+		return map[string]interface{}{
+			"domain":      domain,
+			"entity_name": entity,
+			"action_set":  schema.NewSet(schema.HashString, lists.StringListToInterfaceList(actions)),
+		}
+	}
+
+	if err := validate(policy("directory", "user", "add", "view"), policy("directory", "*", "view"), policy("directory", "group", "*")); err != nil {
+		t.Errorf("expected valid policies, got %v", err)
+	}
+
+	conditional := policy("directory", "user", "view")
+	conditional["conditions"] = []interface{}{map[string]interface{}{"conjunction": "AND"}}
+	if err := validate(conditional); err != nil {
+		t.Errorf("expected conditions to be allowed, got %v", err)
+	}
+
+	tests := map[string]map[string]interface{}{
+		`unknown permission domain "directroy". Did you mean "directory"?`:          policy("directroy", "user", "add"),
+		`unknown entity "usr" in permission domain directory. Did you mean "user"?`: policy("directory", "usr", "add"),
+		`unknown action "ad" for directory:user. Did you mean "add"?`:               policy("directory", "user", "ad"),
+		`unknown action "delete" for directory:*`:                                   policy("directory", "*", "delete"),
+	}
+	for expected, invalidPolicy := range tests {
+		if err := validate(invalidPolicy); err == nil || !strings.Contains(err.Error(), expected) {
+			t.Errorf("expected error %q, got %v", expected, err)
+		}
+	}
+
+	conditional = policy("directory", "user", "add")
+	conditional["conditions"] = []interface{}{map[string]interface{}{"conjunction": "AND"}}
+	if err := validate(conditional); err == nil || !strings.Contains(err.Error(), "directory:user:add does not allow conditions") {
+		t.Errorf("expected a conditions error, got %v", err)
+	}
+
+	// General permissions are not in the catalog, only domain:entity:action permissions are checked
+	emptyPolicies := schema.NewSet(schema.HashResource(rolePermPolicyResource), nil)
+	permissions := schema.NewSet(schema.HashString, []interface{}{"group_creation", "directory:user:view"})
+	if err := validateRolePermissionPolicies(catalog, emptyPolicies, permissions); err != nil {
+		t.Errorf("expected valid permissions, got %v", err)
+	}
+	permissions.Add("directory:user:veiw")
+	if err := validateRolePermissionPolicies(catalog, emptyPolicies, permissions); err == nil || !strings.Contains(err.Error(), `permission directory:user:veiw: unknown action "veiw" for directory:user. Did you mean "view"?`) {
+		t.Errorf("expected a permissions error, got %v", err)
+	}
+}
@@ -0,0 +1,496 @@
+package genesyscloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+	lists "terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mypurecloud/platform-client-sdk-go/v109/platformclientv2"
+)
+
+const (
+	groupSyncModeAuthoritative = "authoritative"
+	groupSyncModeAdditive      = "additive"
+)
+
+func ResourceGroupSync() *schema.Resource {
+	return &schema.Resource{
+		Description: `Genesys Cloud Group Sync keeps the members of a group in sync with a group of an external directory such as Azure AD or Okta.
+
+Membership is read from a CSV or JSON export in ` + "`source_file`" + `, or from an SCIM 2.0 service in ` + "`scim`" + `. Exports list an ` + "`email`" + ` and an optional ` + "`role`" + ` (member | owner) per identity. SCIM groups only have members, and members of nested groups are included.
+External identities are mapped to Genesys Cloud users by email. Identities without a user are reported in ` + "`unmatched_emails`" + ` and are added once the user exists.
+
+In ` + "`authoritative`" + ` mode the group's members are made to match the source. In ` + "`additive`" + ` mode only members added by this resource are removed. The ` + "`member_ids`" + ` attribute of the ` + "`genesyscloud_group`" + ` resource should not be set for a group managed by this resource.`,
+
+		CreateContext: CreateWithPooledClient(createGroupSync),
+		ReadContext:   ReadWithPooledClient(readGroupSync),
+		UpdateContext: UpdateWithPooledClient(updateGroupSync),
+		DeleteContext: DeleteWithPooledClient(deleteGroupSync),
+		CustomizeDiff: customizeGroupSyncDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Description: "ID of the group whose members are kept in sync.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"mode": {
+				Description:  "How membership is managed. `authoritative` removes members that are not in the source. `additive` only removes members that were added by this resource.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      groupSyncModeAuthoritative,
+				ValidateFunc: validation.StringInSlice([]string{groupSyncModeAuthoritative, groupSyncModeAdditive}, false),
+			},
+			"source_file": {
+				Description:  "Path to a CSV or JSON export of the external group. Must be a .csv or .json file.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: ValidatePath,
+				ExactlyOneOf: []string{"source_file", "scim"},
+			},
+			"scim": {
+				Description: "SCIM 2.0 service the external group is read from.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"base_url": {
+							Description:  "Base URL of the SCIM service, e.g. `https://example.okta.com/scim/v2`.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+						},
+						"group_id": {
+							Description: "ID of the group in the SCIM service.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"token": {
+							Description: "Bearer token for the SCIM service.",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+						},
+					},
+				},
+			},
+			"sync_owners": {
+				Description: "Set the group's owners to the identities with the owner role. Only exports have owners.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"member_ids": {
+				Description: "IDs of the group's members. In additive mode only the members added by this resource are listed.",
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"owner_ids": {
+				Description: "IDs of the group's owners when `sync_owners` is set. In additive mode only the owners added by this resource are listed.",
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"user_ids": {
+				Description: "ID of the user of each external identity, keyed by lower case email.",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"unmatched_emails": {
+				Description: "Emails of external identities that do not have a Genesys Cloud user.",
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// customizeGroupSyncDiff reads the source during plan so membership changes in the external directory produce a diff.
+// Emails that have not been mapped to a user yet leave the computed attributes unknown.
+func customizeGroupSyncDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	computedKeys := []string{"member_ids", "owner_ids", "user_ids", "unmatched_emails"}
+	setAllComputed := func() error {
+		for _, key := range computedKeys {
+			if err := diff.SetNewComputed(key); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if diff.Id() == "" || !diff.NewValueKnown("source_file") || !diff.NewValueKnown("scim") || diff.HasChange("mode") || diff.HasChange("sync_owners") {
+		return setAllComputed()
+	}
+
+	identities, err := getGroupSyncIdentities(ctx, diff.Get("source_file").(string), diff.Get("scim").([]interface{}))
+	if err != nil {
+		return err
+	}
+	desired, resolved := getGroupSyncDesiredIds(identities, diff.Get("user_ids").(map[string]interface{}), diff.Get("unmatched_emails").(*schema.Set))
+	if !resolved {
+		return setAllComputed()
+	}
+
+	authoritative := diff.Get("mode").(string) == groupSyncModeAuthoritative
+	managedMemberIds := *lists.SetToStringList(diff.Get("member_ids").(*schema.Set))
+	managedOwnerIds := *lists.SetToStringList(diff.Get("owner_ids").(*schema.Set))
+
+	// In authoritative mode the managed IDs are the group's members and owners. In additive mode they are compared
+	// with the group to find desired members and owners that are missing.
+	currentMemberIds, currentOwnerIds := managedMemberIds, managedOwnerIds
+	if !authoritative {
+		groupsAPI := platformclientv2.NewGroupsApiWithConfig(meta.(*ProviderMeta).ClientConfig)
+		if currentMemberIds, currentOwnerIds, err = getGroupSyncCurrentIds(diff.Id(), groupsAPI); err != nil {
+			return err
+		}
+	}
+
+	if groupSyncNeedsChange(desired[groupSyncRoleMember], managedMemberIds, currentMemberIds, authoritative) {
+		if err := diff.SetNewComputed("member_ids"); err != nil {
+			return err
+		}
+	}
+	if diff.Get("sync_owners").(bool) && groupSyncNeedsChange(desired[groupSyncRoleOwner], managedOwnerIds, currentOwnerIds, authoritative) {
+		if err := diff.SetNewComputed("owner_ids"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// groupSyncNeedsChange reports whether the managed members or owners of a group differ from the desired ones. In
+// additive mode managed IDs that are no longer desired are removed and desired IDs missing from the group are added.
+func groupSyncNeedsChange(desired []string, managed []string, current []string, authoritative bool) bool {
+	if authoritative {
+		return !lists.ListsAreEquivalent(desired, managed)
+	}
+	return len(lists.SliceDifference(managed, desired)) > 0 || len(lists.SliceDifference(desired, current)) > 0
+}
+
+// getGroupSyncManagedIds returns the members or owners managed by this resource after a sync. In additive mode
+// members and owners the group already had are not managed, unless this resource added them before.
+func getGroupSyncManagedIds(desired []string, oldManaged []string, current []string, authoritative bool) []string {
+	if authoritative {
+		return desired
+	}
+	return append(lists.SliceIntersection(oldManaged, desired), lists.SliceDifference(desired, current)...)
+}
+
+// getGroupSyncCurrentIds returns the members and owners of a group
+func getGroupSyncCurrentIds(groupId string, groupsAPI *platformclientv2.GroupsApi) ([]string, []string, error) {
+	members, _, err := groupsAPI.GetGroupIndividuals(groupId)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read members of group %s: %v", groupId, err)
+	}
+	var memberIds []string
+	if members.Entities != nil {
+		for _, member := range *members.Entities {
+			memberIds = append(memberIds, *member.Id)
+		}
+	}
+
+	group, _, err := groupsAPI.GetGroup(groupId)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read group %s: %v", groupId, err)
+	}
+	var ownerIds []string
+	if group.Owners != nil {
+		for _, owner := range *group.Owners {
+			ownerIds = append(ownerIds, *owner.Id)
+		}
+	}
+	return memberIds, ownerIds, nil
+}
+
+func createGroupSync(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	groupId := d.Get("group_id").(string)
+	d.SetId(groupId)
+
+	log.Printf("Syncing members of group %s", groupId)
+	if diagErr := syncGroupMembers(ctx, d, meta, true); diagErr != nil {
+		return diagErr
+	}
+
+	log.Printf("Synced members of group %s", groupId)
+	return readGroupSync(ctx, d, meta)
+}
+
+func readGroupSync(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	groupsAPI := platformclientv2.NewGroupsApiWithConfig(sdkConfig)
+	usersAPI := platformclientv2.NewUsersApiWithConfig(sdkConfig)
+
+	log.Printf("Reading synced members of group %s", d.Id())
+	return WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		group, resp, getErr := groupsAPI.GetGroup(d.Id())
+		if getErr != nil {
+			if IsStatus404(resp) {
+				return retry.RetryableError(fmt.Errorf("Failed to read group %s: %s", d.Id(), getErr))
+			}
+			return retry.NonRetryableError(fmt.Errorf("Failed to read group %s: %s", d.Id(), getErr))
+		}
+
+		cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, ResourceGroupSync())
+
+		memberIds, diagErr := getGroupMemberIds(d, groupsAPI)
+		if diagErr != nil {
+			return retry.NonRetryableError(fmt.Errorf("%v", diagErr))
+		}
+		var ownerIds []string
+		if group.Owners != nil {
+			for _, owner := range *group.Owners {
+				ownerIds = append(ownerIds, *owner.Id)
+			}
+		}
+
+		if d.Get("mode").(string) == groupSyncModeAdditive {
+			// Only report the members and owners added by this resource so ones added elsewhere do not show up as drift
+			memberIds = lists.SliceIntersection(memberIds, *lists.SetToStringList(d.Get("member_ids").(*schema.Set)))
+			ownerIds = lists.SliceIntersection(ownerIds, *lists.SetToStringList(d.Get("owner_ids").(*schema.Set)))
+		}
+		d.Set("member_ids", lists.StringListToSet(memberIds))
+		if d.Get("sync_owners").(bool) {
+			d.Set("owner_ids", lists.StringListToSet(ownerIds))
+		} else {
+			d.Set("owner_ids", nil)
+		}
+
+		// Emails that now have a user are dropped so the next plan picks them up
+		unmatchedEmails := d.Get("unmatched_emails").(*schema.Set)
+		for _, email := range unmatchedEmails.List() {
+			userId, err := getUserIdByEmail(email.(string), usersAPI)
+			if err != nil {
+				return retry.NonRetryableError(err)
+			}
+			if userId != "" {
+				log.Printf("Found a user for unmatched email %s of group %s", email, d.Id())
+				unmatchedEmails.Remove(email)
+			}
+		}
+		d.Set("unmatched_emails", unmatchedEmails)
+
+		log.Printf("Read synced members of group %s", d.Id())
+		return cc.CheckState()
+	})
+}
+
+func updateGroupSync(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("Syncing members of group %s", d.Id())
+	if diagErr := syncGroupMembers(ctx, d, meta, false); diagErr != nil {
+		return diagErr
+	}
+
+	log.Printf("Synced members of group %s", d.Id())
+	return readGroupSync(ctx, d, meta)
+}
+
+func deleteGroupSync(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	groupsAPI := platformclientv2.NewGroupsApiWithConfig(sdkConfig)
+
+	// Only the members are removed. Groups must keep their owners.
+	memberIds := *lists.SetToStringList(d.Get("member_ids").(*schema.Set))
+	log.Printf("Removing %d synced members from group %s", len(memberIds), d.Id())
+	if diagErr := deleteGroupMembers(d, memberIds, groupsAPI); diagErr != nil {
+		_, resp, getErr := groupsAPI.GetGroup(d.Id())
+		if getErr != nil && IsStatus404(resp) {
+			// Group already deleted
+			return nil
+		}
+		return diagErr
+	}
+
+	log.Printf("Removed synced members from group %s", d.Id())
+	return nil
+}
+
+// syncGroupMembers maps the source's identities to users and adds and removes members and owners of the group
+func syncGroupMembers(ctx context.Context, d *schema.ResourceData, meta interface{}, isCreate bool) diag.Diagnostics {
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	groupsAPI := platformclientv2.NewGroupsApiWithConfig(sdkConfig)
+	usersAPI := platformclientv2.NewUsersApiWithConfig(sdkConfig)
+
+	identities, err := getGroupSyncIdentities(ctx, d.Get("source_file").(string), d.Get("scim").([]interface{}))
+	if err != nil {
+		return diag.Errorf("Failed to read the members of group %s: %v", d.Id(), err)
+	}
+
+	userIds, unmatchedEmails, err := resolveGroupSyncUserIds(identities, usersAPI)
+	if err != nil {
+		return diag.Errorf("Failed to map the members of group %s to users: %v", d.Id(), err)
+	}
+	desired, _ := getGroupSyncDesiredIds(identities, userIds, unmatchedEmails)
+	d.Set("user_ids", userIds)
+	d.Set("unmatched_emails", unmatchedEmails)
+
+	// The IDs managed by this resource before this sync. Additive mode only removes those.
+	var oldMemberIds, oldOwnerIds []string
+	if !isCreate {
+		oldMembers, _ := d.GetChange("member_ids")
+		oldOwners, _ := d.GetChange("owner_ids")
+		oldMemberIds = *lists.SetToStringList(oldMembers.(*schema.Set))
+		oldOwnerIds = *lists.SetToStringList(oldOwners.(*schema.Set))
+	}
+	authoritative := d.Get("mode").(string) == groupSyncModeAuthoritative
+
+	currentMemberIds, diagErr := getGroupMemberIds(d, groupsAPI)
+	if diagErr != nil {
+		return diagErr
+	}
+	removable := currentMemberIds
+	if !authoritative {
+		removable = lists.SliceIntersection(currentMemberIds, oldMemberIds)
+	}
+	if diagErr := deleteGroupMembers(d, lists.SliceDifference(removable, desired[groupSyncRoleMember]), groupsAPI); diagErr != nil {
+		return diagErr
+	}
+	for _, chunk := range lists.ChunkStringSlice(lists.SliceDifference(desired[groupSyncRoleMember], currentMemberIds), 50) {
+		if diagErr := addGroupMembers(d, chunk, groupsAPI); diagErr != nil {
+			return diagErr
+		}
+	}
+
+	d.Set("member_ids", lists.StringListToSet(getGroupSyncManagedIds(desired[groupSyncRoleMember], oldMemberIds, currentMemberIds, authoritative)))
+
+	if d.Get("sync_owners").(bool) {
+		ownerIds, diagErr := updateGroupSyncOwners(d, desired[groupSyncRoleOwner], oldOwnerIds, authoritative, groupsAPI)
+		if diagErr != nil {
+			return diagErr
+		}
+		d.Set("owner_ids", lists.StringListToSet(ownerIds))
+	}
+	return nil
+}
+
+// updateGroupSyncOwners sets the group's owners and returns the owners managed by this resource. Additive mode keeps
+// the owners that were not added by this resource.
+func updateGroupSyncOwners(d *schema.ResourceData, desiredOwnerIds []string, oldOwnerIds []string, authoritative bool, groupsAPI *platformclientv2.GroupsApi) ([]string, diag.Diagnostics) {
+	var managedOwnerIds []string
+	diagErr := RetryWhen(IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+		group, resp, getErr := groupsAPI.GetGroup(d.Id())
+		if getErr != nil {
+			return resp, diag.Errorf("Failed to read group %s: %s", d.Id(), getErr)
+		}
+
+		var currentOwnerIds []string
+		if group.Owners != nil {
+			for _, owner := range *group.Owners {
+				currentOwnerIds = append(currentOwnerIds, *owner.Id)
+			}
+		}
+		managedOwnerIds = getGroupSyncManagedIds(desiredOwnerIds, oldOwnerIds, currentOwnerIds, authoritative)
+		ownerIds := desiredOwnerIds
+		if !authoritative {
+			ownerIds = append(lists.SliceDifference(currentOwnerIds, oldOwnerIds), desiredOwnerIds...)
+		}
+		if lists.ListsAreEquivalent(ownerIds, currentOwnerIds) {
+			return resp, nil
+		}
+
+		log.Printf("Updating owners of group %s", d.Id())
+		_, resp, putErr := groupsAPI.PutGroup(d.Id(), platformclientv2.Groupupdate{
+			Version:      group.Version,
+			Name:         group.Name,
+			Description:  group.Description,
+			Visibility:   group.Visibility,
+			RulesVisible: group.RulesVisible,
+			Addresses:    group.Addresses,
+			OwnerIds:     &ownerIds,
+		})
+		if putErr != nil {
+			return resp, diag.Errorf("Failed to update owners of group %s: %s", d.Id(), putErr)
+		}
+		return resp, nil
+	})
+	return managedOwnerIds, diagErr
+}
+
+func getGroupSyncIdentities(ctx context.Context, sourceFile string, scim []interface{}) ([]groupSyncIdentity, error) {
+	if len(scim) > 0 && scim[0] != nil {
+		scimMap := scim[0].(map[string]interface{})
+		return getScimGroupIdentities(ctx, groupSyncScimSettings{
+			BaseUrl: scimMap["base_url"].(string),
+			GroupId: scimMap["group_id"].(string),
+			Token:   scimMap["token"].(string),
+		})
+	}
+	return readGroupSyncFile(sourceFile)
+}
+
+// getGroupSyncDesiredIds returns the sorted user IDs of the members and owners of the source. resolved is false if an
+// email is neither mapped to a user nor known to be unmatched.
+func getGroupSyncDesiredIds(identities []groupSyncIdentity, userIds map[string]interface{}, unmatchedEmails *schema.Set) (desired map[string][]string, resolved bool) {
+	desired = make(map[string][]string)
+	resolved = true
+	for _, role := range []string{groupSyncRoleMember, groupSyncRoleOwner} {
+		ids := make([]string, 0)
+		for _, email := range groupSyncEmails(identities, role) {
+			if userId, ok := userIds[email].(string); ok {
+				if !lists.ItemInSlice(userId, ids) {
+					ids = append(ids, userId)
+				}
+			} else if !unmatchedEmails.Contains(email) {
+				resolved = false
+			}
+		}
+		sort.Strings(ids)
+		desired[role] = ids
+	}
+
+	// Emails removed from the source must be dropped from user_ids
+	sourceEmails := groupSyncEmails(identities, "")
+	for email := range userIds {
+		if !lists.ItemInSlice(email, sourceEmails) {
+			resolved = false
+		}
+	}
+	return desired, resolved
+}
+
+// resolveGroupSyncUserIds looks up the user of every email of the source
+func resolveGroupSyncUserIds(identities []groupSyncIdentity, usersAPI *platformclientv2.UsersApi) (map[string]interface{}, *schema.Set, error) {
+	emails := groupSyncEmails(identities, "")
+
+	userIds := make(map[string]interface{})
+	unmatchedEmails := schema.NewSet(schema.HashString, nil)
+	var lookupErr error
+	var mutex sync.Mutex
+	runWithConcurrency(5, emails, func(email string) {
+		userId, err := getUserIdByEmail(email, usersAPI)
+		mutex.Lock()
+		defer mutex.Unlock()
+		if err != nil {
+			lookupErr = err
+			return
+		}
+		if userId == "" {
+			log.Printf("No user found for email %s", email)
+			unmatchedEmails.Add(email)
+			return
+		}
+		userIds[email] = userId
+	})
+	if lookupErr != nil {
+		return nil, nil, lookupErr
+	}
+	return userIds, unmatchedEmails, nil
+}
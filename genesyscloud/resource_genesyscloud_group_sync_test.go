@@ -0,0 +1,215 @@
+package genesyscloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	lists "terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccResourceGroupSyncFile(t *testing.T) {
+	var (
+		groupResource     = "test-sync-group"
+		groupName         = "Terraform Group Sync-" + uuid.NewString()
+		groupSyncResource = "test-group-sync"
+		userResource1     = "test-sync-user1"
+		userResource2     = "test-sync-user2"
+		email1            = "terraform-sync1-" + uuid.NewString() + "@example.com"
+		email2            = "terraform-sync2-" + uuid.NewString() + "@example.com"
+		unknownEmail      = "terraform-sync-unknown-" + uuid.NewString() + "@example.com"
+		exportPath        = filepath.Join(t.TempDir(), "members.csv")
+	)
+
+	writeExport := func(emails ...string) {
+		content := "email,role\n" + strings.Join(emails, ",member\n") + ",member\n"
+		if err := os.WriteFile(exportPath, []byte(content), 0644); err != nil {
+			t.Fatalf("failed to write group export: %v", err)
+		}
+	}
+	config := generateBasicGroupResource(groupResource, groupName) +
+		GenerateBasicUserResource(userResource1, email1, "Sync Terraform One") +
+		GenerateBasicUserResource(userResource2, email2, "Sync Terraform Two") +
+		generateGroupSyncResource(
+			groupSyncResource,
+			"genesyscloud_group."+groupResource+".id",
+			exportPath,
+			"genesyscloud_user."+userResource1+", genesyscloud_user."+userResource2,
+		)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// Sync one user and an email without a user
+				PreConfig: func() { writeExport(email1, unknownEmail) },
+				Config:    config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_group_sync."+groupSyncResource, "member_ids.#", "1"),
+					resource.TestCheckResourceAttrPair("genesyscloud_group_sync."+groupSyncResource, "member_ids.0", "genesyscloud_user."+userResource1, "id"),
+					resource.TestCheckTypeSetElemAttr("genesyscloud_group_sync."+groupSyncResource, "unmatched_emails.*", strings.ToLower(unknownEmail)),
+				),
+			},
+			{
+				// Membership changes in the export are synced
+				PreConfig: func() { writeExport(email2) },
+				Config:    config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_group_sync."+groupSyncResource, "member_ids.#", "1"),
+					resource.TestCheckResourceAttrPair("genesyscloud_group_sync."+groupSyncResource, "member_ids.0", "genesyscloud_user."+userResource2, "id"),
+					resource.TestCheckResourceAttr("genesyscloud_group_sync."+groupSyncResource, "unmatched_emails.#", "0"),
+					resource.TestCheckResourceAttr("genesyscloud_group_sync."+groupSyncResource, "user_ids.%", "1"),
+				),
+			},
+		},
+		CheckDestroy: testVerifyGroupsDestroyed,
+	})
+}
+
+func TestGroupSyncSourceFiles(t *testing.T) {
+	dir := t.TempDir()
+	writeFile := func(name string, content string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("failed to write %s: %v", name, err)
+		}
+		return path
+	}
+
+	identities, err := readGroupSyncFile(writeFile("members.csv", "Email,Role\nAgent.One@example.com,\nlead@example.com,owner\nlead@example.com,member\n"))
+	if err != nil {
+		t.Fatalf("failed to read CSV export: %v", err)
+	}
+	assertStringList(t, lists.StringListToInterfaceList(groupSyncEmails(identities, groupSyncRoleMember)), []string{"agent.one@example.com", "lead@example.com"})
+	assertStringList(t, lists.StringListToInterfaceList(groupSyncEmails(identities, groupSyncRoleOwner)), []string{"lead@example.com"})
+
+	identities, err = readGroupSyncFile(writeFile("members.json", `[{"email": "agent.two@example.com"}, {"email": "lead@example.com", "role": "Owner"}]`))
+	if err != nil {
+		t.Fatalf("failed to read JSON export: %v", err)
+	}
+	assertStringList(t, lists.StringListToInterfaceList(groupSyncEmails(identities, "")), []string{"agent.two@example.com", "lead@example.com"})
+
+	_, err = readGroupSyncFile(writeFile("invalid.csv", "email,role\nnot-an-email,member\nagent@example.com,admin\nagent@example.com,\nAGENT@example.com,\n"))
+	if err == nil {
+		t.Fatalf("expected an invalid export to fail")
+	}
+	for _, expected := range []string{"row 1:", "row 2: role must be", "row 4: AGENT@example.com is already listed as member in row 3"} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf("expected %q in error %q", expected, err.Error())
+		}
+	}
+
+	if _, err = readGroupSyncFile(writeFile("members.csv", "mail\nagent@example.com\n")); err == nil {
+		t.Errorf("expected an unknown column to fail")
+	}
+}
+
+func TestGroupSyncScimSource(t *testing.T) {
+	responses := map[string]string{
+		"/scim/v2/Groups/sales":   `{"members": [{"value": "u1"}, {"value": "u2"}, {"value": "emea", "type": "Group"}]}`,
+		"/scim/v2/Groups/emea":    `{"members": [{"value": "u1"}, {"value": "u3"}, {"value": "sales", "type": "Group"}]}`,
+		"/scim/v2/Users/u1":       `{"userName": "one", "emails": [{"value": "one@other.com"}, {"value": "One@example.com", "primary": true}]}`,
+		"/scim/v2/Users/u2":       `{"userName": "two@example.com", "active": true}`,
+		"/scim/v2/Users/u3":       `{"userName": "three@example.com", "active": false}`,
+		"/scim/v2/Groups/missing": ``,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		body, ok := responses[r.URL.Path]
+		if !ok || body == "" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/scim+json")
+		fmt.Fprint(w, body)
+	}))
+	defer server.Close()
+
+	settings := groupSyncScimSettings{BaseUrl: server.URL + "/scim/v2/", GroupId: "sales", Token: "secret"}
+	identities, err := getScimGroupIdentities(context.Background(), settings)
+	if err != nil {
+		t.Fatalf("failed to read SCIM group: %v", err)
+	}
+	assertStringList(t, lists.StringListToInterfaceList(groupSyncEmails(identities, groupSyncRoleMember)), []string{"one@example.com", "two@example.com"})
+
+	settings.GroupId = "missing"
+	if _, err := getScimGroupIdentities(context.Background(), settings); err == nil || !strings.Contains(err.Error(), "404") {
+		t.Errorf("expected a missing group to fail, got %v", err)
+	}
+	settings.GroupId, settings.Token = "sales", ""
+	if _, err := getScimGroupIdentities(context.Background(), settings); err == nil || !strings.Contains(err.Error(), "401") {
+		t.Errorf("expected a missing token to fail, got %v", err)
+	}
+}
+
+func TestGroupSyncDesiredIds(t *testing.T) {
+	identities := []groupSyncIdentity{
+		{Email: "One@example.com", Role: groupSyncRoleMember},
+		{Email: "two@example.com", Role: groupSyncRoleMember},
+		{Email: "one@example.com", Role: groupSyncRoleOwner},
+		{Email: "unknown@example.com", Role: groupSyncRoleMember},
+	}
+	userIds := map[string]interface{}{"one@example.com": "user-1", "two@example.com": "user-2"}
+	unmatchedEmails := schema.NewSet(schema.HashString, []interface{}{"unknown@example.com"})
+
+	desired, resolved := getGroupSyncDesiredIds(identities, userIds, unmatchedEmails)
+	if !resolved {
+		t.Fatalf("expected every email to be resolved")
+	}
+	assertStringList(t, lists.StringListToInterfaceList(desired[groupSyncRoleMember]), []string{"user-1", "user-2"})
+	assertStringList(t, lists.StringListToInterfaceList(desired[groupSyncRoleOwner]), []string{"user-1"})
+
+	// A new email has not been looked up yet
+	_, resolved = getGroupSyncDesiredIds(append(identities, groupSyncIdentity{Email: "new@example.com", Role: groupSyncRoleMember}), userIds, unmatchedEmails)
+	if resolved {
+		t.Errorf("expected a new email not to be resolved")
+	}
+
+	// An email removed from the source must be dropped from user_ids
+	_, resolved = getGroupSyncDesiredIds(identities[1:2], userIds, unmatchedEmails)
+	if resolved {
+		t.Errorf("expected a removed email not to be resolved")
+	}
+}
+
+func TestGroupSyncManagedIds(t *testing.T) {
+	desired := []string{"user-1", "user-2", "user-3"}
+	oldManaged := []string{"user-1", "user-4"}
+	current := []string{"user-1", "user-2", "user-4", "user-5"}
+
+	// user-2 was already in the group, so additive mode does not manage it
+	assertStringList(t, lists.StringListToInterfaceList(getGroupSyncManagedIds(desired, oldManaged, current, false)), []string{"user-1", "user-3"})
+	assertStringList(t, lists.StringListToInterfaceList(getGroupSyncManagedIds(desired, oldManaged, current, true)), desired)
+
+	if !groupSyncNeedsChange(desired, oldManaged, current, false) {
+		t.Errorf("expected missing and removed users to need a sync")
+	}
+	if groupSyncNeedsChange([]string{"user-1", "user-2"}, []string{"user-1"}, current, false) {
+		t.Errorf("expected users already in the group not to need a sync in additive mode")
+	}
+	if !groupSyncNeedsChange([]string{"user-1", "user-2"}, []string{"user-1"}, current, true) {
+		t.Errorf("expected unmanaged users to need a sync in authoritative mode")
+	}
+}
+
+func generateGroupSyncResource(resourceID string, groupID string, sourceFile string, dependsOn string) string {
+	return fmt.Sprintf(`resource "genesyscloud_group_sync" "%s" {
+		group_id    = %s
+		source_file = "%s"
+		depends_on  = [%s]
+	}
+	`, resourceID, groupID, filepath.ToSlash(sourceFile), dependsOn)
+}
@@ -24,8 +24,10 @@ func registerDataSources(l registrar.Registrar) {
 	l.RegisterDataSource("genesyscloud_architect_emergencygroup", DataSourceArchitectEmergencyGroup())
 	l.RegisterDataSource("genesyscloud_architect_schedules", DataSourceSchedule())
 	l.RegisterDataSource("genesyscloud_architect_schedulegroups", DataSourceArchitectScheduleGroups())
+	l.RegisterDataSource("genesyscloud_architect_schedulegroup_status", dataSourceArchitectScheduleGroupStatus())
 	l.RegisterDataSource("genesyscloud_architect_user_prompt", dataSourceUserPrompt())
 	l.RegisterDataSource("genesyscloud_auth_role", dataSourceAuthRole())
+	l.RegisterDataSource("genesyscloud_auth_role_analysis", dataSourceAuthRoleAnalysis())
 	l.RegisterDataSource("genesyscloud_auth_division", dataSourceAuthDivision())
 	l.RegisterDataSource("genesyscloud_auth_division_home", DataSourceAuthDivisionHome())
 	l.RegisterDataSource("genesyscloud_employeeperformance_externalmetrics_definitions", dataSourceEmployeeperformanceExternalmetricsDefinition())
@@ -49,11 +51,13 @@ func registerDataSources(l registrar.Registrar) {
 	l.RegisterDataSource("genesyscloud_quality_forms_evaluation", dataSourceQualityFormsEvaluations())
 	l.RegisterDataSource("genesyscloud_quality_forms_survey", dataSourceQualityFormsSurvey())
 	l.RegisterDataSource("genesyscloud_recording_media_retention_policy", dataSourceRecordingMediaRetentionPolicy())
+	l.RegisterDataSource("genesyscloud_recording_media_retention_policy_simulation", dataSourceRecordingMediaRetentionPolicySimulation())
 	l.RegisterDataSource("genesyscloud_responsemanagement_library", dataSourceResponsemanagementLibrary())
 	l.RegisterDataSource("genesyscloud_responsemanagement_response", dataSourceResponsemanagementResponse())
 	l.RegisterDataSource("genesyscloud_responsemanagement_responseasset", dataSourceResponseManagamentResponseAsset())
 	l.RegisterDataSource("genesyscloud_routing_language", dataSourceRoutingLanguage())
 	l.RegisterDataSource("genesyscloud_routing_queue", DataSourceRoutingQueue())
+	l.RegisterDataSource("genesyscloud_routing_queue_simulation", dataSourceRoutingQueueSimulation())
 	l.RegisterDataSource("genesyscloud_routing_settings", dataSourceRoutingSettings())
 	l.RegisterDataSource("genesyscloud_routing_skill", dataSourceRoutingSkill())
 	l.RegisterDataSource("genesyscloud_routing_skill_group", dataSourceRoutingSkillGroup())
@@ -63,6 +67,7 @@ func registerDataSources(l registrar.Registrar) {
 
 	l.RegisterDataSource("genesyscloud_station", dataSourceStation())
 	l.RegisterDataSource("genesyscloud_user", dataSourceUser())
+	l.RegisterDataSource("genesyscloud_telephony_did_inventory", dataSourceDidInventory())
 	l.RegisterDataSource("genesyscloud_telephony_providers_edges_did", dataSourceDid())
 	l.RegisterDataSource("genesyscloud_telephony_providers_edges_did_pool", dataSourceDidPool())
 	l.RegisterDataSource("genesyscloud_telephony_providers_edges_edge_group", dataSourceEdgeGroup())
@@ -73,6 +78,7 @@ func registerDataSources(l registrar.Registrar) {
 	l.RegisterDataSource("genesyscloud_telephony_providers_edges_phonebasesettings", dataSourcePhoneBaseSettings())
 	l.RegisterDataSource("genesyscloud_telephony_providers_edges_trunk", dataSourceTrunk())
 	l.RegisterDataSource("genesyscloud_telephony_providers_edges_trunkbasesettings", dataSourceTrunkBaseSettings())
+	l.RegisterDataSource("genesyscloud_telephony_site_dial_plan_test", dataSourceSiteDialPlanTest())
 	l.RegisterDataSource("genesyscloud_webdeployments_configuration", dataSourceWebDeploymentsConfiguration())
 	l.RegisterDataSource("genesyscloud_webdeployments_deployment", dataSourceWebDeploymentsDeployment())
 	l.RegisterDataSource("genesyscloud_widget_deployment", dataSourceWidgetDeployments())
@@ -94,12 +100,14 @@ func registerResources(l registrar.Registrar) {
 	l.RegisterResource("genesyscloud_architect_ivr", ResourceArchitectIvrConfig())
 	l.RegisterResource("genesyscloud_architect_schedules", ResourceArchitectSchedules())
 	l.RegisterResource("genesyscloud_architect_schedulegroups", ResourceArchitectScheduleGroups())
+	l.RegisterResource("genesyscloud_architect_holiday_schedules", ResourceArchitectHolidaySchedules())
 	l.RegisterResource("genesyscloud_architect_user_prompt", ResourceArchitectUserPrompt())
 	l.RegisterResource("genesyscloud_auth_role", ResourceAuthRole())
 	l.RegisterResource("genesyscloud_auth_division", ResourceAuthDivision())
 	l.RegisterResource("genesyscloud_employeeperformance_externalmetrics_definitions", ResourceEmployeeperformanceExternalmetricsDefinition())
 	l.RegisterResource("genesyscloud_group", ResourceGroup())
 	l.RegisterResource("genesyscloud_group_roles", ResourceGroupRoles())
+	l.RegisterResource("genesyscloud_group_sync", ResourceGroupSync())
 	l.RegisterResource("genesyscloud_idp_adfs", ResourceIdpAdfs())
 	l.RegisterResource("genesyscloud_idp_generic", ResourceIdpGeneric())
 	l.RegisterResource("genesyscloud_idp_gsuite", ResourceIdpGsuite())
@@ -121,6 +129,7 @@ func registerResources(l registrar.Registrar) {
 	l.RegisterResource("genesyscloud_knowledge_category", ResourceKnowledgeCategory())
 	l.RegisterResource("genesyscloud_knowledge_v1_category", ResourceKnowledgeCategoryV1())
 	l.RegisterResource("genesyscloud_knowledge_label", ResourceKnowledgeLabel())
+	l.RegisterResource("genesyscloud_knowledge_import", ResourceKnowledgeImport())
 	l.RegisterResource("genesyscloud_location", ResourceLocation())
 	l.RegisterResource("genesyscloud_recording_media_retention_policy", ResourceMediaRetentionPolicy())
 	l.RegisterResource("genesyscloud_oauth_client", ResourceOAuthClient())
@@ -135,21 +144,31 @@ func registerResources(l registrar.Registrar) {
 	l.RegisterResource("genesyscloud_routing_email_route", ResourceRoutingEmailRoute())
 	l.RegisterResource("genesyscloud_routing_language", ResourceRoutingLanguage())
 	l.RegisterResource("genesyscloud_routing_queue", ResourceRoutingQueue())
+	l.RegisterResource("genesyscloud_routing_queue_members", ResourceRoutingQueueMembers())
+	l.RegisterResource("genesyscloud_routing_queue_template", ResourceRoutingQueueTemplate())
 	l.RegisterResource("genesyscloud_routing_skill", ResourceRoutingSkill())
+	l.RegisterResource("genesyscloud_routing_skill_assignments", ResourceRoutingSkillAssignments())
 	l.RegisterResource("genesyscloud_routing_skill_group", ResourceRoutingSkillGroup())
 	l.RegisterResource("genesyscloud_routing_settings", ResourceRoutingSettings())
 	l.RegisterResource("genesyscloud_routing_utilization", ResourceRoutingUtilization())
 	l.RegisterResource("genesyscloud_routing_wrapupcode", ResourceRoutingWrapupCode())
+	l.RegisterResource("genesyscloud_telephony_compliance_check", ResourceTelephonyComplianceCheck())
+	l.RegisterResource("genesyscloud_telephony_did_assignment", ResourceTelephonyDidAssignment())
+	l.RegisterResource("genesyscloud_telephony_phone_bulk", ResourcePhoneBulk())
 	l.RegisterResource("genesyscloud_telephony_providers_edges_did_pool", ResourceTelephonyDidPool())
 	l.RegisterResource("genesyscloud_telephony_providers_edges_edge_group", ResourceEdgeGroup())
 	l.RegisterResource("genesyscloud_telephony_providers_edges_extension_pool", ResourceTelephonyExtensionPool())
 	l.RegisterResource("genesyscloud_telephony_providers_edges_phone", ResourcePhone())
 	l.RegisterResource("genesyscloud_telephony_providers_edges_site", ResourceSite())
+	l.RegisterResource("genesyscloud_telephony_providers_edges_site_number_plan", ResourceSiteNumberPlan())
+	l.RegisterResource("genesyscloud_telephony_providers_edges_site_outbound_route", ResourceSiteOutboundRoute())
 	l.RegisterResource("genesyscloud_telephony_providers_edges_phonebasesettings", ResourcePhoneBaseSettings())
 	l.RegisterResource("genesyscloud_telephony_providers_edges_trunkbasesettings", ResourceTrunkBaseSettings())
 	l.RegisterResource("genesyscloud_telephony_providers_edges_trunk", ResourceTrunk())
 	l.RegisterResource("genesyscloud_user", ResourceUser())
+	l.RegisterResource("genesyscloud_user_bulk", ResourceUserBulk())
 	l.RegisterResource("genesyscloud_user_roles", ResourceUserRoles())
+	l.RegisterResource("genesyscloud_user_station", ResourceUserStation())
 	l.RegisterResource("genesyscloud_webdeployments_configuration", ResourceWebDeploymentConfiguration())
 	l.RegisterResource("genesyscloud_webdeployments_deployment", ResourceWebDeployment())
 	l.RegisterResource("genesyscloud_widget_deployment", ResourceWidgetDeployment())
@@ -210,11 +229,14 @@ func registerExporters(l registrar.Registrar) {
 	l.RegisterExporter("genesyscloud_telephony_providers_edges_extension_pool", TelephonyExtensionPoolExporter())
 	l.RegisterExporter("genesyscloud_telephony_providers_edges_phone", PhoneExporter())
 	l.RegisterExporter("genesyscloud_telephony_providers_edges_site", SiteExporter())
+	l.RegisterExporter("genesyscloud_telephony_providers_edges_site_number_plan", SiteNumberPlanExporter())
+	l.RegisterExporter("genesyscloud_telephony_providers_edges_site_outbound_route", SiteOutboundRouteExporter())
 	l.RegisterExporter("genesyscloud_telephony_providers_edges_phonebasesettings", PhoneBaseSettingsExporter())
 	l.RegisterExporter("genesyscloud_telephony_providers_edges_trunkbasesettings", TrunkBaseSettingsExporter())
 	l.RegisterExporter("genesyscloud_telephony_providers_edges_trunk", TrunkExporter())
 	l.RegisterExporter("genesyscloud_user", UserExporter())
 	l.RegisterExporter("genesyscloud_user_roles", UserRolesExporter())
+	l.RegisterExporter("genesyscloud_user_station", UserStationExporter())
 	l.RegisterExporter("genesyscloud_webdeployments_configuration", WebDeploymentConfigurationExporter())
 	l.RegisterExporter("genesyscloud_webdeployments_deployment", WebDeploymentExporter())
 	l.RegisterExporter("genesyscloud_widget_deployment", WidgetDeploymentExporter())
@@ -40,12 +40,14 @@ func (r *registerTestInstance) registerTestResources() {
 	providerResources["genesyscloud_architect_ivr"] = ResourceArchitectIvrConfig()
 	providerResources["genesyscloud_architect_schedules"] = ResourceArchitectSchedules()
 	providerResources["genesyscloud_architect_schedulegroups"] = ResourceArchitectScheduleGroups()
+	providerResources["genesyscloud_architect_holiday_schedules"] = ResourceArchitectHolidaySchedules()
 	providerResources["genesyscloud_architect_user_prompt"] = ResourceArchitectUserPrompt()
 	providerResources["genesyscloud_auth_role"] = ResourceAuthRole()
 	providerResources["genesyscloud_auth_division"] = ResourceAuthDivision()
 	providerResources["genesyscloud_employeeperformance_externalmetrics_definitions"] = ResourceEmployeeperformanceExternalmetricsDefinition()
 	providerResources["genesyscloud_group"] = ResourceGroup()
 	providerResources["genesyscloud_group_roles"] = ResourceGroupRoles()
+	providerResources["genesyscloud_group_sync"] = ResourceGroupSync()
 	providerResources["genesyscloud_idp_adfs"] = ResourceIdpAdfs()
 
 	providerResources["genesyscloud_routing_wrapupcode"] = ResourceRoutingWrapupCode()
@@ -67,6 +69,7 @@ func (r *registerTestInstance) registerTestResources() {
 	providerResources["genesyscloud_knowledge_document_variation"] = ResourceKnowledgeDocumentVariation()
 	providerResources["genesyscloud_knowledge_category"] = ResourceKnowledgeCategory()
 	providerResources["genesyscloud_knowledge_label"] = ResourceKnowledgeLabel()
+	providerResources["genesyscloud_knowledge_import"] = ResourceKnowledgeImport()
 	providerResources["genesyscloud_location"] = ResourceLocation()
 	providerResources["genesyscloud_recording_media_retention_policy"] = ResourceMediaRetentionPolicy()
 	providerResources["genesyscloud_oauth_client"] = ResourceOAuthClient()
@@ -81,21 +84,31 @@ func (r *registerTestInstance) registerTestResources() {
 	providerResources["genesyscloud_routing_email_route"] = ResourceRoutingEmailRoute()
 	providerResources["genesyscloud_routing_language"] = ResourceRoutingLanguage()
 	providerResources["genesyscloud_routing_queue"] = ResourceRoutingQueue()
+	providerResources["genesyscloud_routing_queue_members"] = ResourceRoutingQueueMembers()
+	providerResources["genesyscloud_routing_queue_template"] = ResourceRoutingQueueTemplate()
 	providerResources["genesyscloud_routing_skill"] = ResourceRoutingSkill()
+	providerResources["genesyscloud_routing_skill_assignments"] = ResourceRoutingSkillAssignments()
 	providerResources["genesyscloud_routing_skill_group"] = ResourceRoutingSkillGroup()
 	providerResources["genesyscloud_routing_settings"] = ResourceRoutingSettings()
 	providerResources["genesyscloud_routing_utilization"] = ResourceRoutingUtilization()
 	providerResources["genesyscloud_routing_wrapupcode"] = ResourceRoutingWrapupCode()
+	providerResources["genesyscloud_telephony_compliance_check"] = ResourceTelephonyComplianceCheck()
+	providerResources["genesyscloud_telephony_did_assignment"] = ResourceTelephonyDidAssignment()
+	providerResources["genesyscloud_telephony_phone_bulk"] = ResourcePhoneBulk()
 	providerResources["genesyscloud_telephony_providers_edges_did_pool"] = ResourceTelephonyDidPool()
 	providerResources["genesyscloud_telephony_providers_edges_edge_group"] = ResourceEdgeGroup()
 	providerResources["genesyscloud_telephony_providers_edges_extension_pool"] = ResourceTelephonyExtensionPool()
 	providerResources["genesyscloud_telephony_providers_edges_phone"] = ResourcePhone()
 	providerResources["genesyscloud_telephony_providers_edges_site"] = ResourceSite()
+	providerResources["genesyscloud_telephony_providers_edges_site_number_plan"] = ResourceSiteNumberPlan()
+	providerResources["genesyscloud_telephony_providers_edges_site_outbound_route"] = ResourceSiteOutboundRoute()
 	providerResources["genesyscloud_telephony_providers_edges_phonebasesettings"] = ResourcePhoneBaseSettings()
 	providerResources["genesyscloud_telephony_providers_edges_trunkbasesettings"] = ResourceTrunkBaseSettings()
 	providerResources["genesyscloud_telephony_providers_edges_trunk"] = ResourceTrunk()
 	providerResources["genesyscloud_user"] = ResourceUser()
+	providerResources["genesyscloud_user_bulk"] = ResourceUserBulk()
 	providerResources["genesyscloud_user_roles"] = ResourceUserRoles()
+	providerResources["genesyscloud_user_station"] = ResourceUserStation()
 	providerResources["genesyscloud_webdeployments_configuration"] = ResourceWebDeploymentConfiguration()
 	providerResources["genesyscloud_webdeployments_deployment"] = ResourceWebDeployment()
 	providerResources["genesyscloud_widget_deployment"] = ResourceWidgetDeployment()
@@ -119,8 +132,10 @@ func (r *registerTestInstance) registerTestDataSources() {
 	providerDataSources["genesyscloud_architect_emergencygroup"] = DataSourceArchitectEmergencyGroup()
 	providerDataSources["genesyscloud_architect_schedules"] = DataSourceSchedule()
 	providerDataSources["genesyscloud_architect_schedulegroups"] = DataSourceArchitectScheduleGroups()
+	providerDataSources["genesyscloud_architect_schedulegroup_status"] = dataSourceArchitectScheduleGroupStatus()
 	providerDataSources["genesyscloud_architect_user_prompt"] = dataSourceUserPrompt()
 	providerDataSources["genesyscloud_auth_role"] = dataSourceAuthRole()
+	providerDataSources["genesyscloud_auth_role_analysis"] = dataSourceAuthRoleAnalysis()
 	providerDataSources["genesyscloud_auth_division"] = dataSourceAuthDivision()
 	providerDataSources["genesyscloud_auth_division_home"] = DataSourceAuthDivisionHome()
 	providerDataSources["genesyscloud_employeeperformance_externalmetrics_definitions"] = dataSourceEmployeeperformanceExternalmetricsDefinition()
@@ -145,11 +160,13 @@ func (r *registerTestInstance) registerTestDataSources() {
 	providerDataSources["genesyscloud_quality_forms_evaluation"] = dataSourceQualityFormsEvaluations()
 	providerDataSources["genesyscloud_quality_forms_survey"] = dataSourceQualityFormsSurvey()
 	providerDataSources["genesyscloud_recording_media_retention_policy"] = dataSourceRecordingMediaRetentionPolicy()
+	providerDataSources["genesyscloud_recording_media_retention_policy_simulation"] = dataSourceRecordingMediaRetentionPolicySimulation()
 	providerDataSources["genesyscloud_responsemanagement_library"] = dataSourceResponsemanagementLibrary()
 	providerDataSources["genesyscloud_responsemanagement_response"] = dataSourceResponsemanagementResponse()
 	providerDataSources["genesyscloud_responsemanagement_responseasset"] = dataSourceResponseManagamentResponseAsset()
 	providerDataSources["genesyscloud_routing_language"] = dataSourceRoutingLanguage()
 	providerDataSources["genesyscloud_routing_queue"] = DataSourceRoutingQueue()
+	providerDataSources["genesyscloud_routing_queue_simulation"] = dataSourceRoutingQueueSimulation()
 	providerDataSources["genesyscloud_routing_settings"] = dataSourceRoutingSettings()
 	providerDataSources["genesyscloud_routing_skill"] = dataSourceRoutingSkill()
 	providerDataSources["genesyscloud_routing_skill_group"] = dataSourceRoutingSkillGroup()
@@ -158,6 +175,7 @@ func (r *registerTestInstance) registerTestDataSources() {
 
 	providerDataSources["genesyscloud_station"] = dataSourceStation()
 	providerDataSources["genesyscloud_user"] = dataSourceUser()
+	providerDataSources["genesyscloud_telephony_did_inventory"] = dataSourceDidInventory()
 	providerDataSources["genesyscloud_telephony_providers_edges_did"] = dataSourceDid()
 	providerDataSources["genesyscloud_telephony_providers_edges_did_pool"] = dataSourceDidPool()
 	providerDataSources["genesyscloud_telephony_providers_edges_edge_group"] = dataSourceEdgeGroup()
@@ -168,6 +186,7 @@ func (r *registerTestInstance) registerTestDataSources() {
 	providerDataSources["genesyscloud_telephony_providers_edges_phonebasesettings"] = dataSourcePhoneBaseSettings()
 	providerDataSources["genesyscloud_telephony_providers_edges_trunk"] = dataSourceTrunk()
 	providerDataSources["genesyscloud_telephony_providers_edges_trunkbasesettings"] = dataSourceTrunkBaseSettings()
+	providerDataSources["genesyscloud_telephony_site_dial_plan_test"] = dataSourceSiteDialPlanTest()
 	providerDataSources["genesyscloud_webdeployments_configuration"] = dataSourceWebDeploymentsConfiguration()
 	providerDataSources["genesyscloud_webdeployments_deployment"] = dataSourceWebDeploymentsDeployment()
 	providerDataSources["genesyscloud_widget_deployment"] = dataSourceWidgetDeployments()
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"os"
 	"strings"
 	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
 	"time"
@@ -25,11 +26,24 @@ var (
 	knowledgeDocumentVariation = &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"body": {
-				Description: "The content for the variation.",
-				Type:        schema.TypeList,
-				MaxItems:    1,
+				Description:   "The content for the variation.",
+				Type:          schema.TypeList,
+				MaxItems:      1,
+				Optional:      true,
+				Elem:          documentBody,
+				ConflictsWith: []string{"knowledge_document_variation.0.body_filepath"},
+			},
+			"body_filepath": {
+				Description:   "Path to a Markdown (.md, .markdown) or HTML (.html, .htm) file holding the content for the variation. Used instead of `body`.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  ValidatePath,
+				ConflictsWith: []string{"knowledge_document_variation.0.body"},
+			},
+			"body_file_content_hash": {
+				Description: "Hash value of the `body_filepath` file content. Used to detect changes.",
+				Type:        schema.TypeString,
 				Optional:    true,
-				Elem:        documentBody,
 			},
 			"document_version": {
 				Description: "The version of the document.",
@@ -277,6 +291,10 @@ func KnowledgeDocumentVariationExporter() *resourceExporter.ResourceExporter {
 			"knowledge_base_id":     {RefType: "genesyscloud_knowledge_knowledgebase"},
 			"knowledge_document_id": {RefType: "genesyscloud_knowledge_document"},
 		},
+		CustomFileWriter: resourceExporter.CustomFileWriterSettings{
+			RetrieveAndWriteFilesFunc: KnowledgeDocumentVariationBodyResolver,
+			SubDirectory:              "knowledge",
+		},
 	}
 }
 
@@ -332,7 +350,10 @@ func createKnowledgeDocumentVariation(ctx context.Context, d *schema.ResourceDat
 	sdkConfig := meta.(*ProviderMeta).ClientConfig
 	knowledgeAPI := platformclientv2.NewKnowledgeApiWithConfig(sdkConfig)
 
-	knowledgeDocumentVariationRequest := buildKnowledgeDocumentVariation(knowledgeDocumentVariation)
+	knowledgeDocumentVariationRequest, err := buildKnowledgeDocumentVariation(knowledgeDocumentVariation)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	log.Printf("Creating knowledge document variation for document %s", knowledgeDocumentId)
 
@@ -444,7 +465,15 @@ func readKnowledgeDocumentVariation(ctx context.Context, d *schema.ResourceData,
 		d.SetId(newId)
 		d.Set("knowledge_base_id", *knowledgeDocumentVariation.Document.KnowledgeBase.Id)
 		d.Set("knowledge_document_id", documentResourceId)
-		d.Set("knowledge_document_variation", flattenKnowledgeDocumentVariation(*knowledgeDocumentVariation))
+		flattenedVariation := flattenKnowledgeDocumentVariation(*knowledgeDocumentVariation)
+		if bodyFilepath, ok := d.GetOk("knowledge_document_variation.0.body_filepath"); ok {
+			// The body is managed through the file, so only its path and hash are kept in state
+			variationMap := flattenedVariation[0].(map[string]interface{})
+			delete(variationMap, "body")
+			variationMap["body_filepath"] = bodyFilepath
+			variationMap["body_file_content_hash"] = d.Get("knowledge_document_variation.0.body_file_content_hash")
+		}
+		d.Set("knowledge_document_variation", flattenedVariation)
 
 		if knowledgeDocumentVariation.DocumentVersion != nil && knowledgeDocumentVariation.DocumentVersion.Id != nil && len(*knowledgeDocumentVariation.DocumentVersion.Id) > 0 {
 			d.Set("published", true)
@@ -481,7 +510,10 @@ func updateKnowledgeDocumentVariation(ctx context.Context, d *schema.ResourceDat
 			return resp, diag.Errorf("Failed to read knowledge document variation %s: %s", documentVariationId, getErr)
 		}
 
-		knowledgeDocumentVariationUpdate := buildKnowledgeDocumentVariationUpdate(knowledgeDocumentVariation)
+		knowledgeDocumentVariationUpdate, buildErr := buildKnowledgeDocumentVariationUpdate(knowledgeDocumentVariation)
+		if buildErr != nil {
+			return resp, diag.FromErr(buildErr)
+		}
 
 		log.Printf("Updating knowledge document variation %s", documentVariationId)
 		_, resp, putErr := knowledgeAPI.PatchKnowledgeKnowledgebaseDocumentVariation(documentVariationId, knowledgeDocumentId, knowledgeBaseId, *knowledgeDocumentVariationUpdate)
@@ -723,19 +755,37 @@ func buildVariationBody(bodyIn map[string]interface{}) *platformclientv2.Documen
 	return nil
 }
 
-func buildKnowledgeDocumentVariation(variationIn map[string]interface{}) *platformclientv2.Documentvariation {
-	variationOut := platformclientv2.Documentvariation{
-		Body: buildVariationBody(variationIn),
+// buildVariationBodyFromFile reads the variation content from the body_filepath file
+func buildVariationBodyFromFile(filepath string) (*platformclientv2.Documentbody, error) {
+	content, err := os.ReadFile(filepath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read variation body file %s: %v", filepath, err)
+	}
+	_, blocks, err := documentBodyFromContent(filepath, content, false)
+	if err != nil {
+		return nil, err
 	}
-	return &variationOut
+	return buildVariationBody(map[string]interface{}{
+		"body": []interface{}{map[string]interface{}{"blocks": blocks}},
+	}), nil
 }
 
-func buildKnowledgeDocumentVariationUpdate(variationIn map[string]interface{}) *platformclientv2.Documentvariation {
+func buildKnowledgeDocumentVariation(variationIn map[string]interface{}) (*platformclientv2.Documentvariation, error) {
 	variationOut := platformclientv2.Documentvariation{
 		Body: buildVariationBody(variationIn),
 	}
+	if filepath, ok := variationIn["body_filepath"].(string); ok && filepath != "" {
+		body, err := buildVariationBodyFromFile(filepath)
+		if err != nil {
+			return nil, err
+		}
+		variationOut.Body = body
+	}
+	return &variationOut, nil
+}
 
-	return &variationOut
+func buildKnowledgeDocumentVariationUpdate(variationIn map[string]interface{}) (*platformclientv2.Documentvariation, error) {
+	return buildKnowledgeDocumentVariation(variationIn)
 }
 
 func flattenDocumentText(textIn platformclientv2.Documenttext) []interface{} {
@@ -0,0 +1,401 @@
+package genesyscloud
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v109/platformclientv2"
+)
+
+func ResourceKnowledgeImport() *schema.Resource {
+	return &schema.Resource{
+		Description: `Genesys Cloud Knowledge import. Keeps the documents of a knowledge base in sync with a local directory of Markdown (.md, .markdown) or HTML (.html, .htm) files.
+
+Each file becomes one document with a single variation. An optional YAML front-matter block delimited by ` + "`---`" + ` lines sets the ` + "`title`, `category`, `labels`, `alternatives` (list of `phrase`/`autocomplete`) and `visible`" + ` of the document. Without a title the first h1 heading, or else the file name, is used.
+New and changed files are sent through a knowledge import job. Files removed from the directory have their documents deleted. The relative file path is stored as the external ID of each document.
+Files that fail to import are reported and imported again on the next apply. When the directory is first applied, failed files are reported as warnings so the documents of the other files are kept.`,
+
+		CreateContext: CreateWithPooledClient(createKnowledgeImport),
+		ReadContext:   ReadWithPooledClient(readKnowledgeImport),
+		UpdateContext: UpdateWithPooledClient(updateKnowledgeImport),
+		DeleteContext: DeleteWithPooledClient(deleteKnowledgeImport),
+		CustomizeDiff: customizeKnowledgeImportDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+		},
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"knowledge_base_id": {
+				Description: "Knowledge base id",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"source_directory": {
+				Description: "Directory holding the article files. Subdirectories are included, hidden files and directories are skipped.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"published": {
+				Description: "If true, a new version of each imported document is published. If false, imported documents are left as drafts.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"file_content_hashes": {
+				Description: "SHA-256 hash of each article file, keyed by the path relative to `source_directory`. Used to detect changed files.",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"document_ids": {
+				Description: "ID of the knowledge document created for each article file, keyed by the path relative to `source_directory`.",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"import_job_id": {
+				Description: "ID of the most recent import job.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// customizeKnowledgeImportDiff parses the source directory during plan so broken files fail early and changed files produce a diff
+func customizeKnowledgeImportDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if !diff.NewValueKnown("source_directory") {
+		if err := diff.SetNewComputed("file_content_hashes"); err != nil {
+			return err
+		}
+		return diff.SetNewComputed("document_ids")
+	}
+
+	articles, err := readKnowledgeArticles(diff.Get("source_directory").(string))
+	if err != nil {
+		return err
+	}
+
+	hashes := getKnowledgeArticleHashes(articles)
+	oldHashes := diff.Get("file_content_hashes").(map[string]interface{})
+	if reflect.DeepEqual(oldHashes, hashes) {
+		return nil
+	}
+	if err := diff.SetNew("file_content_hashes", hashes); err != nil {
+		return err
+	}
+
+	documentIds := diff.Get("document_ids").(map[string]interface{})
+	for path := range hashes {
+		if _, ok := documentIds[path]; !ok {
+			return diff.SetNewComputed("document_ids")
+		}
+	}
+	if len(documentIds) != len(hashes) {
+		return diff.SetNewComputed("document_ids")
+	}
+	return nil
+}
+
+func createKnowledgeImport(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+
+	log.Printf("Creating knowledge import for directory %s", d.Get("source_directory").(string))
+	d.SetId(uuid.NewString())
+
+	documentErr, diagErr := syncKnowledgeImport(ctx, d, sdkConfig, map[string]interface{}{}, map[string]interface{}{})
+	if documentErr.HasError() || diagErr.HasError() {
+		if len(d.Get("document_ids").(map[string]interface{})) == 0 {
+			d.SetId("")
+			return append(documentErr, diagErr...)
+		}
+		if diagErr.HasError() {
+			return append(documentErr, diagErr...)
+		}
+		// Failing the create would taint the resource and replacing it would delete every imported document.
+		// Failed documents have no hash so they are imported again on the next apply.
+		documentErr = diagErrorsToWarnings(documentErr)
+	}
+
+	log.Printf("Created knowledge import %s", d.Id())
+	return append(documentErr, readKnowledgeImport(ctx, d, meta)...)
+}
+
+func readKnowledgeImport(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	knowledgeAPI := platformclientv2.NewKnowledgeApiWithConfig(sdkConfig)
+	knowledgeBaseId := d.Get("knowledge_base_id").(string)
+
+	log.Printf("Reading knowledge import %s", d.Id())
+	return WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, ResourceKnowledgeImport())
+
+		documentIds := d.Get("document_ids").(map[string]interface{})
+		hashes := d.Get("file_content_hashes").(map[string]interface{})
+		for path, documentId := range documentIds {
+			_, resp, getErr := knowledgeAPI.GetKnowledgeKnowledgebaseDocument(knowledgeBaseId, documentId.(string), nil, "")
+			if getErr != nil {
+				if IsStatus404(resp) {
+					// Document was removed outside of Terraform. Dropping it from state makes the next apply import the file again.
+					log.Printf("Knowledge document %s for %s no longer exists", documentId, path)
+					delete(documentIds, path)
+					delete(hashes, path)
+					continue
+				}
+				return retry.NonRetryableError(fmt.Errorf("Failed to read knowledge document %s for %s: %s", documentId, path, getErr))
+			}
+		}
+		d.Set("document_ids", documentIds)
+		d.Set("file_content_hashes", hashes)
+
+		log.Printf("Read knowledge import %s", d.Id())
+		return cc.CheckState()
+	})
+}
+
+func updateKnowledgeImport(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+
+	oldHashes, _ := d.GetChange("file_content_hashes")
+	oldDocumentIds, _ := d.GetChange("document_ids")
+
+	log.Printf("Updating knowledge import %s", d.Id())
+	documentErr, diagErr := syncKnowledgeImport(ctx, d, sdkConfig, oldHashes.(map[string]interface{}), oldDocumentIds.(map[string]interface{}))
+	if diagErr = append(documentErr, diagErr...); diagErr.HasError() {
+		return diagErr
+	}
+
+	log.Printf("Updated knowledge import %s", d.Id())
+	return readKnowledgeImport(ctx, d, meta)
+}
+
+func deleteKnowledgeImport(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	knowledgeAPI := platformclientv2.NewKnowledgeApiWithConfig(sdkConfig)
+	knowledgeBaseId := d.Get("knowledge_base_id").(string)
+
+	for path, documentId := range d.Get("document_ids").(map[string]interface{}) {
+		log.Printf("Deleting knowledge document %s for %s", documentId, path)
+		if resp, err := knowledgeAPI.DeleteKnowledgeKnowledgebaseDocument(knowledgeBaseId, documentId.(string)); err != nil && !IsStatus404(resp) {
+			return diag.Errorf("Failed to delete knowledge document %s for %s: %s", documentId, path, err)
+		}
+	}
+
+	log.Printf("Deleted knowledge import %s", d.Id())
+	return nil
+}
+
+// syncKnowledgeImport imports new and changed articles, deletes documents of removed articles and stores the resulting document IDs and hashes.
+// Articles that failed to import or publish are returned separately from the other errors and are left without a hash.
+func syncKnowledgeImport(ctx context.Context, d *schema.ResourceData, sdkConfig *platformclientv2.Configuration, oldHashes map[string]interface{}, oldDocumentIds map[string]interface{}) (diag.Diagnostics, diag.Diagnostics) {
+	knowledgeAPI := platformclientv2.NewKnowledgeApiWithConfig(sdkConfig)
+	knowledgeBaseId := d.Get("knowledge_base_id").(string)
+	published := d.Get("published").(bool)
+
+	articles, err := readKnowledgeArticles(d.Get("source_directory").(string))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	documentIds := make(map[string]string)
+	hashes := make(map[string]interface{})
+	for path, documentId := range oldDocumentIds {
+		documentIds[path] = documentId.(string)
+	}
+
+	changedArticles := make([]knowledgeArticle, 0)
+	articlePaths := make(map[string]bool)
+	for _, article := range articles {
+		articlePaths[article.Path] = true
+		if documentIds[article.Path] != "" && oldHashes[article.Path] == article.ContentHash {
+			hashes[article.Path] = article.ContentHash
+			continue
+		}
+		changedArticles = append(changedArticles, article)
+	}
+
+	// Save what has been applied so far, including on failure
+	defer func() {
+		documentIdsOut := make(map[string]interface{})
+		for path, documentId := range documentIds {
+			documentIdsOut[path] = documentId
+		}
+		d.Set("document_ids", documentIdsOut)
+		d.Set("file_content_hashes", hashes)
+	}()
+
+	removedPaths := make([]string, 0)
+	for path := range documentIds {
+		if !articlePaths[path] {
+			removedPaths = append(removedPaths, path)
+		}
+	}
+	sort.Strings(removedPaths)
+	for _, path := range removedPaths {
+		log.Printf("Deleting knowledge document %s for removed file %s", documentIds[path], path)
+		if resp, err := knowledgeAPI.DeleteKnowledgeKnowledgebaseDocument(knowledgeBaseId, documentIds[path]); err != nil && !IsStatus404(resp) {
+			return nil, diag.Errorf("Failed to delete knowledge document %s for removed file %s: %s", documentIds[path], path, err)
+		}
+		delete(documentIds, path)
+	}
+
+	if len(changedArticles) == 0 {
+		return nil, nil
+	}
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+	importFile := buildKnowledgeImportFile(knowledgeBaseId, changedArticles, documentIds)
+	importJobId, documentErr, diagErr := runKnowledgeImportJob(ctx, knowledgeAPI, knowledgeBaseId, importFile, timeout)
+	if importJobId != "" {
+		d.Set("import_job_id", importJobId)
+	}
+	if diagErr != nil {
+		return documentErr, diagErr
+	}
+
+	// Documents are matched back to their files through the external ID
+	documents, diagErr := getAllKnowledgeDocumentEntities(*knowledgeAPI, &platformclientv2.Knowledgebase{Id: &knowledgeBaseId}, sdkConfig)
+	if diagErr != nil {
+		return documentErr, diagErr
+	}
+	idsByExternalId := make(map[string]string)
+	for _, document := range *documents {
+		if document.ExternalId != nil && document.Id != nil {
+			idsByExternalId[*document.ExternalId] = *document.Id
+		}
+	}
+
+	var missing []string
+	for _, article := range changedArticles {
+		documentId, ok := idsByExternalId[article.Path]
+		if !ok {
+			missing = append(missing, article.Path)
+			continue
+		}
+		documentIds[article.Path] = documentId
+
+		if published {
+			if _, _, err := knowledgeAPI.PostKnowledgeKnowledgebaseDocumentVersions(knowledgeBaseId, documentId, platformclientv2.Knowledgedocumentversion{}); err != nil {
+				documentErr = append(documentErr, diag.Diagnostic{Severity: diag.Error, Summary: fmt.Sprintf("Failed to publish knowledge document %s for %s: %s", documentId, article.Path, err)})
+				continue
+			}
+		}
+		hashes[article.Path] = article.ContentHash
+	}
+	if len(missing) > 0 && !documentErr.HasError() {
+		documentErr = append(documentErr, diag.Diagnostic{Severity: diag.Error, Summary: fmt.Sprintf("Import job %s completed but no document was found for %s", importJobId, strings.Join(missing, ", "))})
+	}
+
+	return documentErr, nil
+}
+
+// runKnowledgeImportJob uploads the import file, starts an import job and waits for it to finish. Errors of single
+// documents of a completed job are returned separately from the other errors.
+func runKnowledgeImportJob(ctx context.Context, knowledgeAPI *platformclientv2.KnowledgeApi, knowledgeBaseId string, importFile knowledgeImportFile, timeout time.Duration) (string, diag.Diagnostics, diag.Diagnostics) {
+	content, err := json.Marshal(importFile)
+	if err != nil {
+		return "", nil, diag.Errorf("Failed to build knowledge import file: %s", err)
+	}
+
+	fileName := fmt.Sprintf("terraform-knowledge-import-%s.json", uuid.NewString())
+	contentType := "application/json"
+	upload, _, err := knowledgeAPI.PostKnowledgeDocumentuploads(platformclientv2.Uploadurlrequest{
+		FileName:    &fileName,
+		ContentType: &contentType,
+	})
+	if err != nil {
+		return "", nil, diag.Errorf("Failed to get upload URL for knowledge import: %s", err)
+	}
+
+	headers := make(map[string]string)
+	if upload.Headers != nil {
+		headers = *upload.Headers
+	}
+	if _, err := files.NewS3Uploader(bytes.NewReader(content), nil, nil, headers, "PUT", *upload.Url).Upload(); err != nil {
+		return "", nil, diag.Errorf("Failed to upload knowledge import file: %s", err)
+	}
+
+	fileType := "Json"
+	skipConfirmationStep := true
+	importJob, _, err := knowledgeAPI.PostKnowledgeKnowledgebaseImportJobs(knowledgeBaseId, platformclientv2.Knowledgeimportjobrequest{
+		UploadKey:            upload.UploadKey,
+		FileType:             &fileType,
+		SkipConfirmationStep: &skipConfirmationStep,
+	})
+	if err != nil {
+		return "", nil, diag.Errorf("Failed to create knowledge import job: %s", err)
+	}
+	importJobId := *importJob.Id
+	log.Printf("Started knowledge import job %s with %d documents", importJobId, len(importFile.Documents))
+
+	var documentErr diag.Diagnostics
+	diagErr := WithRetries(ctx, timeout, func() *retry.RetryError {
+		job, _, err := knowledgeAPI.GetKnowledgeKnowledgebaseImportJob(knowledgeBaseId, importJobId, nil)
+		if err != nil {
+			return retry.NonRetryableError(fmt.Errorf("Failed to read knowledge import job %s: %s", importJobId, err))
+		}
+
+		status := ""
+		if job.Status != nil {
+			status = *job.Status
+		}
+		switch status {
+		case "Completed":
+			if importErrors := formatKnowledgeImportErrors(job.Report, importFile); importErrors != "" {
+				documentErr = diag.Diagnostics{{Severity: diag.Error, Summary: fmt.Sprintf("Knowledge import job %s completed with errors", importJobId), Detail: importErrors}}
+			}
+			return nil
+		case "ValidationCompleted":
+			startedStatus := "Started"
+			if _, _, err := knowledgeAPI.PatchKnowledgeKnowledgebaseImportJob(knowledgeBaseId, importJobId, platformclientv2.Importstatusrequest{Status: &startedStatus}); err != nil {
+				return retry.NonRetryableError(fmt.Errorf("Failed to start knowledge import job %s: %s", importJobId, err))
+			}
+		case "Failed", "ValidationFailed", "Aborted":
+			return retry.NonRetryableError(fmt.Errorf("Knowledge import job %s ended with status %s:\n%s", importJobId, status, formatKnowledgeImportErrors(job.Report, importFile)))
+		}
+		return retry.RetryableError(fmt.Errorf("Knowledge import job %s has status %s", importJobId, status))
+	})
+	return importJobId, documentErr, diagErr
+}
+
+// formatKnowledgeImportErrors lists the errors of an import job report against the files they came from
+func formatKnowledgeImportErrors(report *platformclientv2.Knowledgeimportjobreport, importFile knowledgeImportFile) string {
+	if report == nil || report.Errors == nil {
+		return ""
+	}
+
+	messages := make([]string, 0)
+	for _, importError := range *report.Errors {
+		source := "import"
+		if index := importError.DocumentIndex; index != nil && *index >= 0 && *index < len(importFile.Documents) {
+			source = importFile.Documents[*index].ExternalId
+		}
+		message := ""
+		if importError.Message != nil {
+			message = *importError.Message
+		} else if importError.Code != nil {
+			message = *importError.Code
+		}
+		messages = append(messages, fmt.Sprintf("%s: %s", source, message))
+	}
+	return strings.Join(messages, "\n")
+}
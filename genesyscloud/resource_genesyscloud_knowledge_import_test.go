@@ -0,0 +1,116 @@
+package genesyscloud
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v109/platformclientv2"
+)
+
+func TestAccResourceKnowledgeImportBasic(t *testing.T) {
+	var (
+		knowledgeBaseResource1    = "test-knowledgebase1"
+		knowledgeBaseName1        = "Terraform Knowledge Base " + uuid.NewString()
+		knowledgeBaseDescription1 = "test-knowledgebase-description1"
+		coreLanguage1             = "en-US"
+		importResource1           = "test-knowledge-import1"
+		sourceDirectory           = t.TempDir()
+	)
+
+	writeArticle := func(name string, content string) {
+		if err := os.WriteFile(filepath.Join(sourceDirectory, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeArticle("first.md", "# First article\n\nFirst body")
+	writeArticle("second.html", "<h1>Second article</h1><p>Second body</p>")
+
+	config := generateKnowledgeKnowledgebaseResource(
+		knowledgeBaseResource1,
+		knowledgeBaseName1,
+		knowledgeBaseDescription1,
+		coreLanguage1,
+	) + generateKnowledgeImportResource(
+		importResource1,
+		knowledgeBaseResource1,
+		sourceDirectory,
+		false,
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// Create
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_knowledge_import."+importResource1, "document_ids.%", "2"),
+					resource.TestCheckResourceAttr("genesyscloud_knowledge_import."+importResource1, "file_content_hashes.%", "2"),
+					resource.TestCheckResourceAttrSet("genesyscloud_knowledge_import."+importResource1, "document_ids.first.md"),
+					resource.TestCheckResourceAttrSet("genesyscloud_knowledge_import."+importResource1, "import_job_id"),
+				),
+			},
+			{
+				// Change one article, remove the other and add a new one
+				PreConfig: func() {
+					writeArticle("first.md", "# First article\n\nUpdated body")
+					writeArticle("third.md", "---\ntitle: Third article\n---\nThird body")
+					if err := os.Remove(filepath.Join(sourceDirectory, "second.html")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_knowledge_import."+importResource1, "document_ids.%", "2"),
+					resource.TestCheckResourceAttrSet("genesyscloud_knowledge_import."+importResource1, "document_ids.third.md"),
+					resource.TestCheckNoResourceAttr("genesyscloud_knowledge_import."+importResource1, "document_ids.second.html"),
+				),
+			},
+		},
+		CheckDestroy: testVerifyKnowledgeImportDocumentsDestroyed,
+	})
+}
+
+func generateKnowledgeImportResource(resourceName string, knowledgeBaseResourceName string, sourceDirectory string, published bool) string {
+	return fmt.Sprintf(`
+resource "genesyscloud_knowledge_import" "%s" {
+	knowledge_base_id = genesyscloud_knowledge_knowledgebase.%s.id
+	source_directory  = %s
+	published         = %v
+}
+`, resourceName, knowledgeBaseResourceName, strconv.Quote(sourceDirectory), published)
+}
+
+func testVerifyKnowledgeImportDocumentsDestroyed(state *terraform.State) error {
+	knowledgeAPI := platformclientv2.NewKnowledgeApi()
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != "genesyscloud_knowledge_import" {
+			continue
+		}
+		knowledgeBaseId := rs.Primary.Attributes["knowledge_base_id"]
+		for key, documentId := range rs.Primary.Attributes {
+			if !strings.HasPrefix(key, "document_ids.") || key == "document_ids.%" {
+				continue
+			}
+			knowledgeDocument, resp, err := knowledgeAPI.GetKnowledgeKnowledgebaseDocument(knowledgeBaseId, documentId, nil, "")
+			if knowledgeDocument != nil {
+				return fmt.Errorf("Knowledge document (%s) still exists", documentId)
+			} else if IsStatus404(resp) || IsStatus400(resp) {
+				// Knowledge document or its knowledge base not found as expected
+				continue
+			} else {
+				return fmt.Errorf("Unexpected error: %s", err)
+			}
+		}
+	}
+	// Success. All imported documents destroyed
+	return nil
+}
@@ -13,6 +13,7 @@ import (
 
 	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
 	lists "terraform-provider-genesyscloud/genesyscloud/util/lists"
+	"terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -144,6 +145,21 @@ var (
 		},
 	}
 
+	evaluationFormPublishedVersion = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "ID of the published version.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"modified_date": {
+				Description: "Date the version was last modified. Date time is represented as an ISO-8601 string.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+
 	evaluationFormAnswerOptions = &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"text": {
@@ -196,6 +212,7 @@ func ResourceEvaluationForm() *schema.Resource {
 		ReadContext:   ReadWithPooledClient(readEvaluationForm),
 		UpdateContext: UpdateWithPooledClient(updateEvaluationForm),
 		DeleteContext: DeleteWithPooledClient(deleteEvaluationForm),
+		CustomizeDiff: validateEvaluationFormDefinitionDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -213,11 +230,48 @@ func ResourceEvaluationForm() *schema.Resource {
 				Default:     false,
 			},
 			"question_groups": {
-				Description: "A list of question groups.",
+				Description:  "A list of question groups. Either this or `definition_filepath` must be set.",
+				Type:         schema.TypeList,
+				Optional:     true,
+				Computed:     true,
+				MinItems:     1,
+				Elem:         evaluationFormQuestionGroup,
+				ExactlyOneOf: []string{"question_groups", "definition_filepath"},
+			},
+			"definition_filepath": {
+				Description:  "Path or URL of a YAML or JSON file describing the question groups of the form. Files ending in .json are parsed as JSON, anything else as YAML. The keys match the `question_groups` block. Weights, answers, kill/critical flags and visibility predicates are validated before the form is sent.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: ValidatePath,
+				ExactlyOneOf: []string{"question_groups", "definition_filepath"},
+			},
+			"definition_file_content_hash": {
+				Description:  "Hash value of the definition file content. Used to detect changes, e.g. filesha256(definition_filepath).",
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"definition_filepath"},
+			},
+			"delete_unpublished_drafts": {
+				Description: "If true, unpublished versions of the form older than the latest version are deleted after each update. If false, prior drafts are kept.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"context_id": {
+				Description: "The context ID shared by every version of the form.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"published_versions": {
+				Description: "Published versions of the form, most recent first.",
 				Type:        schema.TypeList,
-				Required:    true,
-				MinItems:    1,
-				Elem:        evaluationFormQuestionGroup,
+				Computed:    true,
+				Elem:        evaluationFormPublishedVersion,
+			},
+			"latest_published_version_id": {
+				Description: "ID of the most recently published version of the form. Reference this from `evaluation_form_id` in `genesyscloud_recording_media_retention_policy` to pin that exact version.",
+				Type:        schema.TypeString,
+				Computed:    true,
 			},
 		},
 	}
@@ -290,6 +344,19 @@ func readEvaluationForm(ctx context.Context, d *schema.ResourceData, meta interf
 		if evaluationForm.QuestionGroups != nil {
 			d.Set("question_groups", flattenQuestionGroups(evaluationForm.QuestionGroups))
 		}
+		resourcedata.SetNillableValue(d, "context_id", evaluationForm.ContextId)
+
+		formVersions, err := getEvaluationFormVersions(qualityAPI, d.Id())
+		if err != nil {
+			return retry.NonRetryableError(fmt.Errorf("Failed to read versions of evaluation form %s: %s", d.Id(), err))
+		}
+		publishedVersions := flattenEvaluationFormPublishedVersions(formVersions)
+		d.Set("published_versions", publishedVersions)
+		if len(publishedVersions) > 0 {
+			d.Set("latest_published_version_id", publishedVersions[0].(map[string]interface{})["id"])
+		} else {
+			d.Set("latest_published_version_id", nil)
+		}
 
 		return cc.CheckState()
 	})
@@ -338,6 +405,12 @@ func updateEvaluationForm(ctx context.Context, d *schema.ResourceData, meta inte
 		d.SetId(*form.Id)
 	}
 
+	if d.Get("delete_unpublished_drafts").(bool) {
+		if diagErr := deleteEvaluationFormDrafts(qualityAPI, d.Id(), *form.Id); diagErr != nil {
+			return diagErr
+		}
+	}
+
 	log.Printf("Updated evaluation form %s %s", name, *form.Id)
 	return readEvaluationForm(ctx, d, meta)
 }
@@ -377,39 +450,143 @@ func deleteEvaluationForm(ctx context.Context, d *schema.ResourceData, meta inte
 	})
 }
 
-func buildSdkQuestionGroups(d *schema.ResourceData) (*[]platformclientv2.Evaluationquestiongroup, diag.Diagnostics) {
-	questionGroupType := "questionGroup"
+// getEvaluationFormVersions returns every version of the evaluation form, most recent first
+func getEvaluationFormVersions(qualityAPI *platformclientv2.QualityApi, formId string) ([]platformclientv2.Evaluationform, error) {
+	var versions []platformclientv2.Evaluationform
+	const pageSize = 100
+	for pageNum := 1; ; pageNum++ {
+		formVersions, _, err := qualityAPI.GetQualityFormsEvaluationVersions(formId, pageSize, pageNum, "desc")
+		if err != nil {
+			return nil, err
+		}
+		if formVersions.Entities == nil || len(*formVersions.Entities) == 0 {
+			break
+		}
+		versions = append(versions, *formVersions.Entities...)
+		if formVersions.PageCount == nil || pageNum >= *formVersions.PageCount {
+			break
+		}
+	}
+	return versions, nil
+}
 
-	var evalQuestionGroups []platformclientv2.Evaluationquestiongroup
-	if questionGroups, ok := d.GetOk("question_groups"); ok {
-		questionGroupList := questionGroups.([]interface{})
-		for _, questionGroup := range questionGroupList {
-			questionGroupsMap := questionGroup.(map[string]interface{})
-
-			questionGroupName := questionGroupsMap["name"].(string)
-			defaultAnswersToHighest := questionGroupsMap["default_answers_to_highest"].(bool)
-			defaultAnswersToNA := questionGroupsMap["default_answers_to_na"].(bool)
-			naEnabled := questionGroupsMap["na_enabled"].(bool)
-			weight := float32(questionGroupsMap["weight"].(float64))
-			manualWeight := questionGroupsMap["manual_weight"].(bool)
-			questions := questionGroupsMap["questions"].([]interface{})
-
-			sdkquestionGroup := platformclientv2.Evaluationquestiongroup{
-				Name:                    &questionGroupName,
-				VarType:                 &questionGroupType,
-				DefaultAnswersToHighest: &defaultAnswersToHighest,
-				DefaultAnswersToNA:      &defaultAnswersToNA,
-				NaEnabled:               &naEnabled,
-				Weight:                  &weight,
-				ManualWeight:            &manualWeight,
-				Questions:               buildSdkQuestions(questions),
-			}
+// evaluationFormIdsEquivalent reports whether an evaluation form ID stored by the API refers to the form or version set
+// in configuration. A published version pins that exact version; any other version refers to every version of its form.
+func evaluationFormIdsEquivalent(qualityAPI *platformclientv2.QualityApi, storedId string, configId string) (bool, error) {
+	if storedId == configId {
+		return true, nil
+	}
+	formVersions, err := getEvaluationFormVersions(qualityAPI, configId)
+	if err != nil {
+		return false, err
+	}
+	for _, version := range formVersions {
+		if version.Id != nil && *version.Id == configId && version.Published != nil && *version.Published {
+			return false, nil
+		}
+	}
+	for _, version := range formVersions {
+		if version.Id != nil && *version.Id == storedId {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// deleteEvaluationFormDrafts deletes unpublished versions of the form except the resource version and the latest version
+func deleteEvaluationFormDrafts(qualityAPI *platformclientv2.QualityApi, formId string, latestVersionId string) diag.Diagnostics {
+	formVersions, err := getEvaluationFormVersions(qualityAPI, formId)
+	if err != nil {
+		return diag.Errorf("Failed to get evaluation form versions %s: %s", formId, err)
+	}
+
+	for _, version := range formVersions {
+		if version.Id == nil || *version.Id == formId || *version.Id == latestVersionId {
+			continue
+		}
+		if version.Published != nil && *version.Published {
+			continue
+		}
+		log.Printf("Deleting unpublished draft %s of evaluation form %s", *version.Id, formId)
+		if resp, err := qualityAPI.DeleteQualityFormsEvaluation(*version.Id); err != nil && !IsStatus404(resp) {
+			return diag.Errorf("Failed to delete unpublished draft %s of evaluation form %s: %s", *version.Id, formId, err)
+		}
+	}
+	return nil
+}
 
-			visibilityCondition := questionGroupsMap["visibility_condition"].([]interface{})
-			sdkquestionGroup.VisibilityCondition = buildSdkVisibilityCondition(visibilityCondition)
+func flattenEvaluationFormPublishedVersions(formVersions []platformclientv2.Evaluationform) []interface{} {
+	publishedVersions := make([]interface{}, 0)
+	for _, version := range formVersions {
+		if version.Published == nil || !*version.Published {
+			continue
+		}
+		publishedVersion := make(map[string]interface{})
+		if version.Id != nil {
+			publishedVersion["id"] = *version.Id
+		}
+		if version.ModifiedDate != nil {
+			publishedVersion["modified_date"] = version.ModifiedDate.Format(time.RFC3339)
+		}
+		publishedVersions = append(publishedVersions, publishedVersion)
+	}
+	return publishedVersions
+}
 
-			evalQuestionGroups = append(evalQuestionGroups, sdkquestionGroup)
+// getEvaluationFormQuestionGroups returns the question_groups of the form, read from the definition file if one is set
+func getEvaluationFormQuestionGroups(d *schema.ResourceData) ([]interface{}, diag.Diagnostics) {
+	if definitionPath, ok := d.GetOk("definition_filepath"); ok {
+		definition, err := readQualityFormDefinition(definitionPath.(string))
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		if errs := validateEvaluationFormDefinition(definition); len(errs) > 0 {
+			return nil, diag.FromErr(formatQualityFormDefinitionErrors(definitionPath.(string), errs))
 		}
+		return evaluationFormDefinitionToQuestionGroups(definition), nil
+	}
+
+	if questionGroups, ok := d.GetOk("question_groups"); ok {
+		return questionGroups.([]interface{}), nil
+	}
+	return nil, nil
+}
+
+func buildSdkQuestionGroups(d *schema.ResourceData) (*[]platformclientv2.Evaluationquestiongroup, diag.Diagnostics) {
+	questionGroupType := "questionGroup"
+
+	questionGroupList, diagErr := getEvaluationFormQuestionGroups(d)
+	if diagErr != nil {
+		return nil, diagErr
+	}
+
+	var evalQuestionGroups []platformclientv2.Evaluationquestiongroup
+	for _, questionGroup := range questionGroupList {
+		questionGroupsMap := questionGroup.(map[string]interface{})
+
+		questionGroupName := questionGroupsMap["name"].(string)
+		defaultAnswersToHighest := questionGroupsMap["default_answers_to_highest"].(bool)
+		defaultAnswersToNA := questionGroupsMap["default_answers_to_na"].(bool)
+		naEnabled := questionGroupsMap["na_enabled"].(bool)
+		weight := float32(questionGroupsMap["weight"].(float64))
+		manualWeight := questionGroupsMap["manual_weight"].(bool)
+		questions := questionGroupsMap["questions"].([]interface{})
+
+		sdkquestionGroup := platformclientv2.Evaluationquestiongroup{
+			Name:                    &questionGroupName,
+			VarType:                 &questionGroupType,
+			DefaultAnswersToHighest: &defaultAnswersToHighest,
+			DefaultAnswersToNA:      &defaultAnswersToNA,
+			NaEnabled:               &naEnabled,
+			Weight:                  &weight,
+			ManualWeight:            &manualWeight,
+			Questions:               buildSdkQuestions(questions),
+		}
+
+		visibilityCondition := questionGroupsMap["visibility_condition"].([]interface{})
+		sdkquestionGroup.VisibilityCondition = buildSdkVisibilityCondition(visibilityCondition)
+
+		evalQuestionGroups = append(evalQuestionGroups, sdkquestionGroup)
 	}
 
 	return &evalQuestionGroups, nil
@@ -2,6 +2,7 @@ package genesyscloud
 
 import (
 	"fmt"
+	"path/filepath"
 	"strconv"
 	"testing"
 
@@ -301,6 +302,10 @@ func TestAccResourceEvaluationFormRepublishing(t *testing.T) {
 				Config: GenerateEvaluationFormResource(formResource1, &evaluationForm1),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("genesyscloud_quality_forms_evaluation."+formResource1, "published", trueValue),
+					resource.TestCheckResourceAttrSet("genesyscloud_quality_forms_evaluation."+formResource1, "context_id"),
+					resource.TestCheckResourceAttr("genesyscloud_quality_forms_evaluation."+formResource1, "published_versions.#", "1"),
+					resource.TestCheckResourceAttrPair("genesyscloud_quality_forms_evaluation."+formResource1, "latest_published_version_id",
+						"genesyscloud_quality_forms_evaluation."+formResource1, "published_versions.0.id"),
 				),
 			},
 			{
@@ -315,6 +320,7 @@ func TestAccResourceEvaluationFormRepublishing(t *testing.T) {
 				Config: GenerateEvaluationFormResource(formResource1, &evaluationForm1),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("genesyscloud_quality_forms_evaluation."+formResource1, "published", trueValue),
+					resource.TestCheckResourceAttrSet("genesyscloud_quality_forms_evaluation."+formResource1, "latest_published_version_id"),
 				),
 			},
 			{
@@ -328,6 +334,51 @@ func TestAccResourceEvaluationFormRepublishing(t *testing.T) {
 	})
 }
 
+func TestAccResourceEvaluationFormDefinitionFile(t *testing.T) {
+	formResource1 := "test-evaluation-form-1"
+	formName := "terraform-form-evaluations-" + uuid.NewString()
+	definitionPath := filepath.Join("..", "test", "data", "resource", "genesyscloud_quality_forms_evaluation", "definition.yaml")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// Create from definition file
+				Config: generateEvaluationFormDefinitionFileResource(formResource1, formName, definitionPath),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_quality_forms_evaluation."+formResource1, "name", formName),
+					resource.TestCheckResourceAttr("genesyscloud_quality_forms_evaluation."+formResource1, "question_groups.#", "2"),
+					resource.TestCheckResourceAttr("genesyscloud_quality_forms_evaluation."+formResource1, "question_groups.0.name", "Opening"),
+					resource.TestCheckResourceAttr("genesyscloud_quality_forms_evaluation."+formResource1, "question_groups.0.questions.0.is_critical", trueValue),
+					resource.TestCheckResourceAttr("genesyscloud_quality_forms_evaluation."+formResource1, "question_groups.0.questions.1.visibility_condition.0.predicates.0", "../question/0/answer/0"),
+					resource.TestCheckResourceAttr("genesyscloud_quality_forms_evaluation."+formResource1, "question_groups.1.weight", "2"),
+					resource.TestCheckResourceAttr("genesyscloud_quality_forms_evaluation."+formResource1, "question_groups.1.questions.0.is_kill", trueValue),
+					resource.TestCheckResourceAttr("genesyscloud_quality_forms_evaluation."+formResource1, "question_groups.1.questions.0.answer_options.#", "3"),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:            "genesyscloud_quality_forms_evaluation." + formResource1,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"definition_filepath", "definition_file_content_hash"},
+			},
+		},
+		CheckDestroy: testVerifyEvaluationFormDestroyed,
+	})
+}
+
+func generateEvaluationFormDefinitionFileResource(resourceID string, name string, definitionPath string) string {
+	fullyQualifiedPath, _ := filepath.Abs(definitionPath)
+	return fmt.Sprintf(`resource "genesyscloud_quality_forms_evaluation" "%s" {
+		name                         = "%s"
+		definition_filepath          = %s
+		definition_file_content_hash = filesha256(%s)
+	}
+	`, resourceID, name, strconv.Quote(definitionPath), strconv.Quote(fullyQualifiedPath))
+}
+
 func testVerifyEvaluationFormDestroyed(state *terraform.State) error {
 	qualityAPI := platformclientv2.NewQualityApi()
 	for _, rs := range state.RootModule().Resources {
@@ -191,6 +191,7 @@ func ResourceSurveyForm() *schema.Resource {
 		ReadContext:   ReadWithPooledClient(readSurveyForm),
 		UpdateContext: UpdateWithPooledClient(updateSurveyForm),
 		DeleteContext: DeleteWithPooledClient(deleteSurveyForm),
+		CustomizeDiff: validateSurveyFormDefinitionDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -230,11 +231,26 @@ func ResourceSurveyForm() *schema.Resource {
 				Optional:    true,
 			},
 			"question_groups": {
-				Description: "A list of question groups.",
-				Type:        schema.TypeList,
-				Required:    true,
-				MinItems:    1,
-				Elem:        surveyQuestionGroup,
+				Description:  "A list of question groups. Either this or `definition_filepath` must be set.",
+				Type:         schema.TypeList,
+				Optional:     true,
+				Computed:     true,
+				MinItems:     1,
+				Elem:         surveyQuestionGroup,
+				ExactlyOneOf: []string{"question_groups", "definition_filepath"},
+			},
+			"definition_filepath": {
+				Description:  "Path or URL of a YAML or JSON file describing the question groups of the form. Files ending in .json are parsed as JSON, anything else as YAML. The keys match the `question_groups` block. Question types, answers and visibility predicates are validated before the form is sent.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: ValidatePath,
+				ExactlyOneOf: []string{"question_groups", "definition_filepath"},
+			},
+			"definition_file_content_hash": {
+				Description:  "Hash value of the definition file content. Used to detect changes, e.g. filesha256(definition_filepath).",
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"definition_filepath"},
 			},
 		},
 	}
@@ -439,31 +455,52 @@ func deleteSurveyForm(ctx context.Context, d *schema.ResourceData, meta interfac
 	})
 }
 
+// getSurveyFormQuestionGroups returns the question_groups of the form, read from the definition file if one is set
+func getSurveyFormQuestionGroups(d *schema.ResourceData) ([]interface{}, diag.Diagnostics) {
+	if definitionPath, ok := d.GetOk("definition_filepath"); ok {
+		definition, err := readQualityFormDefinition(definitionPath.(string))
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		if errs := validateSurveyFormDefinition(definition); len(errs) > 0 {
+			return nil, diag.FromErr(formatQualityFormDefinitionErrors(definitionPath.(string), errs))
+		}
+		return surveyFormDefinitionToQuestionGroups(definition), nil
+	}
+
+	if questionGroups, ok := d.GetOk("question_groups"); ok {
+		return questionGroups.([]interface{}), nil
+	}
+	return nil, nil
+}
+
 func buildSurveyQuestionGroups(d *schema.ResourceData) (*[]platformclientv2.Surveyquestiongroup, diag.Diagnostics) {
 	questionGroupType := "questionGroup"
 
+	questionGroupList, diagErr := getSurveyFormQuestionGroups(d)
+	if diagErr != nil {
+		return nil, diagErr
+	}
+
 	var surveyQuestionGroups []platformclientv2.Surveyquestiongroup
-	if questionGroups, ok := d.GetOk("question_groups"); ok {
-		questionGroupList := questionGroups.([]interface{})
-		for _, questionGroup := range questionGroupList {
-			questionGroupsMap := questionGroup.(map[string]interface{})
-
-			questionGroupName := questionGroupsMap["name"].(string)
-			naEnabled := questionGroupsMap["na_enabled"].(bool)
-			questions := questionGroupsMap["questions"].([]interface{})
-
-			sdkquestionGroup := platformclientv2.Surveyquestiongroup{
-				Name:      &questionGroupName,
-				VarType:   &questionGroupType,
-				NaEnabled: &naEnabled,
-				Questions: buildSurveyQuestions(questions),
-			}
+	for _, questionGroup := range questionGroupList {
+		questionGroupsMap := questionGroup.(map[string]interface{})
 
-			visibilityCondition := questionGroupsMap["visibility_condition"].([]interface{})
-			sdkquestionGroup.VisibilityCondition = buildSdkVisibilityCondition(visibilityCondition)
+		questionGroupName := questionGroupsMap["name"].(string)
+		naEnabled := questionGroupsMap["na_enabled"].(bool)
+		questions := questionGroupsMap["questions"].([]interface{})
 
-			surveyQuestionGroups = append(surveyQuestionGroups, sdkquestionGroup)
+		sdkquestionGroup := platformclientv2.Surveyquestiongroup{
+			Name:      &questionGroupName,
+			VarType:   &questionGroupType,
+			NaEnabled: &naEnabled,
+			Questions: buildSurveyQuestions(questions),
 		}
+
+		visibilityCondition := questionGroupsMap["visibility_condition"].([]interface{})
+		sdkquestionGroup.VisibilityCondition = buildSdkVisibilityCondition(visibilityCondition)
+
+		surveyQuestionGroups = append(surveyQuestionGroups, sdkquestionGroup)
 	}
 
 	return &surveyQuestionGroups, nil
@@ -2,6 +2,7 @@ package genesyscloud
 
 import (
 	"fmt"
+	"path/filepath"
 	"strconv"
 	"testing"
 
@@ -348,6 +349,39 @@ func TestAccResourceSurveyFormRepublishing(t *testing.T) {
 	})
 }
 
+func TestAccResourceSurveyFormDefinitionFile(t *testing.T) {
+	formResource1 := "test-survey-form-1"
+	formName := "terraform-form-surveys-" + uuid.NewString()
+	definitionPath := filepath.Join("..", "test", "data", "resource", "genesyscloud_quality_forms_survey", "definition.json")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// Create from definition file
+				Config: generateSurveyFormDefinitionFileResource(formResource1, formName, definitionPath),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_quality_forms_survey."+formResource1, "name", formName),
+					resource.TestCheckResourceAttr("genesyscloud_quality_forms_survey."+formResource1, "question_groups.#", "1"),
+					resource.TestCheckResourceAttr("genesyscloud_quality_forms_survey."+formResource1, "question_groups.0.questions.#", "3"),
+					resource.TestCheckResourceAttr("genesyscloud_quality_forms_survey."+formResource1, "question_groups.0.questions.0.explanation_prompt", "Why did you choose that score?"),
+					resource.TestCheckResourceAttr("genesyscloud_quality_forms_survey."+formResource1, "question_groups.0.questions.1.answer_options.#", "2"),
+					resource.TestCheckResourceAttr("genesyscloud_quality_forms_survey."+formResource1, "question_groups.0.questions.2.max_response_characters", "500"),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:            "genesyscloud_quality_forms_survey." + formResource1,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"definition_filepath", "definition_file_content_hash"},
+			},
+		},
+		CheckDestroy: testVerifySurveyFormDestroyed,
+	})
+}
+
 func testVerifySurveyFormDestroyed(state *terraform.State) error {
 	qualityAPI := platformclientv2.NewQualityApi()
 	for _, rs := range state.RootModule().Resources {
@@ -401,6 +435,17 @@ func generateSurveyFormResource(resourceID string, surveyForm *surveyFormStruct)
 	return form
 }
 
+func generateSurveyFormDefinitionFileResource(resourceID string, name string, definitionPath string) string {
+	fullyQualifiedPath, _ := filepath.Abs(definitionPath)
+	return fmt.Sprintf(`resource "genesyscloud_quality_forms_survey" "%s" {
+		name                         = "%s"
+		language                     = "en-US"
+		definition_filepath          = %s
+		definition_file_content_hash = filesha256(%s)
+	}
+	`, resourceID, name, strconv.Quote(definitionPath), strconv.Quote(fullyQualifiedPath))
+}
+
 func generateLifeCycle() string {
 	return `
 	lifecycle {
@@ -247,9 +247,10 @@ var (
 	evaluationAssignment = &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"evaluation_form_id": {
-				Description: "",
+				Description: "ID of the evaluation form. Set this to the `latest_published_version_id` of a `genesyscloud_quality_forms_evaluation` to pin that published version.",
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 			},
 			"user_id": {
 				Description: "",
@@ -273,9 +274,10 @@ var (
 				Optional:    true,
 			},
 			"evaluation_form_id": {
-				Description: "",
+				Description: "ID of the evaluation form. Set this to the `latest_published_version_id` of a `genesyscloud_quality_forms_evaluation` to pin that published version.",
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 			},
 			"assign_to_active_user": {
 				Description: "",
@@ -306,9 +308,10 @@ var (
 				Optional:    true,
 			},
 			"evaluation_form_id": {
-				Description: "",
+				Description: "ID of the evaluation form. Set this to the `latest_published_version_id` of a `genesyscloud_quality_forms_evaluation` to pin that published version.",
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 			},
 			"time_interval": {
 				Description: "",
@@ -339,9 +342,10 @@ var (
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 			"evaluation_form_id": {
-				Description: "",
+				Description: "ID of the evaluation form. Set this to the `latest_published_version_id` of a `genesyscloud_quality_forms_evaluation` to pin that published version.",
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 			},
 			"expert_evaluator_id": {
 				Description: "",
@@ -908,6 +912,7 @@ func ResourceMediaRetentionPolicy() *schema.Resource {
 		ReadContext:   ReadWithPooledClient(readMediaRetentionPolicy),
 		UpdateContext: UpdateWithPooledClient(updateMediaRetentionPolicy),
 		DeleteContext: DeleteWithPooledClient(deleteMediaRetentionPolicy),
+		CustomizeDiff: customizeMediaRetentionPolicyDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -966,6 +971,46 @@ func ResourceMediaRetentionPolicy() *schema.Resource {
 	}
 }
 
+// customizeMediaRetentionPolicyDiff clears the diff of evaluation form IDs that refer to the form version the policy
+// already stores, e.g. the ID of a genesyscloud_quality_forms_evaluation whose published version is stored. The IDs are
+// computed so that their diff can be cleared.
+func customizeMediaRetentionPolicyDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	qualityAPI := platformclientv2.NewQualityApiWithConfig(meta.(*ProviderMeta).ClientConfig)
+
+	actionsKeys := []string{"actions.0"}
+	for _, mediaPolicy := range []string{"call_policy", "chat_policy", "email_policy", "message_policy"} {
+		actionsKeys = append(actionsKeys, fmt.Sprintf("media_policies.0.%s.0.actions.0", mediaPolicy))
+	}
+	for _, actionsKey := range actionsKeys {
+		for _, assignmentsAttr := range []string{"assign_evaluations", "assign_metered_evaluations", "assign_metered_assignment_by_agent", "assign_calibrations"} {
+			assignments, _ := diff.Get(actionsKey + "." + assignmentsAttr).([]interface{})
+			for i := range assignments {
+				key := fmt.Sprintf("%s.%s.%d.evaluation_form_id", actionsKey, assignmentsAttr, i)
+				if !diff.HasChange(key) || !diff.NewValueKnown(key) {
+					continue
+				}
+				oldId, newId := diff.GetChange(key)
+				if oldId.(string) == "" || newId.(string) == "" {
+					continue
+				}
+				equivalent, err := evaluationFormIdsEquivalent(qualityAPI, oldId.(string), newId.(string))
+				if err != nil {
+					return fmt.Errorf("failed to get versions of evaluation form %s: %v", newId, err)
+				}
+				if equivalent {
+					if err := diff.Clear(key); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
 func getAllMediaRetentionPolicies(_ context.Context, clientConfig *platformclientv2.Configuration) (resourceExporter.ResourceIDMetaMap, diag.Diagnostics) {
 	resources := make(resourceExporter.ResourceIDMetaMap)
 	recordingAPI := platformclientv2.NewRecordingApiWithConfig(clientConfig)
@@ -1030,19 +1075,10 @@ func flattenEvaluationAssignments(assignments *[]platformclientv2.Evaluationassi
 	for _, assignment := range *assignments {
 		assignmentMap := make(map[string]interface{})
 
-		// if form is present in the response, assign the most recent unpublished version id to align with evaluation form resource behavior for export purposes.
-		if assignment.EvaluationForm != nil {
-			formId := *assignment.EvaluationForm.Id
-			formVersions, _, err := qualityAPI.GetQualityFormsEvaluationVersions(formId, 25, 1, "desc")
-			if err != nil {
-				log.Fatalf("Failed to get evaluation form versions %s", *assignment.EvaluationForm.Name)
-			} else if formVersions.Entities == nil || len(*formVersions.Entities) == 0 {
-				log.Fatalf("No versions found for form %s", formId)
-			} else {
-				formId = *(*formVersions.Entities)[0].Id
-			}
-
-			assignmentMap["evaluation_form_id"] = formId
+		// The form version stored by the policy is kept as is. Plans compare it with the configured form in
+		// customizeMediaRetentionPolicyDiff.
+		if assignment.EvaluationForm != nil && assignment.EvaluationForm.Id != nil {
+			assignmentMap["evaluation_form_id"] = *assignment.EvaluationForm.Id
 		}
 		if assignment.User != nil {
 			assignmentMap["user_id"] = *assignment.User.Id
@@ -1162,19 +1198,10 @@ func flattenAssignMeteredEvaluations(assignments *[]platformclientv2.Meteredeval
 		if assignment.MaxNumberEvaluations != nil {
 			assignmentMap["max_number_evaluations"] = *assignment.MaxNumberEvaluations
 		}
-		// if form is present in the response, assign the most recent unpublished version id to align with evaluation form resource behavior for export purposes.
-		if assignment.EvaluationForm != nil {
-			formId := *assignment.EvaluationForm.Id
-			formVersions, _, err := qualityAPI.GetQualityFormsEvaluationVersions(formId, 25, 1, "desc")
-			if err != nil {
-				log.Fatalf("Failed to get evaluation form versions %s", *assignment.EvaluationForm.Name)
-			} else if formVersions.Entities == nil || len(*formVersions.Entities) == 0 {
-				log.Fatalf("No versions found for form %s", formId)
-			} else {
-				formId = *(*formVersions.Entities)[0].Id
-			}
-
-			assignmentMap["evaluation_form_id"] = formId
+		// The form version stored by the policy is kept as is. Plans compare it with the configured form in
+		// customizeMediaRetentionPolicyDiff.
+		if assignment.EvaluationForm != nil && assignment.EvaluationForm.Id != nil {
+			assignmentMap["evaluation_form_id"] = *assignment.EvaluationForm.Id
 		}
 		if assignment.AssignToActiveUser != nil {
 			assignmentMap["assign_to_active_user"] = *assignment.AssignToActiveUser
@@ -1253,19 +1280,10 @@ func flattenAssignMeteredAssignmentByAgent(assignments *[]platformclientv2.Meter
 		if assignment.MaxNumberEvaluations != nil {
 			assignmentMap["max_number_evaluations"] = *assignment.MaxNumberEvaluations
 		}
-		// if form is present in the response, assign the most recent unpublished version id to align with evaluation form resource behavior for export purposes.
-		if assignment.EvaluationForm != nil {
-			formId := *assignment.EvaluationForm.Id
-			formVersions, _, err := qualityAPI.GetQualityFormsEvaluationVersions(formId, 25, 1, "desc")
-			if err != nil {
-				log.Fatalf("Failed to get evaluation form versions %s", *assignment.EvaluationForm.Name)
-			} else if formVersions.Entities == nil || len(*formVersions.Entities) == 0 {
-				log.Fatalf("No versions found for form %s", formId)
-			} else {
-				formId = *(*formVersions.Entities)[0].Id
-			}
-
-			assignmentMap["evaluation_form_id"] = formId
+		// The form version stored by the policy is kept as is. Plans compare it with the configured form in
+		// customizeMediaRetentionPolicyDiff.
+		if assignment.EvaluationForm != nil && assignment.EvaluationForm.Id != nil {
+			assignmentMap["evaluation_form_id"] = *assignment.EvaluationForm.Id
 		}
 		if assignment.TimeInterval != nil {
 			assignmentMap["time_interval"] = flattenTimeInterval(assignment.TimeInterval)
@@ -1349,19 +1367,10 @@ func flattenAssignCalibrations(assignments *[]platformclientv2.Calibrationassign
 			}
 			assignmentMap["evaluator_ids"] = evaluatorIds
 		}
-		// if form is present in the response, assign the most recent unpublished version id to align with evaluation form resource behavior for export purposes.
-		if assignment.EvaluationForm != nil {
-			formId := *assignment.EvaluationForm.Id
-			formVersions, _, err := qualityAPI.GetQualityFormsEvaluationVersions(formId, 25, 1, "desc")
-			if err != nil {
-				log.Fatalf("Failed to get evaluation form versions %s", *assignment.EvaluationForm.Name)
-			} else if formVersions.Entities == nil || len(*formVersions.Entities) == 0 {
-				log.Fatalf("No versions found for form %s", formId)
-			} else {
-				formId = *(*formVersions.Entities)[0].Id
-			}
-
-			assignmentMap["evaluation_form_id"] = formId
+		// The form version stored by the policy is kept as is. Plans compare it with the configured form in
+		// customizeMediaRetentionPolicyDiff.
+		if assignment.EvaluationForm != nil && assignment.EvaluationForm.Id != nil {
+			assignmentMap["evaluation_form_id"] = *assignment.EvaluationForm.Id
 		}
 		if assignment.ExpertEvaluator != nil {
 			assignmentMap["expert_evaluator_id"] = *assignment.ExpertEvaluator.Id
@@ -2489,9 +2498,14 @@ func flattenMessageMediaPolicy(messageMediaPolicy *platformclientv2.Messagemedia
 }
 
 func buildMediaPolicies(d *schema.ResourceData) *platformclientv2.Mediapolicies {
+	mediaPolicies, _ := d.Get("media_policies").([]interface{})
+	return buildSdkMediaPolicies(mediaPolicies)
+}
+
+func buildSdkMediaPolicies(mediaPolicies []interface{}) *platformclientv2.Mediapolicies {
 	sdkMediaPolicies := platformclientv2.Mediapolicies{}
 
-	if mediaPolicies, ok := d.Get("media_policies").([]interface{}); ok && len(mediaPolicies) > 0 {
+	if len(mediaPolicies) > 0 {
 		mediaPoliciesMap, ok := mediaPolicies[0].(map[string]interface{})
 		if !ok {
 			return nil
@@ -2539,7 +2553,12 @@ func flattenMediaPolicies(mediaPolicies *platformclientv2.Mediapolicies) []inter
 }
 
 func buildConditions(d *schema.ResourceData) *platformclientv2.Policyconditions {
-	if conditions, ok := d.Get("conditions").([]interface{}); ok && len(conditions) > 0 {
+	conditions, _ := d.Get("conditions").([]interface{})
+	return buildPolicyConditions(conditions)
+}
+
+func buildPolicyConditions(conditions []interface{}) *platformclientv2.Policyconditions {
+	if len(conditions) > 0 {
 		conditionsMap, ok := conditions[0].(map[string]interface{})
 		if !ok {
 			return nil
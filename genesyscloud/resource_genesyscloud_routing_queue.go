@@ -7,6 +7,7 @@ import (
 	"log"
 	"net/http"
 	"net/url"
+	"sort"
 	"strings"
 	"time"
 
@@ -220,6 +221,7 @@ func ResourceRoutingQueue() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: customizeQueueTemplateDiff,
 		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -227,6 +229,12 @@ func ResourceRoutingQueue() *schema.Resource {
 				Type:        schema.TypeString,
 				Required:    true,
 			},
+			"template_id": {
+				Description:  "ID of a `genesyscloud_routing_queue_template` providing the media settings, routing rules, bullseye rings, ACW settings and default scripts this queue does not set itself.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateQueueTemplateId,
+			},
 			"division_id": {
 				Description: "The division to which this queue will belong. If not set, the home division will be used.",
 				Type:        schema.TypeString,
@@ -282,6 +290,7 @@ func ResourceRoutingQueue() *schema.Resource {
 				Description: "The routing rules for the queue, used for routing to known or preferred agents.",
 				Type:        schema.TypeList,
 				Optional:    true,
+				Computed:    true,
 				MaxItems:    6,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -311,6 +320,7 @@ func ResourceRoutingQueue() *schema.Resource {
 				Description: "The bullseye ring settings for the queue.",
 				Type:        schema.TypeList,
 				Optional:    true,
+				Computed:    true,
 				MaxItems:    5,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -383,10 +393,10 @@ func ResourceRoutingQueue() *schema.Resource {
 				},
 			},
 			"acw_wrapup_prompt": {
-				Description:  "This field controls how the UI prompts the agent for a wrapup (MANDATORY | OPTIONAL | MANDATORY_TIMEOUT | MANDATORY_FORCED_TIMEOUT | AGENT_REQUESTED).",
+				Description:  "This field controls how the UI prompts the agent for a wrapup (MANDATORY | OPTIONAL | MANDATORY_TIMEOUT | MANDATORY_FORCED_TIMEOUT | AGENT_REQUESTED). Defaults to `MANDATORY_TIMEOUT`.",
 				Type:         schema.TypeString,
 				Optional:     true,
-				Default:      "MANDATORY_TIMEOUT",
+				Computed:     true,
 				ValidateFunc: validation.StringInSlice([]string{"MANDATORY", "OPTIONAL", "MANDATORY_TIMEOUT", "MANDATORY_FORCED_TIMEOUT", "AGENT_REQUESTED"}, false),
 			},
 			"acw_timeout_ms": {
@@ -456,6 +466,7 @@ func ResourceRoutingQueue() *schema.Resource {
 				Type:             schema.TypeMap,
 				ValidateDiagFunc: validateMapCommTypes,
 				Optional:         true,
+				Computed:         true,
 				Elem:             &schema.Schema{Type: schema.TypeString},
 			},
 			"outbound_messaging_sms_address_id": {
@@ -484,7 +495,7 @@ func ResourceRoutingQueue() *schema.Resource {
 				},
 			},
 			"members": {
-				Description: "Users in the queue. If not set, this resource will not manage members.",
+				Description: "Users in the queue. If not set, this resource will not manage members. Leave unset when members are managed by `genesyscloud_routing_queue_members`.",
 				Type:        schema.TypeSet,
 				Optional:    true,
 				Computed:    true,
@@ -905,29 +916,29 @@ func deleteQueue(ctx context.Context, d *schema.ResourceData, meta interface{})
 func buildSdkMediaSettings(d *schema.ResourceData) *platformclientv2.Queuemediasettings {
 	queueMediaSettings := &platformclientv2.Queuemediasettings{}
 
-	mediaSettingsCall := d.Get("media_settings_call").([]interface{})
+	mediaSettingsCall := getQueueSetting(d, "media_settings_call").([]interface{})
 	if mediaSettingsCall != nil && len(mediaSettingsCall) > 0 {
 		queueMediaSettings.Call = buildSdkMediaSetting(mediaSettingsCall)
 
 	}
 
-	mediaSettingsCallback := d.Get("media_settings_callback").([]interface{})
+	mediaSettingsCallback := getQueueSetting(d, "media_settings_callback").([]interface{})
 	if mediaSettingsCallback != nil && len(mediaSettingsCallback) > 0 {
 		queueMediaSettings.Callback = buildSdkMediaSettingCallback(mediaSettingsCallback)
 	}
 
-	mediaSettingsChat := d.Get("media_settings_chat").([]interface{})
+	mediaSettingsChat := getQueueSetting(d, "media_settings_chat").([]interface{})
 	if mediaSettingsChat != nil && len(mediaSettingsChat) > 0 {
 		queueMediaSettings.Chat = buildSdkMediaSetting(mediaSettingsChat)
 	}
 
-	mediaSettingsEmail := d.Get("media_settings_email").([]interface{})
+	mediaSettingsEmail := getQueueSetting(d, "media_settings_email").([]interface{})
 	log.Printf("The media settings email #%v", mediaSettingsEmail)
 	if mediaSettingsEmail != nil && len(mediaSettingsEmail) > 0 {
 		queueMediaSettings.Email = buildSdkMediaSetting(mediaSettingsEmail)
 	}
 
-	mediaSettingsMessage := d.Get("media_settings_message").([]interface{})
+	mediaSettingsMessage := getQueueSetting(d, "media_settings_message").([]interface{})
 	if mediaSettingsMessage != nil && len(mediaSettingsMessage) > 0 {
 		queueMediaSettings.Message = buildSdkMediaSetting(mediaSettingsMessage)
 	}
@@ -1001,8 +1012,8 @@ func buildMemberGroupList(d *schema.ResourceData, groupKey string, groupType str
 
 func buildSdkRoutingRules(d *schema.ResourceData) *[]platformclientv2.Routingrule {
 	var routingRules []platformclientv2.Routingrule
-	if configRoutingRules, ok := d.GetOk("routing_rules"); ok {
-		for _, configRule := range configRoutingRules.([]interface{}) {
+	if configRoutingRules, ok := getQueueSetting(d, "routing_rules").([]interface{}); ok {
+		for _, configRule := range configRoutingRules {
 			ruleSettings, ok := configRule.(map[string]interface{})
 			if !ok {
 				continue
@@ -1043,9 +1054,9 @@ func flattenRoutingRules(sdkRoutingRules []platformclientv2.Routingrule) []inter
 }
 
 func buildSdkBullseyeSettings(d *schema.ResourceData) *platformclientv2.Bullseye {
-	if configRings, ok := d.GetOk("bullseye_rings"); ok {
+	if configRings, ok := getQueueSetting(d, "bullseye_rings").([]interface{}); ok && len(configRings) > 0 {
 		var sdkRings []platformclientv2.Ring
-		for _, configRing := range configRings.([]interface{}) {
+		for _, configRing := range configRings {
 			ringSettings, ok := configRing.(map[string]interface{})
 			if !ok {
 				continue
@@ -1283,7 +1294,7 @@ func flattenConditionalGroupRoutingRules(queue *platformclientv2.Queue) []interf
 }
 
 func buildSdkAcwSettings(d *schema.ResourceData) *platformclientv2.Acwsettings {
-	acwWrapupPrompt := d.Get("acw_wrapup_prompt").(string)
+	acwWrapupPrompt := getQueueSetting(d, "acw_wrapup_prompt").(string)
 
 	acwSettings := platformclientv2.Acwsettings{
 		WrapupPrompt: &acwWrapupPrompt, // Set or default
@@ -1291,9 +1302,7 @@ func buildSdkAcwSettings(d *schema.ResourceData) *platformclientv2.Acwsettings {
 
 	// Only set timeout for certain wrapup prompt types
 	if acwWrapupPrompt == "MANDATORY_TIMEOUT" || acwWrapupPrompt == "MANDATORY_FORCED_TIMEOUT" || acwWrapupPrompt == "AGENT_REQUESTED" {
-		acwTimeoutMs, hasTimeout := d.GetOk("acw_timeout_ms")
-		if hasTimeout {
-			timeout := acwTimeoutMs.(int)
+		if timeout, _ := getQueueSetting(d, "acw_timeout_ms").(int); timeout != 0 {
 			acwSettings.TimeoutMs = &timeout
 		}
 	}
@@ -1301,8 +1310,7 @@ func buildSdkAcwSettings(d *schema.ResourceData) *platformclientv2.Acwsettings {
 }
 
 func buildSdkDefaultScriptsMap(d *schema.ResourceData) *map[string]platformclientv2.Script {
-	if scriptIds, ok := d.GetOk("default_script_ids"); ok {
-		scriptMap := scriptIds.(map[string]interface{})
+	if scriptMap, ok := getQueueSetting(d, "default_script_ids").(map[string]interface{}); ok && len(scriptMap) > 0 {
 
 		results := make(map[string]platformclientv2.Script)
 		for k, v := range scriptMap {
@@ -1582,41 +1590,56 @@ func updateQueueMembers(d *schema.ResourceData, routingAPI *platformclientv2.Rou
 				oldUserRingNums[oldUserIds[i]] = *user.RingNumber
 			}
 
-			if len(oldUserIds) > 0 {
-				usersToRemove := lists.SliceDifference(oldUserIds, newUserIds)
-				err := updateMembersInChunks(d.Id(), usersToRemove, true, routingAPI)
-				if err != nil {
-					return err
-				}
+			usersToRemove := lists.SliceDifference(oldUserIds, newUserIds)
+			if err := updateQueueMemberAssignments(d.Id(), usersToRemove, newUserRingNums, oldUserRingNums, routingAPI); err != nil {
+				return err
 			}
+			log.Printf("Members updated for Queue %s", d.Get("name"))
+		}
+	}
+	return nil
+}
 
-			if len(newUserIds) > 0 {
-				usersToAdd := lists.SliceDifference(newUserIds, oldUserIds)
-				err := updateMembersInChunks(d.Id(), usersToAdd, false, routingAPI)
+// updateQueueMemberAssignments removes usersToRemove from the queue, adds the users in newUserRingNums that are not in
+// oldUserRingNums and updates ring numbers that differ from the current ones
+func updateQueueMemberAssignments(queueID string, usersToRemove []string, newUserRingNums map[string]int, oldUserRingNums map[string]int, api *platformclientv2.RoutingApi) diag.Diagnostics {
+	if len(usersToRemove) > 0 {
+		err := updateMembersInChunks(queueID, usersToRemove, true, api)
+		if err != nil {
+			return err
+		}
+	}
+
+	var usersToAdd []string
+	for userID := range newUserRingNums {
+		if _, found := oldUserRingNums[userID]; !found {
+			usersToAdd = append(usersToAdd, userID)
+		}
+	}
+	if len(usersToAdd) > 0 {
+		sort.Strings(usersToAdd)
+		err := updateMembersInChunks(queueID, usersToAdd, false, api)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Check for ring numbers to update
+	for userID, newNum := range newUserRingNums {
+		if oldNum, found := oldUserRingNums[userID]; found {
+			if newNum != oldNum {
+				// Number changed. Update ring number
+				err := updateQueueUserRingNum(queueID, userID, newNum, api)
 				if err != nil {
 					return err
 				}
 			}
-
-			// Check for ring numbers to update
-			for userID, newNum := range newUserRingNums {
-				if oldNum, found := oldUserRingNums[userID]; found {
-					if newNum != oldNum {
-						// Number changed. Update ring number
-						err := updateQueueUserRingNum(d.Id(), userID, newNum, routingAPI)
-						if err != nil {
-							return err
-						}
-					}
-				} else if newNum != 1 {
-					// New queue member. Update ring num if not set to the default of 1
-					err := updateQueueUserRingNum(d.Id(), userID, newNum, routingAPI)
-					if err != nil {
-						return err
-					}
-				}
+		} else if newNum != 1 {
+			// New queue member. Update ring num if not set to the default of 1
+			err := updateQueueUserRingNum(queueID, userID, newNum, api)
+			if err != nil {
+				return err
 			}
-			log.Printf("Members updated for Queue %s", d.Get("name"))
 		}
 	}
 	return nil
@@ -0,0 +1,188 @@
+package genesyscloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mypurecloud/platform-client-sdk-go/v109/platformclientv2"
+)
+
+const (
+	queueMembersModeAuthoritative = "authoritative"
+	queueMembersModeAdditive      = "additive"
+)
+
+func ResourceRoutingQueueMembers() *schema.Resource {
+	return &schema.Resource{
+		Description: `Genesys Cloud Routing Queue Members maintains the users that are direct members of a queue. Members added through groups or bullseye rings are not affected.
+
+In ` + "`authoritative`" + ` mode the queue's direct members are made to match ` + "`members`" + ` and any other user is removed. In ` + "`additive`" + ` mode only the members listed here are added, updated and removed, and members added elsewhere (e.g. in the UI) are left alone.
+The ` + "`members`" + ` attribute of the ` + "`genesyscloud_routing_queue`" + ` resource should not be set for a queue managed by this resource.`,
+
+		CreateContext: CreateWithPooledClient(createRoutingQueueMembers),
+		ReadContext:   ReadWithPooledClient(readRoutingQueueMembers),
+		UpdateContext: UpdateWithPooledClient(updateRoutingQueueMembers),
+		DeleteContext: DeleteWithPooledClient(deleteRoutingQueueMembers),
+		Importer: &schema.ResourceImporter{
+			StateContext: importRoutingQueueMembers,
+		},
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"queue_id": {
+				Description: "ID of the queue whose members are managed by this resource.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"mode": {
+				Description:  "How membership is managed. `authoritative` removes direct members that are not listed. `additive` only manages the listed members.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      queueMembersModeAuthoritative,
+				ValidateFunc: validation.StringInSlice([]string{queueMembersModeAuthoritative, queueMembersModeAdditive}, false),
+			},
+			"members": {
+				Description: "Users in the queue.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				ConfigMode:  schema.SchemaConfigModeAttr,
+				Elem:        queueMemberResource,
+			},
+		},
+	}
+}
+
+func importRoutingQueueMembers(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	d.Set("queue_id", d.Id())
+	d.Set("mode", queueMembersModeAuthoritative)
+	return []*schema.ResourceData{d}, nil
+}
+
+func createRoutingQueueMembers(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	queueID := d.Get("queue_id").(string)
+	d.SetId(queueID)
+	return updateRoutingQueueMembers(ctx, d, meta)
+}
+
+func readRoutingQueueMembers(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	routingAPI := platformclientv2.NewRoutingApiWithConfig(sdkConfig)
+
+	log.Printf("Reading members for queue %s", d.Id())
+
+	return WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, ResourceRoutingQueueMembers())
+
+		_, resp, getErr := routingAPI.GetRoutingQueue(d.Id())
+		if getErr != nil {
+			if IsStatus404(resp) {
+				return retry.RetryableError(fmt.Errorf("Failed to read queue %s: %s", d.Id(), getErr))
+			}
+			return retry.NonRetryableError(fmt.Errorf("Failed to read queue %s: %s", d.Id(), getErr))
+		}
+
+		members, diagErr := flattenQueueMembers(d.Id(), "user", routingAPI)
+		if diagErr != nil {
+			return retry.NonRetryableError(fmt.Errorf("%v", diagErr))
+		}
+
+		if d.Get("mode").(string) == queueMembersModeAdditive {
+			// Only report the members owned by this resource so members added elsewhere do not show up as drift
+			ownedUserIds := getQueueMemberRingNums(d.Get("members").(*schema.Set))
+			for _, member := range members.List() {
+				if _, owned := ownedUserIds[member.(map[string]interface{})["user_id"].(string)]; !owned {
+					members.Remove(member)
+				}
+			}
+		}
+
+		d.Set("queue_id", d.Id())
+		d.Set("members", members)
+
+		log.Printf("Read members for queue %s", d.Id())
+		return cc.CheckState()
+	})
+}
+
+func updateRoutingQueueMembers(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	routingAPI := platformclientv2.NewRoutingApiWithConfig(sdkConfig)
+
+	log.Printf("Updating members for queue %s", d.Id())
+
+	newUserRingNums := getQueueMemberRingNums(d.Get("members").(*schema.Set))
+
+	currentMembers, diagErr := getRoutingQueueMembers(d.Id(), "user", routingAPI)
+	if diagErr != nil {
+		return diagErr
+	}
+	currentUserRingNums := make(map[string]int)
+	for _, member := range currentMembers {
+		currentUserRingNums[*member.Id] = *member.RingNumber
+	}
+
+	// Authoritative mode removes every direct member that is not configured. Additive mode only removes
+	// members that were previously managed by this resource.
+	candidates := currentUserRingNums
+	if d.Get("mode").(string) == queueMembersModeAdditive {
+		oldMembers, _ := d.GetChange("members")
+		candidates = getQueueMemberRingNums(oldMembers.(*schema.Set))
+	}
+	var usersToRemove []string
+	for userID := range candidates {
+		_, isMember := currentUserRingNums[userID]
+		if _, configured := newUserRingNums[userID]; isMember && !configured {
+			usersToRemove = append(usersToRemove, userID)
+		}
+	}
+
+	if diagErr := updateQueueMemberAssignments(d.Id(), usersToRemove, newUserRingNums, currentUserRingNums, routingAPI); diagErr != nil {
+		return diagErr
+	}
+
+	log.Printf("Updated members for queue %s", d.Id())
+	return readRoutingQueueMembers(ctx, d, meta)
+}
+
+func deleteRoutingQueueMembers(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	routingAPI := platformclientv2.NewRoutingApiWithConfig(sdkConfig)
+
+	// Only the members managed by this resource are removed, in both modes
+	var usersToRemove []string
+	for userID := range getQueueMemberRingNums(d.Get("members").(*schema.Set)) {
+		usersToRemove = append(usersToRemove, userID)
+	}
+
+	log.Printf("Removing %d members from queue %s", len(usersToRemove), d.Id())
+	if diagErr := updateMembersInChunks(d.Id(), usersToRemove, true, routingAPI); diagErr != nil {
+		_, resp, getErr := routingAPI.GetRoutingQueue(d.Id())
+		if getErr != nil && IsStatus404(resp) {
+			// Queue already deleted
+			return nil
+		}
+		return diagErr
+	}
+
+	log.Printf("Removed members from queue %s", d.Id())
+	return nil
+}
+
+func getQueueMemberRingNums(members *schema.Set) map[string]int {
+	userRingNums := make(map[string]int)
+	if members == nil {
+		return userRingNums
+	}
+	for _, member := range members.List() {
+		memberMap := member.(map[string]interface{})
+		userRingNums[memberMap["user_id"].(string)] = memberMap["ring_num"].(int)
+	}
+	return userRingNums
+}
@@ -0,0 +1,123 @@
+package genesyscloud
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v109/platformclientv2"
+)
+
+func TestAccResourceRoutingQueueMembersModes(t *testing.T) {
+	var (
+		queueResource        = "test-queue"
+		queueName            = "Terraform Test Queue Members-" + uuid.NewString()
+		queueMembersResource = "test-queue-members"
+		userResource1        = "test-queue-user1"
+		userResource2        = "test-queue-user2"
+		userEmail1           = "terraform1-" + uuid.NewString() + "@example.com"
+		userEmail2           = "terraform2-" + uuid.NewString() + "@example.com"
+		userName1            = "Henry Terraform"
+		userName2            = "Amanda Terraform"
+		queueRingNum         = "3"
+		queueID              string
+		unmanagedUserID      string
+	)
+
+	baseConfig := GenerateRoutingQueueResourceBasic(queueResource, queueName) +
+		GenerateBasicUserResource(userResource1, userEmail1, userName1) +
+		GenerateBasicUserResource(userResource2, userEmail2, userName2)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// Additive mode with one member
+				Config: baseConfig + generateRoutingQueueMembersResource(
+					queueMembersResource,
+					"genesyscloud_routing_queue."+queueResource+".id",
+					queueMembersModeAdditive,
+					GenerateMemberBlock("genesyscloud_user."+userResource1+".id", queueRingNum),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					validateMember("genesyscloud_routing_queue_members."+queueMembersResource, "genesyscloud_user."+userResource1, queueRingNum),
+					func(state *terraform.State) error {
+						queueID = state.RootModule().Resources["genesyscloud_routing_queue."+queueResource].Primary.ID
+						unmanagedUserID = state.RootModule().Resources["genesyscloud_user."+userResource2].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				// A member added outside of Terraform is left alone in additive mode
+				PreConfig: func() {
+					if diagErr := updateMembersInChunks(queueID, []string{unmanagedUserID}, false, platformclientv2.NewRoutingApi()); diagErr != nil {
+						t.Fatalf("failed to add member outside of Terraform: %v", diagErr)
+					}
+				},
+				Config: baseConfig + generateRoutingQueueMembersResource(
+					queueMembersResource,
+					"genesyscloud_routing_queue."+queueResource+".id",
+					queueMembersModeAdditive,
+					GenerateMemberBlock("genesyscloud_user."+userResource1+".id", queueRingNum),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_routing_queue_members."+queueMembersResource, "members.#", "1"),
+					testVerifyQueueHasMember(&queueID, &unmanagedUserID, true),
+				),
+			},
+			{
+				// Authoritative mode removes it
+				Config: baseConfig + generateRoutingQueueMembersResource(
+					queueMembersResource,
+					"genesyscloud_routing_queue."+queueResource+".id",
+					queueMembersModeAuthoritative,
+					GenerateMemberBlock("genesyscloud_user."+userResource1+".id", queueRingNum),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_routing_queue_members."+queueMembersResource, "members.#", "1"),
+					testVerifyQueueHasMember(&queueID, &unmanagedUserID, false),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:      "genesyscloud_routing_queue_members." + queueMembersResource,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+		CheckDestroy: testVerifyQueuesDestroyed,
+	})
+}
+
+func generateRoutingQueueMembersResource(resourceName string, queueID string, mode string, members ...string) string {
+	return fmt.Sprintf(`resource "genesyscloud_routing_queue_members" "%s" {
+		queue_id = %s
+		mode     = "%s"
+		%s
+	}
+	`, resourceName, queueID, mode, strings.Join(members, "\n"))
+}
+
+func testVerifyQueueHasMember(queueID *string, userID *string, expected bool) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		members, diagErr := getRoutingQueueMembers(*queueID, "user", platformclientv2.NewRoutingApi())
+		if diagErr != nil {
+			return fmt.Errorf("%v", diagErr)
+		}
+		found := false
+		for _, member := range members {
+			if *member.Id == *userID {
+				found = true
+			}
+		}
+		if found != expected {
+			return fmt.Errorf("expected user %s membership in queue %s to be %v", *userID, *queueID, expected)
+		}
+		return nil
+	}
+}
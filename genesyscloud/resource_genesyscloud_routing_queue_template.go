@@ -0,0 +1,347 @@
+package genesyscloud
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+
+	lists "terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// queueTemplateAttributes are the genesyscloud_routing_queue attributes that can be set by a template
+var queueTemplateAttributes = []string{
+	"media_settings_call",
+	"media_settings_callback",
+	"media_settings_chat",
+	"media_settings_email",
+	"media_settings_message",
+	"routing_rules",
+	"bullseye_rings",
+	"acw_wrapup_prompt",
+	"acw_timeout_ms",
+	"default_script_ids",
+}
+
+// queueSettingDefaults are planned for the settings that neither a queue nor its template sets. The other template
+// attributes keep the value read from Genesys Cloud.
+var queueSettingDefaults = map[string]interface{}{
+	"routing_rules":      []interface{}{},
+	"bullseye_rings":     []interface{}{},
+	"acw_wrapup_prompt":  "MANDATORY_TIMEOUT",
+	"default_script_ids": map[string]interface{}{},
+}
+
+func ResourceRoutingQueueTemplate() *schema.Resource {
+	templateSchema := map[string]*schema.Schema{
+		"name": {
+			Description: "Template name. Only used in Terraform.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+	}
+	queueSchema := ResourceRoutingQueue().Schema
+	for _, key := range queueTemplateAttributes {
+		attribute := *queueSchema[key]
+		attribute.Required = false
+		attribute.Optional = true
+		attribute.Computed = false
+		attribute.Default = nil
+		attribute.Description = strings.TrimSuffix(attribute.Description, fmt.Sprintf(" Defaults to `%v`.", queueSettingDefaults[key]))
+		attribute.DiffSuppressFunc = nil
+		attribute.ForceNew = true
+		templateSchema[key] = &attribute
+	}
+
+	return &schema.Resource{
+		Description: `Genesys Cloud Routing Queue Template holds queue settings shared by many queues. It only exists in Terraform and no object is created in Genesys Cloud.
+
+Queues reference a template with ` + "`template_id`" + `. Settings set on a queue override the template's settings. The template's ID encodes its settings, so changing a template replaces it and updates the queues that use it.`,
+
+		CreateContext: createRoutingQueueTemplate,
+		ReadContext:   readRoutingQueueTemplate,
+		DeleteContext: deleteRoutingQueueTemplate,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: customizeRoutingQueueTemplateDiff,
+		SchemaVersion: 1,
+		Schema:        templateSchema,
+	}
+}
+
+func createRoutingQueueTemplate(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	settings := map[string]interface{}{
+		"name": d.Get("name").(string),
+	}
+	for _, key := range queueTemplateAttributes {
+		if queueSettingSet(d, key) {
+			settings[key] = setsToLists(d.Get(key))
+		}
+	}
+
+	id, err := encodeQueueTemplate(settings)
+	if err != nil {
+		return diag.Errorf("Failed to encode queue template %s: %s", d.Get("name").(string), err)
+	}
+	d.SetId(id)
+
+	log.Printf("Created queue template %s", d.Get("name").(string))
+	return readRoutingQueueTemplate(context.Background(), d, nil)
+}
+
+func readRoutingQueueTemplate(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	settings, err := decodeQueueTemplate(d.Id())
+	if err != nil {
+		return diag.Errorf("Invalid queue template ID: %s", err)
+	}
+
+	d.Set("name", settings["name"])
+	for _, key := range queueTemplateAttributes {
+		d.Set(key, settings[key])
+	}
+	return nil
+}
+
+func deleteRoutingQueueTemplate(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// Nothing exists outside of Terraform
+	log.Printf("Removed queue template %s", d.Get("name").(string))
+	return nil
+}
+
+// customizeRoutingQueueTemplateDiff replaces the template when a nested setting changes, as the settings are part of its ID
+func customizeRoutingQueueTemplateDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	for _, key := range queueTemplateAttributes {
+		if diff.HasChange(key) {
+			if err := diff.ForceNew(key); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// customizeQueueTemplateDiff plans the settings a queue takes from its template, so the plan shows the queue's
+// effective configuration. Settings that neither the queue nor its template sets are planned from queueSettingDefaults.
+func customizeQueueTemplateDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	rawConfig := diff.GetRawConfig()
+	if rawConfig.IsNull() {
+		return nil
+	}
+
+	templateKnown := diff.NewValueKnown("template_id")
+	template := make(map[string]interface{})
+	if templateKnown {
+		var err error
+		if template, err = decodeQueueTemplate(diff.Get("template_id").(string)); err != nil {
+			return err
+		}
+	}
+
+	for _, key := range queueTemplateAttributes {
+		if queueSettingConfigured(rawConfig, key) {
+			continue
+		}
+		if !templateKnown {
+			if err := diff.SetNewComputed(key); err != nil {
+				return err
+			}
+			continue
+		}
+		value, ok := template[key]
+		if !ok {
+			if value, ok = queueSettingDefaults[key]; !ok {
+				continue
+			}
+		}
+		if err := diff.SetNew(key, value); err != nil {
+			return fmt.Errorf("failed to plan %s of queue: %v", key, err)
+		}
+	}
+	return nil
+}
+
+// getQueueSetting returns a queue attribute, falling back to the queue's template when the queue does not set it
+func getQueueSetting(d *schema.ResourceData, key string) interface{} {
+	value := d.Get(key)
+	if queueSettingSet(d, key) {
+		return value
+	}
+	if template, err := decodeQueueTemplate(d.Get("template_id").(string)); err == nil {
+		if templateValue, ok := template[key]; ok {
+			return templateValue
+		}
+	}
+	if defaultValue, ok := queueSettingDefaults[key]; ok && value == "" {
+		return defaultValue
+	}
+	return value
+}
+
+// queueSettingSet reports whether an attribute is set in the configuration, or has a value when there is no configuration
+func queueSettingSet(d *schema.ResourceData, key string) bool {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() {
+		// Defaults are not set by the configuration
+		value, ok := d.GetOk(key)
+		return ok && !reflect.DeepEqual(value, queueSettingDefaults[key])
+	}
+	return queueSettingConfigured(rawConfig, key)
+}
+
+// queueSettingConfigured reports whether an attribute or block is set in the configuration
+func queueSettingConfigured(rawConfig cty.Value, key string) bool {
+	if !rawConfig.Type().IsObjectType() || !rawConfig.Type().HasAttribute(key) {
+		return false
+	}
+	value := rawConfig.GetAttr(key)
+	if value.IsNull() {
+		return false
+	}
+	if !value.IsKnown() {
+		return true
+	}
+	if value.Type().IsListType() || value.Type().IsSetType() || value.Type().IsTupleType() {
+		return value.LengthInt() > 0
+	}
+	return true
+}
+
+func encodeQueueTemplate(settings map[string]interface{}) (string, error) {
+	content, err := json.Marshal(settings)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(content), nil
+}
+
+// decodeQueueTemplate returns the settings encoded in a template ID with the types of the queue schema.
+// An empty ID has no settings.
+func decodeQueueTemplate(id string) (map[string]interface{}, error) {
+	settings := make(map[string]interface{})
+	if id == "" {
+		return settings, nil
+	}
+
+	content, err := base64.RawURLEncoding.DecodeString(id)
+	if err != nil {
+		return nil, fmt.Errorf("%s is not a genesyscloud_routing_queue_template ID", id)
+	}
+	var raw map[string]interface{}
+	if err := json.Unmarshal(content, &raw); err != nil {
+		return nil, fmt.Errorf("%s is not a genesyscloud_routing_queue_template ID", id)
+	}
+
+	queueSchema := ResourceRoutingQueue().Schema
+	for key, value := range raw {
+		if key == "name" {
+			settings[key] = value
+			continue
+		}
+		attribute, ok := queueSchema[key]
+		if !ok || !lists.ItemInSlice(key, queueTemplateAttributes) {
+			return nil, fmt.Errorf("queue template has unknown setting %s", key)
+		}
+		settings[key] = convertToSchemaType(attribute, value)
+	}
+	return settings, nil
+}
+
+func validateQueueTemplateId(value interface{}, _ string) ([]string, []error) {
+	if _, err := decodeQueueTemplate(value.(string)); err != nil {
+		return nil, []error{err}
+	}
+	return nil, nil
+}
+
+// convertToSchemaType converts a value decoded from JSON to the types used by an attribute's schema
+func convertToSchemaType(attribute *schema.Schema, value interface{}) interface{} {
+	if value == nil {
+		return nil
+	}
+	switch attribute.Type {
+	case schema.TypeInt:
+		if number, ok := value.(float64); ok {
+			return int(number)
+		}
+	case schema.TypeList, schema.TypeSet:
+		items, ok := value.([]interface{})
+		if !ok {
+			return value
+		}
+		converted := make([]interface{}, len(items))
+		for i, item := range items {
+			switch elem := attribute.Elem.(type) {
+			case *schema.Resource:
+				itemMap, _ := item.(map[string]interface{})
+				convertedMap := make(map[string]interface{})
+				for itemKey, itemValue := range itemMap {
+					if itemSchema, ok := elem.Schema[itemKey]; ok {
+						convertedMap[itemKey] = convertToSchemaType(itemSchema, itemValue)
+					}
+				}
+				converted[i] = convertedMap
+			case *schema.Schema:
+				converted[i] = convertToSchemaType(elem, item)
+			default:
+				converted[i] = item
+			}
+		}
+		if attribute.Type == schema.TypeSet {
+			switch elem := attribute.Elem.(type) {
+			case *schema.Resource:
+				return schema.NewSet(schema.HashResource(elem), converted)
+			case *schema.Schema:
+				return schema.NewSet(schema.HashSchema(elem), converted)
+			}
+		}
+		return converted
+	case schema.TypeMap:
+		items, ok := value.(map[string]interface{})
+		if !ok {
+			return value
+		}
+		converted := make(map[string]interface{})
+		for itemKey, item := range items {
+			if elem, ok := attribute.Elem.(*schema.Schema); ok {
+				converted[itemKey] = convertToSchemaType(elem, item)
+			} else {
+				converted[itemKey] = item
+			}
+		}
+		return converted
+	}
+	return value
+}
+
+// setsToLists replaces the sets in a value read from resource data with lists so it can be encoded
+func setsToLists(value interface{}) interface{} {
+	switch v := value.(type) {
+	case *schema.Set:
+		return setsToLists(v.List())
+	case []interface{}:
+		converted := make([]interface{}, len(v))
+		for i, item := range v {
+			converted[i] = setsToLists(item)
+		}
+		return converted
+	case map[string]interface{}:
+		converted := make(map[string]interface{})
+		for key, item := range v {
+			converted[key] = setsToLists(item)
+		}
+		return converted
+	}
+	return value
+}
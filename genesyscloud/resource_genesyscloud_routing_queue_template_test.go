@@ -0,0 +1,177 @@
+package genesyscloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceRoutingQueueTemplate(t *testing.T) {
+	var (
+		templateResource = "test-queue-template"
+		queueResource1   = "test-queue-1"
+		queueResource2   = "test-queue-2"
+		queueName1       = "Terraform Test Queue Template-" + uuid.NewString()
+		queueName2       = "Terraform Test Queue Template-" + uuid.NewString()
+		templateID       = "genesyscloud_routing_queue_template." + templateResource + ".id"
+	)
+
+	templateConfig := generateRoutingQueueTemplateResource(
+		templateResource,
+		"Standard Queue",
+		`acw_wrapup_prompt = "OPTIONAL"`,
+		generateMediaSettings("media_settings_call", "20", "0.8", "30000"),
+		generateRoutingRules("ANY", "50", "6"),
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// The first queue takes everything from the template, the second overrides the call settings
+				Config: templateConfig +
+					GenerateRoutingQueueResourceBasic(queueResource1, queueName1, "template_id = "+templateID) +
+					GenerateRoutingQueueResourceBasic(queueResource2, queueName2, "template_id = "+templateID,
+						generateMediaSettings("media_settings_call", "10", "0.5", "20000")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "acw_wrapup_prompt", "OPTIONAL"),
+					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "media_settings_call.0.alerting_timeout_sec", "20"),
+					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "routing_rules.0.operator", "ANY"),
+					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource2, "acw_wrapup_prompt", "OPTIONAL"),
+					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource2, "media_settings_call.0.alerting_timeout_sec", "10"),
+					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource2, "routing_rules.0.threshold", "50"),
+				),
+			},
+			{
+				// Dropping the template restores the defaults
+				Config: templateConfig +
+					GenerateRoutingQueueResourceBasic(queueResource1, queueName1) +
+					GenerateRoutingQueueResourceBasic(queueResource2, queueName2, "template_id = "+templateID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "acw_wrapup_prompt", "MANDATORY_TIMEOUT"),
+					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource1, "routing_rules.#", "0"),
+					resource.TestCheckResourceAttr("genesyscloud_routing_queue."+queueResource2, "media_settings_call.0.alerting_timeout_sec", "20"),
+				),
+			},
+			{
+				ResourceName:      "genesyscloud_routing_queue_template." + templateResource,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+		CheckDestroy: testVerifyQueuesDestroyed,
+	})
+}
+
+func TestQueueTemplateRoundTrip(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ResourceRoutingQueueTemplate().Schema, map[string]interface{}{
+		"name":              "Standard Queue",
+		"acw_wrapup_prompt": "OPTIONAL",
+		"routing_rules": []interface{}{
+			map[string]interface{}{"operator": "ANY", "threshold": 50, "wait_seconds": 6.5},
+		},
+		"bullseye_rings": []interface{}{
+			map[string]interface{}{"expansion_timeout_seconds": 15.0, "skills_to_remove": []interface{}{"skill-1"}},
+		},
+		"default_script_ids": map[string]interface{}{"CALL": "script-1"},
+	})
+	if diagErr := createRoutingQueueTemplate(context.Background(), d, nil); diagErr.HasError() {
+		t.Fatalf("failed to create template: %v", diagErr)
+	}
+
+	settings, err := decodeQueueTemplate(d.Id())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := settings["media_settings_call"]; ok {
+		t.Error("expected unset attributes to be left out of the template")
+	}
+	rule := settings["routing_rules"].([]interface{})[0].(map[string]interface{})
+	if threshold, ok := rule["threshold"].(int); !ok || threshold != 50 {
+		t.Errorf("expected integer threshold 50, got %#v", rule["threshold"])
+	}
+	ring := settings["bullseye_rings"].([]interface{})[0].(map[string]interface{})
+	if skills, ok := ring["skills_to_remove"].(*schema.Set); !ok || !skills.Contains("skill-1") {
+		t.Errorf("expected skills to remove as a set, got %#v", ring["skills_to_remove"])
+	}
+
+	// Queue settings override the template
+	queue := schema.TestResourceDataRaw(t, ResourceRoutingQueue().Schema, map[string]interface{}{
+		"name":        "Queue",
+		"template_id": d.Id(),
+		"routing_rules": []interface{}{
+			map[string]interface{}{"operator": "MEETS_THRESHOLD", "threshold": 10, "wait_seconds": 5.0},
+		},
+	})
+	if rules := buildSdkRoutingRules(queue); len(*rules) != 1 || *(*rules)[0].Operator != "MEETS_THRESHOLD" {
+		t.Errorf("expected the queue's routing rule, got %+v", rules)
+	}
+	if acw := buildSdkAcwSettings(queue); *acw.WrapupPrompt != "OPTIONAL" {
+		t.Errorf("expected the template's wrapup prompt, got %s", *acw.WrapupPrompt)
+	}
+	if bullseye := buildSdkBullseyeSettings(queue); bullseye == nil || *(*(*bullseye.Rings)[0].ExpansionCriteria)[0].Threshold != 15 {
+		t.Errorf("expected the template's bullseye ring, got %+v", bullseye)
+	}
+	if scripts := buildSdkDefaultScriptsMap(queue); scripts == nil || *(*scripts)["CALL"].Id != "script-1" {
+		t.Errorf("expected the template's default script, got %+v", scripts)
+	}
+
+	// Plans show the template's settings for the settings a queue does not set
+	planQueue := func(config map[string]interface{}) map[string]string {
+		queueResource := ResourceRoutingQueue()
+		rawConfig := make(map[string]cty.Value)
+		for name, attrType := range queueResource.CoreConfigSchema().ImpliedType().AttributeTypes() {
+			rawConfig[name] = cty.NullVal(attrType)
+		}
+		for name, value := range config {
+			rawConfig[name] = cty.StringVal(value.(string))
+		}
+		state := &terraform.InstanceState{
+			ID:         "queue-1",
+			Attributes: map[string]string{"id": "queue-1", "name": "Queue", "acw_wrapup_prompt": "MANDATORY"},
+			RawConfig:  cty.ObjectVal(rawConfig),
+		}
+		diff, err := queueResource.Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil)
+		if err != nil {
+			t.Fatalf("failed to plan queue: %v", err)
+		}
+		planned := make(map[string]string)
+		for key, attrDiff := range diff.Attributes {
+			planned[key] = attrDiff.New
+		}
+		return planned
+	}
+	planned := planQueue(map[string]interface{}{"name": "Queue", "template_id": d.Id()})
+	for key, value := range map[string]string{
+		"acw_wrapup_prompt":        "OPTIONAL",
+		"routing_rules.0.operator": "ANY",
+		"default_script_ids.CALL":  "script-1",
+	} {
+		if planned[key] != value {
+			t.Errorf("expected %s to be planned as %q from the template, got %q", key, value, planned[key])
+		}
+	}
+	if planned := planQueue(map[string]interface{}{"name": "Queue"}); planned["acw_wrapup_prompt"] != "MANDATORY_TIMEOUT" {
+		t.Errorf("expected a queue without a template to plan the default wrapup prompt, got %q", planned["acw_wrapup_prompt"])
+	}
+
+	if _, errs := validateQueueTemplateId("not a template", "template_id"); len(errs) == 0 {
+		t.Error("expected an invalid template ID to fail validation")
+	}
+}
+
+func generateRoutingQueueTemplateResource(resourceID string, name string, attrs ...string) string {
+	return fmt.Sprintf(`resource "genesyscloud_routing_queue_template" "%s" {
+		name = "%s"
+		%s
+	}
+	`, resourceID, name, strings.Join(attrs, "\n"))
+}
@@ -0,0 +1,243 @@
+package genesyscloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"sync"
+
+	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+	lists "terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v109/platformclientv2"
+)
+
+// skillAssignmentConcurrency is the number of users updated at the same time
+const skillAssignmentConcurrency = 5
+
+func ResourceRoutingSkillAssignments() *schema.Resource {
+	return &schema.Resource{
+		Description: `Genesys Cloud Routing Skill Assignments maintains which users have a skill and their proficiency, for a single skill.
+
+Only the users listed here are managed. Other users with the skill and other skills of the listed users are left alone. Set ` + "`ignore_unmanaged_routing_skills`" + ` on ` + "`genesyscloud_user`" + ` resources that also set ` + "`routing_skills`" + ` so they do not remove the skill.`,
+
+		CreateContext: CreateWithPooledClient(createRoutingSkillAssignments),
+		ReadContext:   ReadWithPooledClient(readRoutingSkillAssignments),
+		UpdateContext: UpdateWithPooledClient(updateRoutingSkillAssignments),
+		DeleteContext: DeleteWithPooledClient(deleteRoutingSkillAssignments),
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"skill_id": {
+				Description: "ID of the skill to assign.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"user_proficiencies": {
+				Description:      "Map of user ID to the user's proficiency in the skill (0 - 5).",
+				Type:             schema.TypeMap,
+				Required:         true,
+				Elem:             &schema.Schema{Type: schema.TypeFloat},
+				ValidateDiagFunc: validateSkillProficiencies,
+			},
+		},
+	}
+}
+
+func createRoutingSkillAssignments(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(d.Get("skill_id").(string))
+	return updateRoutingSkillAssignments(ctx, d, meta)
+}
+
+func readRoutingSkillAssignments(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	routingAPI := platformclientv2.NewRoutingApiWithConfig(sdkConfig)
+	usersAPI := platformclientv2.NewUsersApiWithConfig(sdkConfig)
+
+	log.Printf("Reading assignments for skill %s", d.Id())
+
+	return WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		_, resp, getErr := routingAPI.GetRoutingSkill(d.Id())
+		if getErr != nil {
+			if IsStatus404(resp) {
+				return retry.RetryableError(fmt.Errorf("Failed to read skill %s: %s", d.Id(), getErr))
+			}
+			return retry.NonRetryableError(fmt.Errorf("Failed to read skill %s: %s", d.Id(), getErr))
+		}
+
+		cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, ResourceRoutingSkillAssignments())
+
+		var userIds []string
+		for userId := range d.Get("user_proficiencies").(map[string]interface{}) {
+			userIds = append(userIds, userId)
+		}
+		proficiencies, err := getUserSkillProficiencies(userIds, d.Id(), usersAPI)
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		// Users that no longer have the skill drop out and are assigned again on the next apply
+		d.Set("skill_id", d.Id())
+		d.Set("user_proficiencies", proficiencies)
+
+		log.Printf("Read assignments for skill %s", d.Id())
+		return cc.CheckState()
+	})
+}
+
+func updateRoutingSkillAssignments(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	usersAPI := platformclientv2.NewUsersApiWithConfig(sdkConfig)
+
+	oldProficiencies, newProficiencies := d.GetChange("user_proficiencies")
+	oldMap := oldProficiencies.(map[string]interface{})
+	newMap := newProficiencies.(map[string]interface{})
+
+	var usersToRemove []string
+	for userId := range oldMap {
+		if _, ok := newMap[userId]; !ok {
+			usersToRemove = append(usersToRemove, userId)
+		}
+	}
+	var usersToAssign []string
+	for userId, proficiency := range newMap {
+		if oldMap[userId] != proficiency {
+			usersToAssign = append(usersToAssign, userId)
+		}
+	}
+	sort.Strings(usersToRemove)
+	sort.Strings(usersToAssign)
+
+	log.Printf("Updating assignments for skill %s: %d to assign, %d to remove", d.Id(), len(usersToAssign), len(usersToRemove))
+
+	var diagErr diag.Diagnostics
+	var mutex sync.Mutex
+	runWithConcurrency(skillAssignmentConcurrency, usersToRemove, func(userId string) {
+		if err := removeUserSkill(userId, d.Id(), usersAPI); err != nil {
+			mutex.Lock()
+			diagErr = append(diagErr, err...)
+			mutex.Unlock()
+		}
+	})
+	runWithConcurrency(skillAssignmentConcurrency, usersToAssign, func(userId string) {
+		if err := assignUserSkill(userId, d.Id(), newMap[userId].(float64), usersAPI); err != nil {
+			mutex.Lock()
+			diagErr = append(diagErr, err...)
+			mutex.Unlock()
+		}
+	})
+	if diagErr.HasError() {
+		return diagErr
+	}
+
+	log.Printf("Updated assignments for skill %s", d.Id())
+	return readRoutingSkillAssignments(ctx, d, meta)
+}
+
+func deleteRoutingSkillAssignments(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	usersAPI := platformclientv2.NewUsersApiWithConfig(sdkConfig)
+
+	var userIds []string
+	for userId := range d.Get("user_proficiencies").(map[string]interface{}) {
+		userIds = append(userIds, userId)
+	}
+	sort.Strings(userIds)
+
+	log.Printf("Removing skill %s from %d users", d.Id(), len(userIds))
+	var diagErr diag.Diagnostics
+	var mutex sync.Mutex
+	runWithConcurrency(skillAssignmentConcurrency, userIds, func(userId string) {
+		// Users that were deleted no longer have the skill
+		if err := removeUserSkill(userId, d.Id(), usersAPI); err != nil {
+			mutex.Lock()
+			diagErr = append(diagErr, err...)
+			mutex.Unlock()
+		}
+	})
+	if diagErr.HasError() {
+		return diagErr
+	}
+
+	log.Printf("Removed skill %s from users", d.Id())
+	return nil
+}
+
+// assignUserSkill adds or updates one skill of a user without touching the user's other skills
+func assignUserSkill(userId string, skillId string, proficiency float64, usersAPI *platformclientv2.UsersApi) diag.Diagnostics {
+	return RetryWhen(IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+		_, resp, err := usersAPI.PatchUserRoutingskillsBulk(userId, []platformclientv2.Userroutingskillpost{
+			{
+				Id:          &skillId,
+				Proficiency: &proficiency,
+			},
+		})
+		if err != nil {
+			return resp, diag.Errorf("Failed to assign skill %s to user %s: %s", skillId, userId, err)
+		}
+		return nil, nil
+	})
+}
+
+// getUserSkillProficiencies returns the proficiency in a skill of each of the users that have it, reading users 100 at a time
+func getUserSkillProficiencies(userIds []string, skillId string, usersAPI *platformclientv2.UsersApi) (map[string]interface{}, error) {
+	sort.Strings(userIds)
+	proficiencies := make(map[string]interface{})
+	for _, chunk := range lists.ChunkStringSlice(userIds, 100) {
+		// Inactive users keep their skills, so users of any state are read
+		users, _, err := usersAPI.GetUsers(len(chunk), 1, chunk, nil, "", []string{"skills"}, "", "any")
+		if err != nil {
+			return nil, fmt.Errorf("failed to read skills of users: %v", err)
+		}
+		if users.Entities == nil {
+			continue
+		}
+		for _, user := range *users.Entities {
+			if user.Skills == nil {
+				continue
+			}
+			for _, skill := range *user.Skills {
+				if skill.Id != nil && *skill.Id == skillId && skill.Proficiency != nil {
+					proficiencies[*user.Id] = *skill.Proficiency
+				}
+			}
+		}
+	}
+	return proficiencies, nil
+}
+
+func validateSkillProficiencies(value interface{}, path cty.Path) diag.Diagnostics {
+	var diagErr diag.Diagnostics
+	for userId, proficiency := range value.(map[string]interface{}) {
+		var prof float64
+		switch p := proficiency.(type) {
+		case float64:
+			prof = p
+		case int:
+			prof = float64(p)
+		case string:
+			parsed, err := strconv.ParseFloat(p, 64)
+			if err != nil {
+				// Unknown values are checked again once known
+				continue
+			}
+			prof = parsed
+		default:
+			continue
+		}
+		if prof < 0 || prof > 5 {
+			diagErr = append(diagErr, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       fmt.Sprintf("Proficiency of user %s must be between 0 and 5, got %v", userId, prof),
+				AttributePath: path,
+			})
+		}
+	}
+	return diagErr
+}
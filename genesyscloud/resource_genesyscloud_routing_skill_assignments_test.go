@@ -0,0 +1,90 @@
+package genesyscloud
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceRoutingSkillAssignments(t *testing.T) {
+	var (
+		assignmentsResource = "test-skill-assignments"
+		skillResource1      = "test-skill-1"
+		skillResource2      = "test-skill-2"
+		skillName1          = "Terraform Skill Assignments-" + uuid.NewString()
+		skillName2          = "Terraform Skill Assignments-" + uuid.NewString()
+		userResource1       = "test-user1"
+		userResource2       = "test-user2"
+		userEmail1          = "terraform1-" + uuid.NewString() + "@example.com"
+		userEmail2          = "terraform2-" + uuid.NewString() + "@example.com"
+		userID1             = "genesyscloud_user." + userResource1 + ".id"
+		userID2             = "genesyscloud_user." + userResource2 + ".id"
+	)
+
+	// The first user also manages a different skill of its own
+	baseConfig := generateRoutingSkillResource(skillResource1, skillName1) +
+		generateRoutingSkillResource(skillResource2, skillName2) +
+		generateUserWithCustomAttrs(userResource1, userEmail1, "Skill Assignments One",
+			generateUserRoutingSkill("genesyscloud_routing_skill."+skillResource2+".id", "1"),
+			"ignore_unmanaged_routing_skills = true") +
+		GenerateBasicUserResource(userResource2, userEmail2, "Skill Assignments Two")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// Assign the skill to both users
+				Config: baseConfig + generateRoutingSkillAssignmentsResource(
+					assignmentsResource,
+					"genesyscloud_routing_skill."+skillResource1+".id",
+					fmt.Sprintf("(%s) = 4", userID1),
+					fmt.Sprintf("(%s) = 2.5", userID2),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_routing_skill_assignments."+assignmentsResource, "user_proficiencies.%", "2"),
+					validateUserSkill("genesyscloud_user."+userResource1, "genesyscloud_routing_skill."+skillResource2, "1"),
+					resource.TestCheckResourceAttr("genesyscloud_user."+userResource1, "routing_skills.#", "1"),
+				),
+			},
+			{
+				// Change a proficiency and remove a user
+				Config: baseConfig + generateRoutingSkillAssignmentsResource(
+					assignmentsResource,
+					"genesyscloud_routing_skill."+skillResource1+".id",
+					fmt.Sprintf("(%s) = 5", userID1),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_routing_skill_assignments."+assignmentsResource, "user_proficiencies.%", "1"),
+					resource.TestCheckResourceAttr("genesyscloud_user."+userResource1, "routing_skills.#", "1"),
+				),
+			},
+		},
+		CheckDestroy: testVerifySkillsDestroyed,
+	})
+}
+
+func TestValidateSkillProficiencies(t *testing.T) {
+	diagErr := validateSkillProficiencies(map[string]interface{}{
+		"user-1": 4.5,
+		"user-2": "6",
+		"user-3": "${unknown}",
+	}, cty.GetAttrPath("user_proficiencies"))
+	if len(diagErr) != 1 || !strings.Contains(diagErr[0].Summary, "user-2") {
+		t.Errorf("expected a single error for user-2, got %v", diagErr)
+	}
+}
+
+func generateRoutingSkillAssignmentsResource(resourceID string, skillID string, proficiencies ...string) string {
+	return fmt.Sprintf(`resource "genesyscloud_routing_skill_assignments" "%s" {
+		skill_id           = %s
+		user_proficiencies = {
+			%s
+		}
+	}
+	`, resourceID, skillID, strings.Join(proficiencies, "\n"))
+}
@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
@@ -18,6 +20,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/mypurecloud/platform-client-sdk-go/v109/platformclientv2"
 )
 
@@ -31,6 +34,14 @@ type SkillGroupsRequest struct {
 	SkillConditions struct{} `json:"skillConditions"` //Keep this here.  Even though we do not use this field in the struct The generated attributed is used as a placeholder
 }
 
+// skillGroupConditionMaxDepth is how deep skill_condition blocks can be nested
+const skillGroupConditionMaxDepth = 3
+
+var (
+	skillGroupConditionOperations  = []string{"And", "Or"}
+	skillGroupConditionComparators = []string{"GreaterThan", "GreaterThanOrEqualTo", "LessThan", "LessThanOrEqualTo", "EqualTo", "NotEqualTo"}
+)
+
 type AllSkillGroups struct {
 	Entities []struct {
 		ID   string `json:"id"`
@@ -96,6 +107,7 @@ func ResourceSkillGroupExporter() *resourceExporter.ResourceExporter {
 			"division_id": {"division_id"},
 		},
 		JsonEncodeAttributes: []string{"skill_conditions"},
+		ExcludedAttributes:   []string{"member_count", "member_user_ids"},
 	}
 }
 
@@ -130,12 +142,36 @@ func ResourceRoutingSkillGroup() *schema.Resource {
 				Computed:    true,
 			},
 			"skill_conditions": {
-				Description:      "JSON encoded array of rules that will be used to determine group membership.",
+				Description:      "JSON encoded array of rules that will be used to determine group membership. Use `skill_condition` blocks instead to reference skills and languages by ID.",
 				Type:             schema.TypeString,
 				Optional:         true,
 				Computed:         true,
 				DiffSuppressFunc: suppressEquivalentJsonDiffs,
 			},
+			"skill_condition": {
+				Description:   "Rules that will be used to determine group membership, as an alternative to `skill_conditions`. Conditions can be nested " + strconv.Itoa(skillGroupConditionMaxDepth) + " levels deep.",
+				Type:          schema.TypeList,
+				Optional:      true,
+				Elem:          skillGroupConditionResource(skillGroupConditionMaxDepth),
+				ConflictsWith: []string{"skill_conditions"},
+			},
+			"preview_members": {
+				Description: "Whether to read the IDs of the users in the group into `member_user_ids`. Genesys Cloud evaluates the skill conditions in the background, so the members can lag behind a change to the conditions.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"member_count": {
+				Description: "Number of users that match the skill conditions.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"member_user_ids": {
+				Description: "IDs of the users that match the skill conditions. Only read when `preview_members` is true.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 			"member_division_ids": {
 				Description: "The IDs of member divisions to add or remove for this skill group. An empty array means all divisions will be removed, \"*\" means all divisions will be added.",
 				Type:        schema.TypeList,
@@ -169,14 +205,23 @@ func createOrUpdateSkillGroups(ctx context.Context, d *schema.ResourceData, meta
 		skillGroupsRequest.Division.ID = divisionId
 	}
 
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	routingAPI := platformclientv2.NewRoutingApiWithConfig(sdkConfig)
+
+	skillConditionsJson := d.Get("skill_conditions").(string)
+	if skillConditions := d.Get("skill_condition").([]interface{}); len(skillConditions) > 0 {
+		var err error
+		skillConditionsJson, err = buildSkillGroupConditionsJson(skillConditions, newSkillGroupConditionResolver(routingAPI))
+		if err != nil {
+			return diag.Errorf("Invalid skill_condition for skill group %s: %s", skillGroupsRequest.Name, err)
+		}
+	}
+
 	//Merge in skill conditions
-	finalSkillGroupsJson, err := mergeSkillConditionsIntoSkillGroups(d, skillGroupsRequest)
+	finalSkillGroupsJson, err := mergeSkillConditionsIntoSkillGroups(skillConditionsJson, skillGroupsRequest)
 	if err != nil {
 		return diag.Errorf("Failed to read the before skills groups request before: %s: %s", skillGroupsRequest.Name, err)
 	}
-
-	sdkConfig := meta.(*ProviderMeta).ClientConfig
-	routingAPI := platformclientv2.NewRoutingApiWithConfig(sdkConfig)
 	apiClient := &routingAPI.Configuration.APIClient
 	path := routingAPI.Configuration.BasePath + route
 	headerParams := buildHeaderParams(routingAPI)
@@ -327,8 +372,8 @@ and replace the empty stringConditions string with the contents of skill_conditi
 
 Not the most eloquent code, but these are uncivilized times.
 */
-func mergeSkillConditionsIntoSkillGroups(d *schema.ResourceData, skillGroupsRequest *SkillGroupsRequest) (string, error) {
-	skillsConditionsJsonString := fmt.Sprintf(`"skillConditions": %s`, d.Get("skill_conditions").(string))
+func mergeSkillConditionsIntoSkillGroups(skillConditionsJson string, skillGroupsRequest *SkillGroupsRequest) (string, error) {
+	skillsConditionsJsonString := fmt.Sprintf(`"skillConditions": %s`, skillConditionsJson)
 
 	//Get the before image of the JSON.  Note this a byte array
 	skillGroupsRequestBefore, err := json.Marshal(skillGroupsRequest)
@@ -339,7 +384,7 @@ func mergeSkillConditionsIntoSkillGroups(d *schema.ResourceData, skillGroupsRequ
 	skillGroupsRequestAfter := ""
 
 	//Skill conditions are present, replace skill conditions with the content of the string
-	if d.Get("skill_conditions").(string) != "" {
+	if skillConditionsJson != "" {
 		skillGroupsRequestAfter = strings.Replace(string(skillGroupsRequestBefore), `"skillConditions":{}`, skillsConditionsJsonString, 1)
 	} else {
 		//Skill conditions are not present, get rid of skill conditions.
@@ -421,6 +466,31 @@ func readSkillGroups(ctx context.Context, d *schema.ResourceData, meta interface
 			d.Set("skill_conditions", nil)
 		}
 
+		// The typed form is only read when it is used, so imported and exported skill groups keep the JSON form
+		if len(d.Get("skill_condition").([]interface{})) > 0 {
+			flattenedConditions, err := flattenSkillGroupConditionsJson(skillConditions, newSkillGroupConditionResolver(routingAPI))
+			if err != nil {
+				return retry.NonRetryableError(fmt.Errorf("Failed to read skill conditions of skill group %s: %s", d.Id(), err))
+			}
+			d.Set("skill_condition", flattenedConditions)
+		}
+
+		if memberCount, ok := skillGroupPayload["memberCount"].(float64); ok {
+			d.Set("member_count", int(memberCount))
+		} else {
+			d.Set("member_count", 0)
+		}
+
+		if d.Get("preview_members").(bool) {
+			memberUserIds, err := getSkillGroupMemberUserIds(d.Id(), routingAPI)
+			if err != nil {
+				return retry.NonRetryableError(fmt.Errorf("Failed to read members of skill group %s: %s", d.Id(), err))
+			}
+			d.Set("member_user_ids", memberUserIds)
+		} else {
+			d.Set("member_user_ids", nil)
+		}
+
 		apiMemberDivisionIds, diagErr := readSkillGroupMemberDivisionIds(d, routingAPI)
 		if diagErr != nil {
 			return retry.NonRetryableError(fmt.Errorf("%v", diagErr))
@@ -587,3 +657,358 @@ func removeSkillGroupDivisionID(d *schema.ResourceData, list []string) ([]string
 	}
 	return list, nil
 }
+
+// skillGroupConditionResource is the schema of a skill_condition block with child conditions nested up to depth levels
+func skillGroupConditionResource(depth int) *schema.Resource {
+	skillCondition := func(idKey string, idDescription string) *schema.Resource {
+		conditionSchema := map[string]*schema.Schema{
+			idKey: {
+				Description:  idDescription,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"comparator": {
+				Description:  "Comparator applied to the proficiency (" + strings.Join(skillGroupConditionComparators, " | ") + ").",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(skillGroupConditionComparators, false),
+			},
+			"proficiency": {
+				Description:  "Proficiency the comparator is applied to (0 - 5).",
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 5),
+			},
+		}
+		if depth > 1 {
+			conditionSchema["child_conditions"] = &schema.Schema{
+				Description: "Nested conditions that users with the skill must also match.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        skillGroupConditionResource(depth - 1),
+			}
+		}
+		return &schema.Resource{Schema: conditionSchema}
+	}
+
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"operation": {
+				Description:  "Operation applied to the conditions (And | Or).",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "And",
+				ValidateFunc: validation.StringInSlice(skillGroupConditionOperations, false),
+			},
+			"routing_skill_conditions": {
+				Description: "Conditions on the proficiency of users in a routing skill.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        skillCondition("skill_id", "ID of the routing skill."),
+			},
+			"language_skill_conditions": {
+				Description: "Conditions on the proficiency of users in a language.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        skillCondition("language_id", "ID of the routing language."),
+			},
+		},
+	}
+}
+
+// skillGroupConditionResolver converts between the skill and language IDs of skill_condition blocks and the names
+// used by the skill groups API, caching what it has looked up
+type skillGroupConditionResolver struct {
+	routingAPI    *platformclientv2.RoutingApi
+	skillNames    map[string]string
+	skillIds      map[string]string
+	languageNames map[string]string
+	languageIds   map[string]string
+}
+
+func newSkillGroupConditionResolver(routingAPI *platformclientv2.RoutingApi) *skillGroupConditionResolver {
+	return &skillGroupConditionResolver{
+		routingAPI:    routingAPI,
+		skillNames:    make(map[string]string),
+		skillIds:      make(map[string]string),
+		languageNames: make(map[string]string),
+		languageIds:   make(map[string]string),
+	}
+}
+
+func (r *skillGroupConditionResolver) skillName(id string) (string, error) {
+	if name, ok := r.skillNames[id]; ok {
+		return name, nil
+	}
+	skill, resp, err := r.routingAPI.GetRoutingSkill(id)
+	if err != nil {
+		if IsStatus404(resp) {
+			return "", fmt.Errorf("skill %s does not exist", id)
+		}
+		return "", fmt.Errorf("failed to read skill %s: %v", id, err)
+	}
+	r.skillNames[id] = *skill.Name
+	r.skillIds[*skill.Name] = id
+	return *skill.Name, nil
+}
+
+func (r *skillGroupConditionResolver) skillId(name string) (string, error) {
+	if id, ok := r.skillIds[name]; ok {
+		return id, nil
+	}
+	skills, _, err := r.routingAPI.GetRoutingSkills(100, 1, name, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to find skill %s: %v", name, err)
+	}
+	if skills.Entities != nil {
+		for _, skill := range *skills.Entities {
+			if skill.Name != nil && *skill.Name == name {
+				r.skillNames[*skill.Id] = name
+				r.skillIds[name] = *skill.Id
+				return *skill.Id, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("skill %s does not exist", name)
+}
+
+func (r *skillGroupConditionResolver) languageName(id string) (string, error) {
+	if name, ok := r.languageNames[id]; ok {
+		return name, nil
+	}
+	language, resp, err := r.routingAPI.GetRoutingLanguage(id)
+	if err != nil {
+		if IsStatus404(resp) {
+			return "", fmt.Errorf("language %s does not exist", id)
+		}
+		return "", fmt.Errorf("failed to read language %s: %v", id, err)
+	}
+	r.languageNames[id] = *language.Name
+	r.languageIds[*language.Name] = id
+	return *language.Name, nil
+}
+
+func (r *skillGroupConditionResolver) languageId(name string) (string, error) {
+	if id, ok := r.languageIds[name]; ok {
+		return id, nil
+	}
+	languages, _, err := r.routingAPI.GetRoutingLanguages(100, 1, "", name, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to find language %s: %v", name, err)
+	}
+	if languages.Entities != nil {
+		for _, language := range *languages.Entities {
+			if language.Name != nil && *language.Name == name {
+				r.languageNames[*language.Id] = name
+				r.languageIds[name] = *language.Id
+				return *language.Id, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("language %s does not exist", name)
+}
+
+// buildSkillGroupConditionsJson converts skill_condition blocks to the JSON skill conditions of the API
+func buildSkillGroupConditionsJson(conditions []interface{}, resolver *skillGroupConditionResolver) (string, error) {
+	sdkConditions, err := buildSdkSkillGroupConditions(conditions, "skill_condition", resolver)
+	if err != nil {
+		return "", err
+	}
+	conditionsJson, err := json.Marshal(sdkConditions)
+	if err != nil {
+		return "", err
+	}
+	return string(conditionsJson), nil
+}
+
+func buildSdkSkillGroupConditions(conditions []interface{}, path string, resolver *skillGroupConditionResolver) ([]platformclientv2.Skillgroupcondition, error) {
+	sdkConditions := make([]platformclientv2.Skillgroupcondition, 0)
+	for i, condition := range conditions {
+		conditionMap, ok := condition.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		conditionPath := fmt.Sprintf("%s.%d", path, i)
+		operation := conditionMap["operation"].(string)
+
+		routingConditions := make([]platformclientv2.Skillgrouproutingcondition, 0)
+		for j, routingCondition := range conditionMap["routing_skill_conditions"].([]interface{}) {
+			routingMap := routingCondition.(map[string]interface{})
+			routingPath := fmt.Sprintf("%s.routing_skill_conditions.%d", conditionPath, j)
+			name, err := resolver.skillName(routingMap["skill_id"].(string))
+			if err != nil {
+				return nil, fmt.Errorf("%s: %v", routingPath, err)
+			}
+			childConditions, err := buildSdkSkillGroupChildConditions(routingMap, routingPath, resolver)
+			if err != nil {
+				return nil, err
+			}
+			comparator := routingMap["comparator"].(string)
+			proficiency := routingMap["proficiency"].(int)
+			routingConditions = append(routingConditions, platformclientv2.Skillgrouproutingcondition{
+				RoutingSkill:    &name,
+				Comparator:      &comparator,
+				Proficiency:     &proficiency,
+				ChildConditions: childConditions,
+			})
+		}
+
+		languageConditions := make([]platformclientv2.Skillgrouplanguagecondition, 0)
+		for j, languageCondition := range conditionMap["language_skill_conditions"].([]interface{}) {
+			languageMap := languageCondition.(map[string]interface{})
+			languagePath := fmt.Sprintf("%s.language_skill_conditions.%d", conditionPath, j)
+			name, err := resolver.languageName(languageMap["language_id"].(string))
+			if err != nil {
+				return nil, fmt.Errorf("%s: %v", languagePath, err)
+			}
+			childConditions, err := buildSdkSkillGroupChildConditions(languageMap, languagePath, resolver)
+			if err != nil {
+				return nil, err
+			}
+			comparator := languageMap["comparator"].(string)
+			proficiency := languageMap["proficiency"].(int)
+			languageConditions = append(languageConditions, platformclientv2.Skillgrouplanguagecondition{
+				LanguageSkill:   &name,
+				Comparator:      &comparator,
+				Proficiency:     &proficiency,
+				ChildConditions: childConditions,
+			})
+		}
+
+		if len(routingConditions) == 0 && len(languageConditions) == 0 {
+			return nil, fmt.Errorf("%s: at least one routing or language skill condition is required", conditionPath)
+		}
+		sdkConditions = append(sdkConditions, platformclientv2.Skillgroupcondition{
+			RoutingSkillConditions:  &routingConditions,
+			LanguageSkillConditions: &languageConditions,
+			Operation:               &operation,
+		})
+	}
+	return sdkConditions, nil
+}
+
+func buildSdkSkillGroupChildConditions(conditionMap map[string]interface{}, path string, resolver *skillGroupConditionResolver) (*[]platformclientv2.Skillgroupcondition, error) {
+	childConditions, ok := conditionMap["child_conditions"].([]interface{})
+	if !ok || len(childConditions) == 0 {
+		return nil, nil
+	}
+	sdkChildConditions, err := buildSdkSkillGroupConditions(childConditions, path+".child_conditions", resolver)
+	if err != nil {
+		return nil, err
+	}
+	return &sdkChildConditions, nil
+}
+
+// flattenSkillGroupConditionsJson converts the JSON skill conditions of the API to skill_condition blocks. Conditions
+// without any skill or language, which the API adds as empty children, are left out.
+func flattenSkillGroupConditionsJson(conditionsJson string, resolver *skillGroupConditionResolver) ([]interface{}, error) {
+	var sdkConditions []platformclientv2.Skillgroupcondition
+	if conditionsJson != "" && conditionsJson != "null" {
+		if err := json.Unmarshal([]byte(conditionsJson), &sdkConditions); err != nil {
+			return nil, err
+		}
+	}
+	return flattenSdkSkillGroupConditions(sdkConditions, skillGroupConditionMaxDepth, resolver)
+}
+
+func flattenSdkSkillGroupConditions(sdkConditions []platformclientv2.Skillgroupcondition, depth int, resolver *skillGroupConditionResolver) ([]interface{}, error) {
+	conditions := make([]interface{}, 0)
+	for _, sdkCondition := range sdkConditions {
+		routingConditions := make([]interface{}, 0)
+		if sdkCondition.RoutingSkillConditions != nil {
+			for _, routingCondition := range *sdkCondition.RoutingSkillConditions {
+				id, err := resolver.skillId(*routingCondition.RoutingSkill)
+				if err != nil {
+					return nil, err
+				}
+				routingMap := map[string]interface{}{
+					"skill_id":    id,
+					"comparator":  *routingCondition.Comparator,
+					"proficiency": *routingCondition.Proficiency,
+				}
+				if err := flattenSdkSkillGroupChildConditions(routingMap, routingCondition.ChildConditions, depth, resolver); err != nil {
+					return nil, err
+				}
+				routingConditions = append(routingConditions, routingMap)
+			}
+		}
+
+		languageConditions := make([]interface{}, 0)
+		if sdkCondition.LanguageSkillConditions != nil {
+			for _, languageCondition := range *sdkCondition.LanguageSkillConditions {
+				id, err := resolver.languageId(*languageCondition.LanguageSkill)
+				if err != nil {
+					return nil, err
+				}
+				languageMap := map[string]interface{}{
+					"language_id": id,
+					"comparator":  *languageCondition.Comparator,
+					"proficiency": *languageCondition.Proficiency,
+				}
+				if err := flattenSdkSkillGroupChildConditions(languageMap, languageCondition.ChildConditions, depth, resolver); err != nil {
+					return nil, err
+				}
+				languageConditions = append(languageConditions, languageMap)
+			}
+		}
+
+		if len(routingConditions) == 0 && len(languageConditions) == 0 {
+			continue
+		}
+		operation := "And"
+		if sdkCondition.Operation != nil {
+			operation = *sdkCondition.Operation
+		}
+		conditions = append(conditions, map[string]interface{}{
+			"operation":                 operation,
+			"routing_skill_conditions":  routingConditions,
+			"language_skill_conditions": languageConditions,
+		})
+	}
+	return conditions, nil
+}
+
+func flattenSdkSkillGroupChildConditions(conditionMap map[string]interface{}, sdkChildConditions *[]platformclientv2.Skillgroupcondition, depth int, resolver *skillGroupConditionResolver) error {
+	if depth <= 1 {
+		return nil
+	}
+	var childConditions []interface{}
+	var err error
+	if sdkChildConditions != nil {
+		childConditions, err = flattenSdkSkillGroupConditions(*sdkChildConditions, depth-1, resolver)
+		if err != nil {
+			return err
+		}
+	}
+	conditionMap["child_conditions"] = childConditions
+	return nil
+}
+
+// getSkillGroupMemberUserIds pages through the members of a skill group
+func getSkillGroupMemberUserIds(skillGroupId string, routingAPI *platformclientv2.RoutingApi) ([]string, error) {
+	memberUserIds := make([]string, 0)
+	after := ""
+	for {
+		members, _, err := routingAPI.GetRoutingSkillgroupMembers(skillGroupId, 100, after, "", "")
+		if err != nil {
+			return nil, err
+		}
+		if members.Entities != nil {
+			for _, member := range *members.Entities {
+				memberUserIds = append(memberUserIds, *member.Id)
+			}
+		}
+		if members.NextUri == nil || *members.NextUri == "" {
+			break
+		}
+		nextUri, err := url.Parse(*members.NextUri)
+		if err != nil {
+			return nil, fmt.Errorf("invalid next page URI %s: %v", *members.NextUri, err)
+		}
+		if after = nextUri.Query().Get("after"); after == "" {
+			break
+		}
+	}
+	return memberUserIds, nil
+}
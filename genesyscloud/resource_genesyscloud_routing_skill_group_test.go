@@ -522,6 +522,158 @@ resource "genesyscloud_routing_skill_group" "%s" {
 	})
 }
 
+func TestAccResourceRoutingSkillGroupTypedConditions(t *testing.T) {
+	t.Parallel()
+	var (
+		skillGroupResourceId = "testskillgroup4"
+		skillGroupName       = "testskillgroup4 " + uuid.NewString()
+		skillResourceId      = "routing_skill"
+		skillName            = "Skill " + uuid.NewString()
+		languageResourceId   = "routing_language"
+		languageName         = "Language " + uuid.NewString()
+		userResourceId       = "user_1"
+		userEmail            = "terraform-" + uuid.NewString() + "@example.com"
+	)
+
+	userResource := fmt.Sprintf(`
+resource "genesyscloud_user" "%s" {
+	name  = "tf.test.user %s"
+	email = "%s"
+	routing_skills {
+		skill_id    = genesyscloud_routing_skill.%s.id
+		proficiency = 4
+	}
+}
+`, userResourceId, uuid.NewString(), userEmail, skillResourceId)
+
+	skillGroupResource := func(comparator string) string {
+		return fmt.Sprintf(`
+resource "genesyscloud_routing_skill_group" "%s" {
+	name            = "%s"
+	preview_members = true
+	skill_condition {
+		operation = "Or"
+		routing_skill_conditions {
+			skill_id    = genesyscloud_routing_skill.%s.id
+			comparator  = "%s"
+			proficiency = 3
+		}
+		language_skill_conditions {
+			language_id = genesyscloud_routing_language.%s.id
+			comparator  = "EqualTo"
+			proficiency = 5
+		}
+	}
+	depends_on = [genesyscloud_user.%s]
+}
+`, skillGroupResourceId, skillGroupName, skillResourceId, comparator, languageResourceId, userResourceId)
+	}
+
+	baseConfig := generateRoutingSkillResource(skillResourceId, skillName) +
+		generateRoutingLanguageResource(languageResourceId, languageName) +
+		userResource
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				Config: baseConfig + skillGroupResource("GreaterThan"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_routing_skill_group."+skillGroupResourceId, "skill_condition.0.operation", "Or"),
+					resource.TestCheckResourceAttrPair("genesyscloud_routing_skill_group."+skillGroupResourceId, "skill_condition.0.routing_skill_conditions.0.skill_id", "genesyscloud_routing_skill."+skillResourceId, "id"),
+					resource.TestCheckResourceAttrPair("genesyscloud_routing_skill_group."+skillGroupResourceId, "skill_condition.0.language_skill_conditions.0.language_id", "genesyscloud_routing_language."+languageResourceId, "id"),
+					resource.TestCheckResourceAttrSet("genesyscloud_routing_skill_group."+skillGroupResourceId, "member_count"),
+				),
+			},
+			{
+				// Membership is evaluated in the background, so refresh before checking it
+				Config:    baseConfig + skillGroupResource("GreaterThan"),
+				PreConfig: func() { time.Sleep(10 * time.Second) },
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_routing_skill_group."+skillGroupResourceId, "member_count", "1"),
+					resource.TestCheckResourceAttrPair("genesyscloud_routing_skill_group."+skillGroupResourceId, "member_user_ids.0", "genesyscloud_user."+userResourceId, "id"),
+				),
+			},
+			{
+				Config: baseConfig + skillGroupResource("LessThan"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_routing_skill_group."+skillGroupResourceId, "skill_condition.0.routing_skill_conditions.0.comparator", "LessThan"),
+					resource.TestCheckResourceAttr("genesyscloud_routing_skill_group."+skillGroupResourceId, "skill_condition.0.routing_skill_conditions.0.proficiency", "3"),
+				),
+			},
+		},
+		CheckDestroy: testVerifySkillGroupDestroyed,
+	})
+}
+
+func TestSkillGroupConditionsRoundTrip(t *testing.T) {
+	resolver := newSkillGroupConditionResolver(nil)
+	resolver.skillNames["skill-1"] = "Sales"
+	resolver.skillIds["Sales"] = "skill-1"
+	resolver.languageNames["language-1"] = "Spanish"
+	resolver.languageIds["Spanish"] = "language-1"
+
+	conditions := []interface{}{
+		map[string]interface{}{
+			"operation": "And",
+			"routing_skill_conditions": []interface{}{
+				map[string]interface{}{
+					"skill_id":    "skill-1",
+					"comparator":  "GreaterThanOrEqualTo",
+					"proficiency": 3,
+					"child_conditions": []interface{}{
+						map[string]interface{}{
+							"operation":                "Or",
+							"routing_skill_conditions": []interface{}{},
+							"language_skill_conditions": []interface{}{
+								map[string]interface{}{
+									"language_id":      "language-1",
+									"comparator":       "EqualTo",
+									"proficiency":      5,
+									"child_conditions": []interface{}{},
+								},
+							},
+						},
+					},
+				},
+			},
+			"language_skill_conditions": []interface{}{},
+		},
+	}
+
+	conditionsJson, err := buildSkillGroupConditionsJson(conditions, resolver)
+	if err != nil {
+		t.Fatalf("failed to build skill conditions: %v", err)
+	}
+	if !strings.Contains(conditionsJson, `"routingSkill":"Sales"`) || !strings.Contains(conditionsJson, `"languageSkill":"Spanish"`) {
+		t.Errorf("expected skills and languages to be referenced by name, got %s", conditionsJson)
+	}
+
+	// The API adds empty child conditions, which are not part of the typed form
+	conditionsJson = strings.Replace(conditionsJson, `"proficiency":5}`, `"proficiency":5,"childConditions":[{"routingSkillConditions":[],"languageSkillConditions":[],"operation":"And"}]}`, 1)
+	flattened, err := flattenSkillGroupConditionsJson(conditionsJson, resolver)
+	if err != nil {
+		t.Fatalf("failed to flatten skill conditions: %v", err)
+	}
+	child := flattened[0].(map[string]interface{})["routing_skill_conditions"].([]interface{})[0].(map[string]interface{})["child_conditions"].([]interface{})[0].(map[string]interface{})
+	language := child["language_skill_conditions"].([]interface{})[0].(map[string]interface{})
+	if language["language_id"] != "language-1" || language["proficiency"] != 5 || child["operation"] != "Or" {
+		t.Errorf("unexpected child condition %v", child)
+	}
+	if grandchildren := language["child_conditions"].([]interface{}); len(grandchildren) != 0 {
+		t.Errorf("expected empty child conditions to be dropped, got %v", grandchildren)
+	}
+
+	if _, err := buildSkillGroupConditionsJson([]interface{}{map[string]interface{}{
+		"operation":                 "And",
+		"routing_skill_conditions":  []interface{}{},
+		"language_skill_conditions": []interface{}{},
+	}}, resolver); err == nil || !strings.Contains(err.Error(), "skill_condition.0") {
+		t.Errorf("expected an error for a condition without skills, got %v", err)
+	}
+}
+
 func generateRoutingSkillGroupResource(
 	resourceID string,
 	divisionResourceName string,
@@ -2,6 +2,7 @@ package genesyscloud
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"sort"
@@ -31,6 +32,57 @@ var (
 		"message":  "message",
 	}
 
+	mediaUtilizationResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"media_type": {
+				Description:  fmt.Sprintf("Media type the settings apply to (%s).", strings.Join(getSdkUtilizationTypes(), " | ")),
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(getSdkUtilizationTypes(), false),
+			},
+			"maximum_capacity": {
+				Description:  "Maximum capacity of conversations of this media type. Value must be between 0 and 25.",
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 25),
+			},
+			"interruptible_media_types": {
+				Description: fmt.Sprintf("Set of other media types that can interrupt this media type (%s).", strings.Join(getSdkUtilizationTypes(), " | ")),
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"include_non_acd": {
+				Description: "Block this media type when on a non-ACD conversation.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+		},
+	}
+
+	labelUtilizationResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"label_id": {
+				Description: "ID of the utilization label.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"maximum_capacity": {
+				Description:  "Maximum number of conversations with this label that an agent can handle at one time. Value must be between 0 and 25.",
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 25),
+			},
+			"interruptible_label_ids": {
+				Description: "IDs of the other labels that can interrupt a conversation with this label.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+
 	utilizationSettingsResource = &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"maximum_capacity": {
@@ -55,6 +107,13 @@ var (
 	}
 )
 
+// utilizationSettings is the body of the org and user utilization APIs. The SDK models do not have label utilizations yet.
+type utilizationSettings struct {
+	Utilization       map[string]platformclientv2.Mediautilization `json:"utilization,omitempty"`
+	LabelUtilizations map[string]platformclientv2.Labelutilization `json:"labelUtilizations,omitempty"`
+	Level             string                                       `json:"level,omitempty"`
+}
+
 func getSdkUtilizationTypes() []string {
 	types := make([]string, 0, len(utilizationMediaTypes))
 	for t := range utilizationMediaTypes {
@@ -75,7 +134,9 @@ func RoutingUtilizationExporter() *resourceExporter.ResourceExporter {
 	return &resourceExporter.ResourceExporter{
 		GetResourcesFunc: GetAllWithPooledClient(getAllRoutingUtilization),
 		RefAttrs:         map[string]*resourceExporter.RefAttrSettings{}, // No references
-		AllowZeroValues:  []string{"maximum_capacity"},
+		AllowZeroValues:  []string{"maximum_capacity", "media_utilization.maximum_capacity", "label_utilizations.maximum_capacity"},
+		// The media type blocks are deprecated in favour of media_utilization
+		ExcludedAttributes: []string{"call", "callback", "chat", "email", "message"},
 	}
 }
 
@@ -95,49 +156,45 @@ func ResourceRoutingUtilization() *schema.Resource {
 			Update: schema.DefaultTimeout(8 * time.Minute),
 			Read:   schema.DefaultTimeout(8 * time.Minute),
 		},
-		Schema: map[string]*schema.Schema{
-			"call": {
-				Description: "Call media settings. If not set, this reverts to the default media type settings.",
-				Type:        schema.TypeList,
-				MaxItems:    1,
-				Optional:    true,
-				Computed:    true,
-				Elem:        utilizationSettingsResource,
-			},
-			"callback": {
-				Description: "Callback media settings. If not set, this reverts to the default media type settings.",
-				Type:        schema.TypeList,
-				MaxItems:    1,
-				Optional:    true,
-				Computed:    true,
-				Elem:        utilizationSettingsResource,
-			},
-			"message": {
-				Description: "Message media settings. If not set, this reverts to the default media type settings.",
-				Type:        schema.TypeList,
-				MaxItems:    1,
-				Optional:    true,
-				Computed:    true,
-				Elem:        utilizationSettingsResource,
-			},
-			"email": {
-				Description: "Email media settings. If not set, this reverts to the default media type settings.",
-				Type:        schema.TypeList,
-				MaxItems:    1,
-				Optional:    true,
-				Computed:    true,
-				Elem:        utilizationSettingsResource,
-			},
-			"chat": {
-				Description: "Chat media settings. If not set, this reverts to the default media type settings.",
-				Type:        schema.TypeList,
-				MaxItems:    1,
-				Optional:    true,
-				Computed:    true,
-				Elem:        utilizationSettingsResource,
-			},
+		Schema: utilizationSettingsSchema(false),
+	}
+}
+
+// utilizationSettingsSchema returns the attributes shared by the org-wide utilization and the utilization of a user.
+// nested is set when the attributes are part of a block.
+func utilizationSettingsSchema(nested bool) map[string]*schema.Schema {
+	settingsSchema := map[string]*schema.Schema{
+		"media_utilization": {
+			Description: "Settings per media type. Media types that have never been listed keep their current settings and media types removed from the list revert to the default settings.",
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Computed:    true,
+			Elem:        mediaUtilizationResource,
+		},
+		"label_utilizations": {
+			Description: "Settings per utilization label. If not set, the current label settings are kept.",
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Computed:    true,
+			Elem:        labelUtilizationResource,
 		},
 	}
+	for _, mediaType := range getSdkUtilizationTypes() {
+		mediaTypeSchema := &schema.Schema{
+			Description: fmt.Sprintf("%s%s media settings. If not set, this reverts to the default media type settings.", strings.ToUpper(mediaType[:1]), mediaType[1:]),
+			Type:        schema.TypeList,
+			MaxItems:    1,
+			Optional:    true,
+			Computed:    true,
+			Elem:        utilizationSettingsResource,
+			Deprecated:  "Use media_utilization instead.",
+		}
+		if nested {
+			mediaTypeSchema.ConfigMode = schema.SchemaConfigModeAttr
+		}
+		settingsSchema[utilizationMediaTypes[mediaType]] = mediaTypeSchema
+	}
+	return settingsSchema
 }
 
 func createRoutingUtilization(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -152,7 +209,7 @@ func readRoutingUtilization(ctx context.Context, d *schema.ResourceData, meta in
 
 	log.Printf("Reading Routing Utilization")
 	return WithRetriesForRead(ctx, d, func() *retry.RetryError {
-		settings, resp, getErr := routingAPI.GetRoutingUtilization()
+		settings, resp, getErr := getUtilizationSettings(routingAPI, "/api/v2/routing/utilization")
 		if getErr != nil {
 			if IsStatus404(resp) {
 				return retry.RetryableError(fmt.Errorf("Failed to read Routing Utilization: %s", getErr))
@@ -160,15 +217,9 @@ func readRoutingUtilization(ctx context.Context, d *schema.ResourceData, meta in
 			return retry.NonRetryableError(fmt.Errorf("Failed to read Routing Utilization: %s", getErr))
 		}
 
-		cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, ResourceRoutingSkill())
-		if settings.Utilization != nil {
-			for sdkType, schemaType := range utilizationMediaTypes {
-				if mediaSettings, ok := (*settings.Utilization)[sdkType]; ok {
-					d.Set(schemaType, flattenUtilizationSetting(mediaSettings))
-				} else {
-					d.Set(schemaType, nil)
-				}
-			}
+		cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, ResourceRoutingUtilization())
+		for key, value := range flattenUtilizationSettings(settings, d.Get("media_utilization").(*schema.Set)) {
+			d.Set(key, value)
 		}
 
 		log.Printf("Read Routing Utilization")
@@ -182,10 +233,11 @@ func updateRoutingUtilization(ctx context.Context, d *schema.ResourceData, meta
 
 	log.Printf("Updating Routing Utilization")
 
-	_, _, err := routingAPI.PutRoutingUtilization(platformclientv2.Utilization{
-		Utilization: buildSdkRoutingUtilizations(d),
-	})
+	settings, err := buildUtilizationSettings(d, "")
 	if err != nil {
+		return diag.Errorf("Invalid Routing Utilization: %s", err)
+	}
+	if err := putUtilizationSettings(routingAPI, "/api/v2/routing/utilization", settings); err != nil {
 		return diag.Errorf("Failed to update Routing Utilization: %s", err)
 	}
 
@@ -207,6 +259,121 @@ func deleteRoutingUtilization(_ context.Context, _ *schema.ResourceData, meta in
 	return nil
 }
 
+// getUtilizationSettings reads the org-wide or a user's utilization, including the label utilizations
+func getUtilizationSettings(routingAPI *platformclientv2.RoutingApi, route string) (*utilizationSettings, *platformclientv2.APIResponse, error) {
+	apiClient := &routingAPI.Configuration.APIClient
+	path := routingAPI.Configuration.BasePath + route
+	response, err := apiClient.CallAPI(path, "GET", nil, buildHeaderParams(routingAPI), nil, nil, "", nil)
+	if err != nil {
+		return nil, response, err
+	}
+
+	settings := &utilizationSettings{}
+	if err := json.Unmarshal(response.RawBody, settings); err != nil {
+		return nil, response, fmt.Errorf("failed to unmarshal utilization: %v", err)
+	}
+	return settings, response, nil
+}
+
+func putUtilizationSettings(routingAPI *platformclientv2.RoutingApi, route string, settings *utilizationSettings) error {
+	apiClient := &routingAPI.Configuration.APIClient
+	path := routingAPI.Configuration.BasePath + route
+	_, err := apiClient.CallAPI(path, "PUT", settings, buildHeaderParams(routingAPI), nil, nil, "", nil)
+	return err
+}
+
+// buildUtilizationSettings builds the utilization settings from the attributes under prefix. The deprecated media type
+// blocks hold the current settings of every media type, which media_utilization overrides when it changes.
+func buildUtilizationSettings(d *schema.ResourceData, prefix string) (*utilizationSettings, error) {
+	settings := &utilizationSettings{
+		Utilization:       make(map[string]platformclientv2.Mediautilization),
+		LabelUtilizations: make(map[string]platformclientv2.Labelutilization),
+	}
+
+	for sdkType, schemaType := range utilizationMediaTypes {
+		if mediaSettings, ok := d.Get(prefix + schemaType).([]interface{}); ok && len(mediaSettings) > 0 {
+			settings.Utilization[sdkType] = buildSdkMediaUtilization(mediaSettings)
+		}
+	}
+	if d.HasChange(prefix + "media_utilization") {
+		oldMediaUtilizations, newMediaUtilizations := d.GetChange(prefix + "media_utilization")
+		// Media types removed from the list revert to the defaults
+		for _, mediaUtilization := range oldMediaUtilizations.(*schema.Set).List() {
+			delete(settings.Utilization, mediaUtilization.(map[string]interface{})["media_type"].(string))
+		}
+		listed := make(map[string]bool)
+		for _, mediaUtilization := range newMediaUtilizations.(*schema.Set).List() {
+			mediaMap := mediaUtilization.(map[string]interface{})
+			mediaType := mediaMap["media_type"].(string)
+			if listed[mediaType] {
+				return nil, fmt.Errorf("media type %s is listed more than once in media_utilization", mediaType)
+			}
+			listed[mediaType] = true
+			settings.Utilization[mediaType] = buildSdkMediaUtilization([]interface{}{mediaMap})
+		}
+	}
+
+	for _, labelUtilization := range d.Get(prefix + "label_utilizations").(*schema.Set).List() {
+		labelMap := labelUtilization.(map[string]interface{})
+		labelId := labelMap["label_id"].(string)
+		if _, ok := settings.LabelUtilizations[labelId]; ok {
+			return nil, fmt.Errorf("label %s is listed more than once in label_utilizations", labelId)
+		}
+		maxCapacity := labelMap["maximum_capacity"].(int)
+		interruptingLabelIds := &[]string{}
+		if labelIds, ok := labelMap["interruptible_label_ids"].(*schema.Set); ok {
+			interruptingLabelIds = lists.SetToStringList(labelIds)
+		}
+		settings.LabelUtilizations[labelId] = platformclientv2.Labelutilization{
+			MaximumCapacity:      &maxCapacity,
+			InterruptingLabelIds: interruptingLabelIds,
+		}
+	}
+	return settings, nil
+}
+
+// flattenUtilizationSettings returns the values of the utilization attributes. media_utilization only lists the media
+// types in currentMediaUtilization, or all of them when it is empty.
+func flattenUtilizationSettings(settings *utilizationSettings, currentMediaUtilization *schema.Set) map[string]interface{} {
+	managedMediaTypes := make([]string, 0)
+	for _, mediaUtilization := range currentMediaUtilization.List() {
+		managedMediaTypes = append(managedMediaTypes, mediaUtilization.(map[string]interface{})["media_type"].(string))
+	}
+
+	values := make(map[string]interface{})
+	mediaUtilizations := schema.NewSet(schema.HashResource(mediaUtilizationResource), []interface{}{})
+	for sdkType, schemaType := range utilizationMediaTypes {
+		mediaSettings, ok := settings.Utilization[sdkType]
+		if !ok {
+			values[schemaType] = nil
+			continue
+		}
+		values[schemaType] = flattenUtilizationSetting(mediaSettings)
+		if len(managedMediaTypes) == 0 || lists.ItemInSlice(sdkType, managedMediaTypes) {
+			mediaMap := flattenUtilizationSetting(mediaSettings)[0].(map[string]interface{})
+			mediaMap["media_type"] = sdkType
+			mediaUtilizations.Add(mediaMap)
+		}
+	}
+	values["media_utilization"] = mediaUtilizations
+
+	labelUtilizations := schema.NewSet(schema.HashResource(labelUtilizationResource), []interface{}{})
+	for labelId, labelSettings := range settings.LabelUtilizations {
+		labelMap := map[string]interface{}{
+			"label_id": labelId,
+		}
+		if labelSettings.MaximumCapacity != nil {
+			labelMap["maximum_capacity"] = *labelSettings.MaximumCapacity
+		}
+		if labelSettings.InterruptingLabelIds != nil {
+			labelMap["interruptible_label_ids"] = lists.StringListToSet(*labelSettings.InterruptingLabelIds)
+		}
+		labelUtilizations.Add(labelMap)
+	}
+	values["label_utilizations"] = labelUtilizations
+	return values
+}
+
 func flattenUtilizationSetting(settings platformclientv2.Mediautilization) []interface{} {
 	settingsMap := make(map[string]interface{})
 	if settings.MaximumCapacity != nil {
@@ -221,19 +388,6 @@ func flattenUtilizationSetting(settings platformclientv2.Mediautilization) []int
 	return []interface{}{settingsMap}
 }
 
-func buildSdkRoutingUtilizations(d *schema.ResourceData) *map[string]platformclientv2.Mediautilization {
-	settings := make(map[string]platformclientv2.Mediautilization)
-
-	for sdkType, schemaType := range utilizationMediaTypes {
-		mediaSettings := d.Get(schemaType).([]interface{})
-		if mediaSettings != nil && len(mediaSettings) > 0 {
-			settings[sdkType] = buildSdkMediaUtilization(mediaSettings)
-		}
-	}
-
-	return &settings
-}
-
 func buildSdkMediaUtilization(settings []interface{}) platformclientv2.Mediautilization {
 	settingsMap := settings[0].(map[string]interface{})
 
@@ -7,6 +7,8 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v109/platformclientv2"
 )
 
 func TestAccResourceRoutingUtilization(t *testing.T) {
@@ -105,3 +107,150 @@ func generateRoutingUtilizationResource(mediaTypes ...string) string {
 	}
 	`, strings.Join(mediaTypes, "\n"))
 }
+
+func TestAccResourceRoutingUtilizationMediaUtilization(t *testing.T) {
+	t.Parallel()
+	var (
+		maxCapacity1 = "2"
+		maxCapacity2 = "5"
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// Create
+				Config: generateRoutingUtilizationResource(
+					generateRoutingMediaUtilization("call", maxCapacity1, falseValue),
+					generateRoutingMediaUtilization("email", maxCapacity1, trueValue, strconv.Quote("call")),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_routing_utilization.routing-util", "media_utilization.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("genesyscloud_routing_utilization.routing-util", "media_utilization.*", map[string]string{
+						"media_type":       "call",
+						"maximum_capacity": maxCapacity1,
+						"include_non_acd":  falseValue,
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("genesyscloud_routing_utilization.routing-util", "media_utilization.*", map[string]string{
+						"media_type":                  "email",
+						"maximum_capacity":            maxCapacity1,
+						"include_non_acd":             trueValue,
+						"interruptible_media_types.#": "1",
+					}),
+					resource.TestCheckResourceAttr("genesyscloud_routing_utilization.routing-util", "call.0.maximum_capacity", maxCapacity1),
+				),
+			},
+			{
+				// Update the listed media types
+				Config: generateRoutingUtilizationResource(
+					generateRoutingMediaUtilization("call", maxCapacity2, trueValue, strconv.Quote("email")),
+					generateRoutingMediaUtilization("message", maxCapacity2, falseValue),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_routing_utilization.routing-util", "media_utilization.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("genesyscloud_routing_utilization.routing-util", "media_utilization.*", map[string]string{
+						"media_type":       "call",
+						"maximum_capacity": maxCapacity2,
+						"include_non_acd":  trueValue,
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("genesyscloud_routing_utilization.routing-util", "media_utilization.*", map[string]string{
+						"media_type":       "message",
+						"maximum_capacity": maxCapacity2,
+					}),
+					resource.TestCheckResourceAttr("genesyscloud_routing_utilization.routing-util", "message.0.maximum_capacity", maxCapacity2),
+				),
+			},
+		},
+	})
+}
+
+func TestRoutingUtilizationSettingsBuildAndFlatten(t *testing.T) {
+	resourceSchema := ResourceRoutingUtilization().Schema
+	d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{
+		"media_utilization": []interface{}{
+			map[string]interface{}{
+				"media_type":                "call",
+				"maximum_capacity":          1,
+				"interruptible_media_types": []interface{}{"email"},
+			},
+			map[string]interface{}{
+				"media_type":       "chat",
+				"maximum_capacity": 0,
+				"include_non_acd":  true,
+			},
+		},
+		"label_utilizations": []interface{}{
+			map[string]interface{}{
+				"label_id":                "label-urgent",
+				"maximum_capacity":        2,
+				"interruptible_label_ids": []interface{}{"label-standard"},
+			},
+		},
+	})
+
+	settings, err := buildUtilizationSettings(d, "")
+	if err != nil {
+		t.Fatalf("failed to build the utilization settings: %v", err)
+	}
+	if len(settings.Utilization) != 2 {
+		t.Fatalf("expected 2 media types, got %d", len(settings.Utilization))
+	}
+	if chat := settings.Utilization["chat"]; *chat.MaximumCapacity != 0 || !*chat.IncludeNonAcd {
+		t.Errorf("unexpected chat settings %+v", chat)
+	}
+	if call := settings.Utilization["call"]; len(*call.InterruptableMediaTypes) != 1 || (*call.InterruptableMediaTypes)[0] != "email" {
+		t.Errorf("unexpected call settings %+v", call)
+	}
+	label, ok := settings.LabelUtilizations["label-urgent"]
+	if !ok || *label.MaximumCapacity != 2 || (*label.InterruptingLabelIds)[0] != "label-standard" {
+		t.Errorf("unexpected label settings %+v", label)
+	}
+
+	// Only the listed media types are flattened into media_utilization, but the media type blocks hold them all
+	emailCapacity := 3
+	settings.Utilization["email"] = platformclientv2.Mediautilization{MaximumCapacity: &emailCapacity}
+	values := flattenUtilizationSettings(settings, d.Get("media_utilization").(*schema.Set))
+	if mediaUtilizations := values["media_utilization"].(*schema.Set); mediaUtilizations.Len() != 2 {
+		t.Errorf("expected 2 managed media types, got %d", mediaUtilizations.Len())
+	}
+	if email := values["email"].([]interface{}); len(email) != 1 || email[0].(map[string]interface{})["maximum_capacity"] != emailCapacity {
+		t.Errorf("expected the email block to be set, got %v", email)
+	}
+	if values["message"] != nil {
+		t.Errorf("expected no message block, got %v", values["message"])
+	}
+	if labels := values["label_utilizations"].(*schema.Set); labels.Len() != 1 {
+		t.Errorf("expected 1 label, got %d", labels.Len())
+	}
+
+	// Without a media_utilization list every media type is flattened
+	values = flattenUtilizationSettings(settings, schema.NewSet(schema.HashResource(mediaUtilizationResource), nil))
+	if mediaUtilizations := values["media_utilization"].(*schema.Set); mediaUtilizations.Len() != 3 {
+		t.Errorf("expected 3 media types, got %d", mediaUtilizations.Len())
+	}
+
+	d = schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{
+		"label_utilizations": []interface{}{
+			map[string]interface{}{"label_id": "label-urgent", "maximum_capacity": 1},
+			map[string]interface{}{"label_id": "label-urgent", "maximum_capacity": 2},
+		},
+	})
+	if _, err := buildUtilizationSettings(d, ""); err == nil {
+		t.Errorf("expected an error for a label listed twice")
+	}
+}
+
+func generateRoutingMediaUtilization(
+	mediaType string,
+	maxCapacity string,
+	includeNonAcd string,
+	interruptTypes ...string) string {
+	return fmt.Sprintf(`media_utilization {
+		media_type = "%s"
+		maximum_capacity = %s
+		include_non_acd = %s
+		interruptible_media_types = [%s]
+	}
+	`, mediaType, maxCapacity, includeNonAcd, strings.Join(interruptTypes, ","))
+}
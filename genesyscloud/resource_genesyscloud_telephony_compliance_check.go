@@ -0,0 +1,262 @@
+package genesyscloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v109/platformclientv2"
+)
+
+func ResourceTelephonyComplianceCheck() *schema.Resource {
+	return &schema.Resource{
+		Description: `Genesys Cloud Telephony Compliance Check. Verifies E911 coverage and fails apply with a report of every gap.
+
+The location of each site must have an emergency number that is a valid E.164 number and an address with a street, city, zipcode and country. Every IVR of a site must be in an enabled emergency group with an emergency flow.
+Genesys Cloud does not link IVRs to sites, so the IVRs of each site are set with ` + "`site_ivrs`" + `. Without ` + "`site_ids` or `site_ivrs`" + ` the locations of all sites are checked.
+The check runs on every apply while gaps remain and whenever the checked sites change. Refreshes record the current gaps in ` + "`issues`" + ` without failing. Deleting the resource changes nothing in Genesys Cloud.`,
+
+		CreateContext: CreateWithPooledClient(createTelephonyComplianceCheck),
+		ReadContext:   ReadWithPooledClient(readTelephonyComplianceCheck),
+		UpdateContext: UpdateWithPooledClient(updateTelephonyComplianceCheck),
+		DeleteContext: DeleteWithPooledClient(deleteTelephonyComplianceCheck),
+		CustomizeDiff: customizeTelephonyComplianceCheckDiff,
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"site_ids": {
+				Description: "IDs of the sites whose locations are checked.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"site_ivrs": {
+				Description: "IVRs of a site that must be covered by an emergency group. The location of the site is also checked.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"site_id": {
+							Description: "ID of the site.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"ivr_ids": {
+							Description: "IDs of the IVRs that route calls of the site.",
+							Type:        schema.TypeSet,
+							Required:    true,
+							MinItems:    1,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"require_verified_address": {
+				Description: "Whether the addresses of locations must be verified.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"issues": {
+				Description: "E911 coverage gaps found by the last check.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// customizeTelephonyComplianceCheckDiff plans an update while gaps remain so the next apply checks again
+func customizeTelephonyComplianceCheckDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Id() == "" || len(diff.Get("issues").([]interface{})) == 0 {
+		return nil
+	}
+	return diff.SetNewComputed("issues")
+}
+
+func createTelephonyComplianceCheck(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(uuid.NewString())
+
+	log.Printf("Creating telephony compliance check %s", d.Id())
+	return runTelephonyComplianceCheck(ctx, d, meta)
+}
+
+func readTelephonyComplianceCheck(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("Reading telephony compliance check %s", d.Id())
+	return WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		issues, diagErr := getTelephonyComplianceIssues(ctx, d, meta)
+		if diagErr != nil {
+			return retry.NonRetryableError(diagErrorsToError(diagErr))
+		}
+		d.Set("issues", issues)
+
+		log.Printf("Read telephony compliance check %s with %d issues", d.Id(), len(issues))
+		return nil
+	})
+}
+
+func updateTelephonyComplianceCheck(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("Updating telephony compliance check %s", d.Id())
+	return runTelephonyComplianceCheck(ctx, d, meta)
+}
+
+func deleteTelephonyComplianceCheck(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// Nothing is created in Genesys Cloud
+	log.Printf("Deleting telephony compliance check %s", d.Id())
+	return nil
+}
+
+// runTelephonyComplianceCheck records the gaps found by the check and fails with a report when there are any
+func runTelephonyComplianceCheck(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	issues, diagErr := getTelephonyComplianceIssues(ctx, d, meta)
+	if diagErr != nil {
+		return diagErr
+	}
+	d.Set("issues", issues)
+
+	if len(issues) > 0 {
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("E911 compliance check found %d issues", len(issues)),
+			Detail:   "- " + strings.Join(issues, "\n- "),
+		}}
+	}
+	log.Printf("Telephony compliance check %s found no issues", d.Id())
+	return nil
+}
+
+func getTelephonyComplianceIssues(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]string, diag.Diagnostics) {
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+
+	siteIvrs := make(map[string][]string)
+	siteIds := make(map[string]bool)
+	for _, siteId := range d.Get("site_ids").(*schema.Set).List() {
+		siteIds[siteId.(string)] = true
+	}
+	for _, siteIvrsMap := range d.Get("site_ivrs").(*schema.Set).List() {
+		siteIvrsMap := siteIvrsMap.(map[string]interface{})
+		siteId := siteIvrsMap["site_id"].(string)
+		siteIds[siteId] = true
+		for _, ivrId := range siteIvrsMap["ivr_ids"].(*schema.Set).List() {
+			siteIvrs[siteId] = append(siteIvrs[siteId], ivrId.(string))
+		}
+	}
+	for siteId := range siteIvrs {
+		sort.Strings(siteIvrs[siteId])
+	}
+
+	objects, missingSiteIds, diagErr := getE911Objects(ctx, sdkConfig, sortedKeys(siteIds), siteIvrs)
+	if diagErr != nil {
+		return nil, diagErr
+	}
+
+	var issues []string
+	for _, siteId := range missingSiteIds {
+		issues = append(issues, fmt.Sprintf("Site %s not found", siteId))
+	}
+	return append(issues, checkE911Compliance(objects, d.Get("require_verified_address").(bool))...), nil
+}
+
+// getE911Objects reads the sites, their locations and IVRs, and every emergency group. All sites are read when no
+// site IDs are given. The IDs of sites that were not found are returned separately.
+func getE911Objects(_ context.Context, sdkConfig *platformclientv2.Configuration, siteIds []string, siteIvrs map[string][]string) (e911Objects, []string, diag.Diagnostics) {
+	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
+	locationsAPI := platformclientv2.NewLocationsApiWithConfig(sdkConfig)
+	architectAPI := platformclientv2.NewArchitectApiWithConfig(sdkConfig)
+
+	objects := e911Objects{
+		locations: make(map[string]*platformclientv2.Locationdefinition),
+		siteIvrs:  siteIvrs,
+		ivrs:      make(map[string]*platformclientv2.Ivr),
+	}
+
+	var missingSiteIds []string
+	if len(siteIds) == 0 {
+		for _, managed := range []bool{false, true} {
+			for pageNum := 1; ; pageNum++ {
+				const pageSize = 100
+				sites, _, getErr := edgesAPI.GetTelephonyProvidersEdgesSites(pageSize, pageNum, "", "", "", "", managed)
+				if getErr != nil {
+					return objects, nil, diag.Errorf("Failed to get page of sites: %v", getErr)
+				}
+				if sites.Entities == nil || len(*sites.Entities) == 0 {
+					break
+				}
+				for _, site := range *sites.Entities {
+					if site.State != nil && *site.State != "deleted" {
+						objects.sites = append(objects.sites, site)
+					}
+				}
+			}
+		}
+	} else {
+		for _, siteId := range siteIds {
+			site, resp, getErr := edgesAPI.GetTelephonyProvidersEdgesSite(siteId)
+			if getErr != nil {
+				if IsStatus404(resp) {
+					missingSiteIds = append(missingSiteIds, siteId)
+					continue
+				}
+				return objects, nil, diag.Errorf("Failed to read site %s: %v", siteId, getErr)
+			}
+			if site.State != nil && *site.State == "deleted" {
+				missingSiteIds = append(missingSiteIds, siteId)
+				continue
+			}
+			objects.sites = append(objects.sites, *site)
+		}
+	}
+
+	for _, site := range objects.sites {
+		if site.Location == nil || site.Location.Id == nil {
+			continue
+		}
+		locationId := *site.Location.Id
+		if _, ok := objects.locations[locationId]; ok {
+			continue
+		}
+		location, resp, getErr := locationsAPI.GetLocation(locationId, nil)
+		if getErr != nil && !IsStatus404(resp) {
+			return objects, nil, diag.Errorf("Failed to read location %s: %v", locationId, getErr)
+		}
+		objects.locations[locationId] = location
+	}
+
+	for _, ivrIds := range siteIvrs {
+		for _, ivrId := range ivrIds {
+			if _, ok := objects.ivrs[ivrId]; ok {
+				continue
+			}
+			ivr, resp, getErr := architectAPI.GetArchitectIvr(ivrId)
+			if getErr != nil && !IsStatus404(resp) {
+				return objects, nil, diag.Errorf("Failed to read IVR %s: %v", ivrId, getErr)
+			}
+			if ivr != nil && ivr.State != nil && *ivr.State == "deleted" {
+				ivr = nil
+			}
+			objects.ivrs[ivrId] = ivr
+		}
+	}
+
+	if len(siteIvrs) > 0 {
+		for pageNum := 1; ; pageNum++ {
+			const pageSize = 100
+			emergencyGroups, _, getErr := architectAPI.GetArchitectEmergencygroups(pageNum, pageSize, "", "", "")
+			if getErr != nil {
+				return objects, nil, diag.Errorf("Failed to get page of emergency groups: %v", getErr)
+			}
+			if emergencyGroups.Entities == nil || len(*emergencyGroups.Entities) == 0 {
+				break
+			}
+			objects.emergencyGroups = append(objects.emergencyGroups, *emergencyGroups.Entities...)
+		}
+	}
+
+	return objects, missingSiteIds, nil
+}
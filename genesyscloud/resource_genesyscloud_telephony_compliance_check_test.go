@@ -0,0 +1,107 @@
+package genesyscloud
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceTelephonyComplianceCheck(t *testing.T) {
+	t.Parallel()
+	var (
+		siteRes         = "site"
+		locationRes     = "test-location1"
+		ivrRes          = "ivr"
+		checkRes        = "e911"
+		emergencyNumber = "+13173124743"
+	)
+
+	_, err := AuthorizeSdk()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = DeleteLocationWithNumber(emergencyNumber); err != nil {
+		t.Fatal(err)
+	}
+
+	location := GenerateLocationResource(
+		locationRes,
+		"Terraform location"+uuid.NewString(),
+		"HQ1",
+		[]string{},
+		GenerateLocationEmergencyNum(
+			emergencyNumber,
+			nullValue, // Default number type
+		), GenerateLocationAddress(
+			"7601 Interactive Way",
+			"Indianapolis",
+			"IN",
+			"US",
+			"46278",
+		))
+
+	site := GenerateSiteResourceWithCustomAttrs(
+		siteRes,
+		"site "+uuid.NewString(),
+		"test site description",
+		"genesyscloud_location."+locationRes+".id",
+		"Cloud",
+		false,
+		"[\"us-west-2\"]",
+		strconv.Quote("+19205551212"),
+		strconv.Quote("Wilco plumbing"),
+	)
+
+	ivr := generateIvrConfigResource(&ivrConfigStruct{
+		resourceID:  ivrRes,
+		name:        "IVR " + uuid.NewString(),
+		description: "IVR without an emergency group",
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// The IVR of the site is not in an emergency group
+				Config: location + site + ivr + generateTelephonyComplianceCheckResource(
+					checkRes,
+					nil,
+					generateTelephonyComplianceSiteIvrs("genesyscloud_telephony_providers_edges_site."+siteRes+".id", "genesyscloud_architect_ivr."+ivrRes+".id"),
+				),
+				ExpectError: regexp.MustCompile(`(?s)E911 compliance check found 1 issues.*is not in an emergency group`),
+			},
+			{
+				// The location of the site has a valid emergency number and address
+				Config: location + site + ivr + generateTelephonyComplianceCheckResource(
+					checkRes,
+					[]string{"genesyscloud_telephony_providers_edges_site." + siteRes + ".id"},
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_telephony_compliance_check."+checkRes, "issues.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func generateTelephonyComplianceCheckResource(resourceID string, siteIds []string, otherAttrs ...string) string {
+	return fmt.Sprintf(`resource "genesyscloud_telephony_compliance_check" "%s" {
+		site_ids = [%s]
+		%s
+	}
+	`, resourceID, strings.Join(siteIds, ", "), strings.Join(otherAttrs, "\n"))
+}
+
+func generateTelephonyComplianceSiteIvrs(siteId string, ivrIds ...string) string {
+	return fmt.Sprintf(`site_ivrs {
+		site_id = %s
+		ivr_ids = [%s]
+	}
+	`, siteId, strings.Join(ivrIds, ", "))
+}
@@ -0,0 +1,675 @@
+package genesyscloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+	lists "terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mypurecloud/platform-client-sdk-go/v109/platformclientv2"
+)
+
+const (
+	didOwnerTypeUser  = "USER"
+	didOwnerTypeGroup = "GROUP"
+	didOwnerTypeIvr   = "IVR_CONFIG"
+	didOwnerTypePhone = "PHONE"
+)
+
+var (
+	// Phone address types a DID can be assigned to, in the order they are used
+	didUserAddressTypes  = []string{"WORK", "WORK2", "WORK3", "WORK4"}
+	didGroupAddressTypes = []string{"GROUPRING", "GROUPPHONE"}
+
+	// Assignments of the same number are serialized so two resources can't claim it at once
+	didAssignmentLocks sync.Map
+)
+
+func ResourceTelephonyDidAssignment() *schema.Resource {
+	return &schema.Resource{
+		Description: `Genesys Cloud DID Assignment. Assigns a DID to exactly one owner: a user, a group, an IVR or a phone.
+
+The number must be within a DID pool. Creating an assignment fails if the number is already assigned to another owner. The number is assigned through the addresses of a user or group, the DNIS of an IVR or the first line without an address of a phone, so it must not also be listed in the configuration of the owner. Group addresses are not computed, so a group that is managed by Terraform should ignore changes to its ` + "`addresses`" + `.`,
+
+		CreateContext: CreateWithPooledClient(createDidAssignment),
+		ReadContext:   ReadWithPooledClient(readDidAssignment),
+		UpdateContext: UpdateWithPooledClient(updateDidAssignment),
+		DeleteContext: DeleteWithPooledClient(deleteDidAssignment),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: validateDidAssignmentPool,
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"phone_number": {
+				Description:      "Phone number of the DID in an E.164 number format. Changing the phone_number attribute will cause the assignment to be dropped and recreated.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: ValidatePhoneNumber,
+			},
+			"owner_type": {
+				Description:  "Type of the owner of the DID. Valid values: USER, GROUP, IVR_CONFIG, PHONE.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{didOwnerTypeUser, didOwnerTypeGroup, didOwnerTypeIvr, didOwnerTypePhone}, false),
+			},
+			"owner_id": {
+				Description: "ID of the owner of the DID.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"did_pool_id": {
+				Description: "ID of the DID pool the number must be within. If not set, the pool containing the number is used.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func createDidAssignment(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	phoneNumber := d.Get("phone_number").(string)
+	ownerType := d.Get("owner_type").(string)
+	ownerId := d.Get("owner_id").(string)
+
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
+
+	lock := lockDidAssignment(phoneNumber)
+	defer lock.Unlock()
+
+	didPool, diagErr := getDidPoolForNumber(phoneNumber, d.Get("did_pool_id").(string), edgesAPI)
+	if diagErr != nil {
+		return diagErr
+	}
+
+	did, err := getDidByPhoneNumber(phoneNumber, edgesAPI)
+	if err != nil {
+		return diag.Errorf("%v", err)
+	}
+	if did != nil && did.Owner != nil && did.Owner.Id != nil {
+		if *did.Owner.Id != ownerId {
+			return diag.Errorf("Phone number %s is already assigned to %s %s", phoneNumber, getDidOwnerType(*did), *did.Owner.Id)
+		}
+		log.Printf("Phone number %s is already assigned to %s %s", phoneNumber, ownerType, ownerId)
+	} else {
+		log.Printf("Assigning phone number %s to %s %s", phoneNumber, ownerType, ownerId)
+		if diagErr := assignDid(phoneNumber, ownerType, ownerId, sdkConfig); diagErr != nil {
+			return diagErr
+		}
+	}
+	if diagErr := waitForDidOwner(ctx, phoneNumber, ownerId, edgesAPI); diagErr != nil {
+		return diagErr
+	}
+
+	d.SetId(phoneNumber)
+	d.Set("did_pool_id", *didPool.Id)
+
+	log.Printf("Assigned phone number %s to %s %s", phoneNumber, ownerType, ownerId)
+	return readDidAssignment(ctx, d, meta)
+}
+
+func readDidAssignment(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
+
+	log.Printf("Reading DID assignment %s", d.Id())
+	return WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		did, err := getDidByPhoneNumber(d.Id(), edgesAPI)
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+		if did == nil || did.Owner == nil || did.Owner.Id == nil {
+			// The number is no longer assigned
+			log.Printf("Phone number %s is not assigned", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, ResourceTelephonyDidAssignment())
+
+		d.Set("phone_number", d.Id())
+		d.Set("owner_id", *did.Owner.Id)
+		d.Set("owner_type", getDidOwnerType(*did))
+		if did.DidPool != nil && did.DidPool.Id != nil {
+			d.Set("did_pool_id", *did.DidPool.Id)
+		}
+
+		log.Printf("Read DID assignment %s", d.Id())
+		return cc.CheckState()
+	})
+}
+
+func updateDidAssignment(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	phoneNumber := d.Get("phone_number").(string)
+	ownerType := d.Get("owner_type").(string)
+	ownerId := d.Get("owner_id").(string)
+
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
+
+	lock := lockDidAssignment(phoneNumber)
+	defer lock.Unlock()
+
+	if d.HasChange("did_pool_id") {
+		if _, diagErr := getDidPoolForNumber(phoneNumber, d.Get("did_pool_id").(string), edgesAPI); diagErr != nil {
+			return diagErr
+		}
+	}
+
+	if d.HasChanges("owner_type", "owner_id") {
+		oldOwnerType, _ := d.GetChange("owner_type")
+		oldOwnerId, _ := d.GetChange("owner_id")
+
+		log.Printf("Moving phone number %s from %s %s to %s %s", phoneNumber, oldOwnerType, oldOwnerId, ownerType, ownerId)
+		if diagErr := unassignDid(phoneNumber, oldOwnerType.(string), oldOwnerId.(string), sdkConfig); diagErr != nil {
+			return diagErr
+		}
+		if diagErr := waitForDidOwner(ctx, phoneNumber, "", edgesAPI); diagErr != nil {
+			return diagErr
+		}
+		if diagErr := assignDid(phoneNumber, ownerType, ownerId, sdkConfig); diagErr != nil {
+			return diagErr
+		}
+		if diagErr := waitForDidOwner(ctx, phoneNumber, ownerId, edgesAPI); diagErr != nil {
+			return diagErr
+		}
+	}
+
+	log.Printf("Updated DID assignment %s", d.Id())
+	return readDidAssignment(ctx, d, meta)
+}
+
+func deleteDidAssignment(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	phoneNumber := d.Get("phone_number").(string)
+	ownerType := d.Get("owner_type").(string)
+	ownerId := d.Get("owner_id").(string)
+
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
+
+	lock := lockDidAssignment(phoneNumber)
+	defer lock.Unlock()
+
+	log.Printf("Unassigning phone number %s from %s %s", phoneNumber, ownerType, ownerId)
+	if diagErr := unassignDid(phoneNumber, ownerType, ownerId, sdkConfig); diagErr != nil {
+		return diagErr
+	}
+	if diagErr := waitForDidOwner(ctx, phoneNumber, "", edgesAPI); diagErr != nil {
+		return diagErr
+	}
+	log.Printf("Unassigned phone number %s from %s %s", phoneNumber, ownerType, ownerId)
+	return nil
+}
+
+// validateDidAssignmentPool checks at plan time that the number is within the DID pool, if the pool is already known.
+// Pools created in the same run are checked when the assignment is created.
+func validateDidAssignmentPool(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("phone_number") || !diff.NewValueKnown("did_pool_id") {
+		return nil
+	}
+	if !diff.HasChange("phone_number") && !diff.HasChange("did_pool_id") {
+		return nil
+	}
+	phoneNumber, _ := diff.Get("phone_number").(string)
+	didPoolId, _ := diff.Get("did_pool_id").(string)
+	if phoneNumber == "" || didPoolId == "" {
+		return nil
+	}
+
+	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(meta.(*ProviderMeta).ClientConfig)
+	didPool, _, err := edgesAPI.GetTelephonyProvidersEdgesDidpool(didPoolId)
+	if err != nil {
+		log.Printf("DID pool of phone number %s is not validated: %v", phoneNumber, err)
+		return nil
+	}
+	if !didPoolContains(*didPool, phoneNumber) {
+		return fmt.Errorf("phone number %s is not within DID pool %s (%s)", phoneNumber, didPoolId, getDidPoolRange(*didPool))
+	}
+	return nil
+}
+
+// getDidPoolForNumber returns the DID pool containing a number. If a pool ID is given, the number must be within
+// that pool.
+func getDidPoolForNumber(phoneNumber string, didPoolId string, edgesAPI *platformclientv2.TelephonyProvidersEdgeApi) (*platformclientv2.Didpool, diag.Diagnostics) {
+	if didPoolId != "" {
+		didPool, _, err := edgesAPI.GetTelephonyProvidersEdgesDidpool(didPoolId)
+		if err != nil {
+			return nil, diag.Errorf("Failed to read DID pool %s: %s", didPoolId, err)
+		}
+		if !didPoolContains(*didPool, phoneNumber) {
+			return nil, diag.Errorf("Phone number %s is not within DID pool %s (%s)", phoneNumber, didPoolId, getDidPoolRange(*didPool))
+		}
+		return didPool, nil
+	}
+
+	didPools, err := getDidPools(nil, edgesAPI)
+	if err != nil {
+		return nil, diag.Errorf("%v", err)
+	}
+	for i := range didPools {
+		if didPoolContains(didPools[i], phoneNumber) {
+			return &didPools[i], nil
+		}
+	}
+	return nil, diag.Errorf("Phone number %s is not within any DID pool", phoneNumber)
+}
+
+// getDidPools returns the active DID pools with the given IDs, or all active pools if no IDs are given
+func getDidPools(didPoolIds []string, edgesAPI *platformclientv2.TelephonyProvidersEdgeApi) ([]platformclientv2.Didpool, error) {
+	var didPools []platformclientv2.Didpool
+	const pageSize = 100
+	for pageNum := 1; ; pageNum++ {
+		didPoolPage, _, getErr := edgesAPI.GetTelephonyProvidersEdgesDidpools(pageSize, pageNum, "", didPoolIds)
+		if getErr != nil {
+			return nil, fmt.Errorf("Failed to get page of DID pools: %v", getErr)
+		}
+		if didPoolPage.Entities == nil || len(*didPoolPage.Entities) == 0 {
+			break
+		}
+		for _, didPool := range *didPoolPage.Entities {
+			if didPool.State != nil && *didPool.State == "deleted" {
+				continue
+			}
+			didPools = append(didPools, didPool)
+		}
+		if didPoolPage.PageCount == nil || pageNum >= *didPoolPage.PageCount {
+			break
+		}
+	}
+	return didPools, nil
+}
+
+// getDidByPhoneNumber returns the DID of a number, or nil if the number is not assigned
+func getDidByPhoneNumber(phoneNumber string, edgesAPI *platformclientv2.TelephonyProvidersEdgeApi) (*platformclientv2.Did, error) {
+	const pageSize = 100
+	for pageNum := 1; ; pageNum++ {
+		dids, _, getErr := edgesAPI.GetTelephonyProvidersEdgesDids(pageSize, pageNum, "", "", phoneNumber, "", "", nil)
+		if getErr != nil {
+			return nil, fmt.Errorf("Failed to get DIDs for phone number %s: %v", phoneNumber, getErr)
+		}
+		if dids.Entities == nil || len(*dids.Entities) == 0 {
+			return nil, nil
+		}
+		for _, did := range *dids.Entities {
+			if did.PhoneNumber != nil && *did.PhoneNumber == phoneNumber {
+				return &did, nil
+			}
+		}
+		if dids.PageCount == nil || pageNum >= *dids.PageCount {
+			return nil, nil
+		}
+	}
+}
+
+// waitForDidOwner waits until a number is assigned to an owner, or unassigned if the owner ID is empty
+func waitForDidOwner(ctx context.Context, phoneNumber string, ownerId string, edgesAPI *platformclientv2.TelephonyProvidersEdgeApi) diag.Diagnostics {
+	return WithRetries(ctx, 60*time.Second, func() *retry.RetryError {
+		did, err := getDidByPhoneNumber(phoneNumber, edgesAPI)
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+		currentOwnerId := ""
+		if did != nil && did.Owner != nil && did.Owner.Id != nil {
+			currentOwnerId = *did.Owner.Id
+		}
+		if currentOwnerId != ownerId {
+			return retry.RetryableError(fmt.Errorf("Phone number %s is assigned to %q, expected %q", phoneNumber, currentOwnerId, ownerId))
+		}
+		return nil
+	})
+}
+
+// assignDid adds a number to the addresses, DNIS or lines of its owner
+func assignDid(phoneNumber string, ownerType string, ownerId string, sdkConfig *platformclientv2.Configuration) diag.Diagnostics {
+	switch ownerType {
+	case didOwnerTypeUser:
+		return updateDidUserAddresses(ownerId, sdkConfig, func(addresses []platformclientv2.Contact) ([]platformclientv2.Contact, error) {
+			return addDidUserAddress(addresses, phoneNumber)
+		})
+	case didOwnerTypeGroup:
+		return updateDidGroupAddresses(ownerId, sdkConfig, func(addresses []platformclientv2.Groupcontact) ([]platformclientv2.Groupcontact, error) {
+			return addDidGroupAddress(addresses, phoneNumber)
+		})
+	case didOwnerTypeIvr:
+		return updateDidIvrDnis(ownerId, sdkConfig, func(dnis []string) []string {
+			return append(removeDidNumber(dnis, phoneNumber), phoneNumber)
+		})
+	case didOwnerTypePhone:
+		return updateDidPhoneLines(ownerId, sdkConfig, func(lines []platformclientv2.Line) error {
+			return addDidPhoneLineAddress(lines, phoneNumber)
+		})
+	}
+	return diag.Errorf("Unknown DID owner type %s", ownerType)
+}
+
+// unassignDid removes a number from the addresses, DNIS or lines of its owner. Owners that no longer exist are
+// ignored.
+func unassignDid(phoneNumber string, ownerType string, ownerId string, sdkConfig *platformclientv2.Configuration) diag.Diagnostics {
+	switch ownerType {
+	case didOwnerTypeUser:
+		return updateDidUserAddresses(ownerId, sdkConfig, func(addresses []platformclientv2.Contact) ([]platformclientv2.Contact, error) {
+			return removeDidUserAddress(addresses, phoneNumber), nil
+		})
+	case didOwnerTypeGroup:
+		return updateDidGroupAddresses(ownerId, sdkConfig, func(addresses []platformclientv2.Groupcontact) ([]platformclientv2.Groupcontact, error) {
+			return removeDidGroupAddress(addresses, phoneNumber), nil
+		})
+	case didOwnerTypeIvr:
+		return updateDidIvrDnis(ownerId, sdkConfig, func(dnis []string) []string {
+			return removeDidNumber(dnis, phoneNumber)
+		})
+	case didOwnerTypePhone:
+		return updateDidPhoneLines(ownerId, sdkConfig, func(lines []platformclientv2.Line) error {
+			removeDidPhoneLineAddress(lines, phoneNumber)
+			return nil
+		})
+	}
+	return diag.Errorf("Unknown DID owner type %s", ownerType)
+}
+
+func updateDidUserAddresses(userId string, sdkConfig *platformclientv2.Configuration, update func([]platformclientv2.Contact) ([]platformclientv2.Contact, error)) diag.Diagnostics {
+	usersAPI := platformclientv2.NewUsersApiWithConfig(sdkConfig)
+	return RetryWhen(IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+		user, resp, getErr := usersAPI.GetUser(userId, nil, "", "")
+		if getErr != nil {
+			if IsStatus404(resp) {
+				return nil, nil
+			}
+			return resp, diag.Errorf("Failed to read user %s: %s", userId, getErr)
+		}
+
+		var addresses []platformclientv2.Contact
+		if user.Addresses != nil {
+			addresses = *user.Addresses
+		}
+		addresses, err := update(addresses)
+		if err != nil {
+			return nil, diag.Errorf("Failed to update addresses of user %s: %v", userId, err)
+		}
+
+		_, resp, patchErr := usersAPI.PatchUser(userId, platformclientv2.Updateuser{
+			Addresses: &addresses,
+			Version:   user.Version,
+		})
+		if patchErr != nil {
+			return resp, diag.Errorf("Failed to update addresses of user %s: %s", userId, patchErr)
+		}
+		return resp, nil
+	})
+}
+
+func updateDidGroupAddresses(groupId string, sdkConfig *platformclientv2.Configuration, update func([]platformclientv2.Groupcontact) ([]platformclientv2.Groupcontact, error)) diag.Diagnostics {
+	groupsAPI := platformclientv2.NewGroupsApiWithConfig(sdkConfig)
+	return RetryWhen(IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+		group, resp, getErr := groupsAPI.GetGroup(groupId)
+		if getErr != nil {
+			if IsStatus404(resp) {
+				return nil, nil
+			}
+			return resp, diag.Errorf("Failed to read group %s: %s", groupId, getErr)
+		}
+
+		var addresses []platformclientv2.Groupcontact
+		if group.Addresses != nil {
+			addresses = *group.Addresses
+		}
+		addresses, err := update(addresses)
+		if err != nil {
+			return nil, diag.Errorf("Failed to update addresses of group %s: %v", groupId, err)
+		}
+
+		// The group is replaced, so its other settings are sent unchanged
+		var ownerIds []string
+		if group.Owners != nil {
+			for _, owner := range *group.Owners {
+				if owner.Id != nil {
+					ownerIds = append(ownerIds, *owner.Id)
+				}
+			}
+		}
+		_, resp, putErr := groupsAPI.PutGroup(groupId, platformclientv2.Groupupdate{
+			Version:      group.Version,
+			Name:         group.Name,
+			Description:  group.Description,
+			Visibility:   group.Visibility,
+			RulesVisible: group.RulesVisible,
+			Addresses:    &addresses,
+			OwnerIds:     &ownerIds,
+		})
+		if putErr != nil {
+			return resp, diag.Errorf("Failed to update addresses of group %s: %s", groupId, putErr)
+		}
+		return resp, nil
+	})
+}
+
+func updateDidIvrDnis(ivrId string, sdkConfig *platformclientv2.Configuration, update func([]string) []string) diag.Diagnostics {
+	architectIvrProxy.ConfigureProxyApiInstance(sdkConfig)
+	return RetryWhen(IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+		ivr, resp, getErr := architectIvrProxy.GetArchitectIvr(architectIvrProxy, ivrId)
+		if getErr != nil {
+			if IsStatus404(resp) {
+				return nil, nil
+			}
+			return resp, diag.Errorf("Failed to read IVR config %s: %s", ivrId, getErr)
+		}
+
+		var dnis []string
+		if ivr.Dnis != nil {
+			dnis = *ivr.Dnis
+		}
+		dnis = update(dnis)
+		ivr.Dnis = &dnis
+
+		_, resp, putErr := architectIvrProxy.PutArchitectIvr(architectIvrProxy, ivrId, *ivr)
+		if putErr != nil {
+			return resp, diag.Errorf("Failed to update DNIS of IVR config %s: %s", ivrId, putErr)
+		}
+		return resp, nil
+	})
+}
+
+func updateDidPhoneLines(phoneId string, sdkConfig *platformclientv2.Configuration, update func([]platformclientv2.Line) error) diag.Diagnostics {
+	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
+	return RetryWhen(IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+		phone, resp, getErr := edgesAPI.GetTelephonyProvidersEdgesPhone(phoneId)
+		if getErr != nil {
+			if IsStatus404(resp) {
+				return nil, nil
+			}
+			return resp, diag.Errorf("Failed to read phone %s: %s", phoneId, getErr)
+		}
+		if phone.Lines == nil {
+			return nil, diag.Errorf("Phone %s has no lines", phoneId)
+		}
+		if err := update(*phone.Lines); err != nil {
+			return nil, diag.Errorf("Failed to update lines of phone %s: %v", phoneId, err)
+		}
+
+		_, resp, putErr := edgesAPI.PutTelephonyProvidersEdgesPhone(phoneId, *phone)
+		if putErr != nil {
+			return resp, diag.Errorf("Failed to update lines of phone %s: %s", phoneId, putErr)
+		}
+		return resp, nil
+	})
+}
+
+// addDidUserAddress adds a number as the first work phone address that is not in use
+func addDidUserAddress(addresses []platformclientv2.Contact, phoneNumber string) ([]platformclientv2.Contact, error) {
+	addresses = removeDidUserAddress(addresses, phoneNumber)
+	usedTypes := make([]string, 0)
+	for _, address := range addresses {
+		if address.MediaType != nil && *address.MediaType == "PHONE" && address.VarType != nil {
+			usedTypes = append(usedTypes, *address.VarType)
+		}
+	}
+	for _, addressType := range didUserAddressTypes {
+		if lists.ItemInSlice(addressType, usedTypes) {
+			continue
+		}
+		mediaType := "PHONE"
+		addressType := addressType
+		return append(addresses, platformclientv2.Contact{
+			Address:   &phoneNumber,
+			MediaType: &mediaType,
+			VarType:   &addressType,
+		}), nil
+	}
+	return nil, fmt.Errorf("all work phone addresses (%s) are in use", strings.Join(didUserAddressTypes, ", "))
+}
+
+func removeDidUserAddress(addresses []platformclientv2.Contact, phoneNumber string) []platformclientv2.Contact {
+	updatedAddresses := make([]platformclientv2.Contact, 0, len(addresses))
+	for _, address := range addresses {
+		if address.MediaType != nil && *address.MediaType == "PHONE" && address.Address != nil && *address.Address == phoneNumber {
+			continue
+		}
+		updatedAddresses = append(updatedAddresses, address)
+	}
+	return updatedAddresses
+}
+
+// addDidGroupAddress adds a number as the first group phone address that is not in use
+func addDidGroupAddress(addresses []platformclientv2.Groupcontact, phoneNumber string) ([]platformclientv2.Groupcontact, error) {
+	addresses = removeDidGroupAddress(addresses, phoneNumber)
+	usedTypes := make([]string, 0)
+	for _, address := range addresses {
+		if address.VarType != nil {
+			usedTypes = append(usedTypes, *address.VarType)
+		}
+	}
+	for _, addressType := range didGroupAddressTypes {
+		if lists.ItemInSlice(addressType, usedTypes) {
+			continue
+		}
+		mediaType := groupPhoneType
+		addressType := addressType
+		return append(addresses, platformclientv2.Groupcontact{
+			Address:   &phoneNumber,
+			MediaType: &mediaType,
+			VarType:   &addressType,
+		}), nil
+	}
+	return nil, fmt.Errorf("all group phone addresses (%s) are in use", strings.Join(didGroupAddressTypes, ", "))
+}
+
+func removeDidGroupAddress(addresses []platformclientv2.Groupcontact, phoneNumber string) []platformclientv2.Groupcontact {
+	updatedAddresses := make([]platformclientv2.Groupcontact, 0, len(addresses))
+	for _, address := range addresses {
+		if address.Address != nil && *address.Address == phoneNumber {
+			continue
+		}
+		updatedAddresses = append(updatedAddresses, address)
+	}
+	return updatedAddresses
+}
+
+func removeDidNumber(numbers []string, phoneNumber string) []string {
+	updatedNumbers := make([]string, 0, len(numbers))
+	for _, number := range numbers {
+		if number != phoneNumber {
+			updatedNumbers = append(updatedNumbers, number)
+		}
+	}
+	return updatedNumbers
+}
+
+// addDidPhoneLineAddress sets a number as the address of the first line of a phone without one
+func addDidPhoneLineAddress(lines []platformclientv2.Line, phoneNumber string) error {
+	for i := range lines {
+		if getPhoneLineAddress(lines[i]) == phoneNumber {
+			return nil
+		}
+	}
+	for i := range lines {
+		if getPhoneLineAddress(lines[i]) != "" {
+			continue
+		}
+		if lines[i].Properties == nil {
+			lines[i].Properties = &map[string]interface{}{}
+		}
+		(*lines[i].Properties)["station_identity_address"] = map[string]interface{}{
+			"value": map[string]interface{}{
+				"instance": phoneNumber,
+			},
+		}
+		return nil
+	}
+	return fmt.Errorf("all %d lines have an address", len(lines))
+}
+
+func removeDidPhoneLineAddress(lines []platformclientv2.Line, phoneNumber string) {
+	for i := range lines {
+		if getPhoneLineAddress(lines[i]) == phoneNumber {
+			delete(*lines[i].Properties, "station_identity_address")
+		}
+	}
+}
+
+func getPhoneLineAddress(line platformclientv2.Line) string {
+	if line.Properties == nil {
+		return ""
+	}
+	property, _ := (*line.Properties)["station_identity_address"].(map[string]interface{})
+	value, _ := property["value"].(map[string]interface{})
+	address, _ := value["instance"].(string)
+	return address
+}
+
+// didPoolContains reports whether a number is within the range of a DID pool
+func didPoolContains(didPool platformclientv2.Didpool, phoneNumber string) bool {
+	number := strings.TrimPrefix(phoneNumber, "+")
+	if !isDigits(number) || getDidPoolEnd(didPool) == "" {
+		return false
+	}
+	return numberInRange(number, strings.TrimPrefix(getDidPoolStart(didPool), "+"), strings.TrimPrefix(getDidPoolEnd(didPool), "+"))
+}
+
+func getDidPoolRange(didPool platformclientv2.Didpool) string {
+	return getDidPoolStart(didPool) + " - " + getDidPoolEnd(didPool)
+}
+
+func getDidOwnerType(did platformclientv2.Did) string {
+	if did.OwnerType == nil {
+		return ""
+	}
+	return *did.OwnerType
+}
+
+// parseDidNumber returns the value of an E.164 number and its number of digits
+func parseDidNumber(phoneNumber string) (int64, int, error) {
+	digits := strings.TrimPrefix(phoneNumber, "+")
+	if !isDigits(digits) {
+		return 0, 0, fmt.Errorf("%s is not an E.164 number", phoneNumber)
+	}
+	value, err := strconv.ParseInt(digits, 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("%s is not an E.164 number: %v", phoneNumber, err)
+	}
+	return value, len(digits), nil
+}
+
+func formatDidNumber(value int64, digitCount int) string {
+	return fmt.Sprintf("+%0*d", digitCount, value)
+}
+
+// lockDidAssignment locks the assignment of a number. The caller must unlock it.
+func lockDidAssignment(phoneNumber string) *sync.Mutex {
+	lock, _ := didAssignmentLocks.LoadOrStore(phoneNumber, &sync.Mutex{})
+	mutex := lock.(*sync.Mutex)
+	mutex.Lock()
+	return mutex
+}
@@ -0,0 +1,211 @@
+package genesyscloud
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v109/platformclientv2"
+)
+
+func TestAccResourceDidAssignment(t *testing.T) {
+	var (
+		didAssignmentRes = "did-assignment"
+		didPoolRes       = "did-pool"
+		ivrRes           = "ivr"
+		groupRes         = "group"
+		startNumber      = "+14175540020"
+		endNumber        = "+14175540021"
+		phoneNumber      = "+14175540021"
+	)
+	if _, err := AuthorizeSdk(); err != nil {
+		t.Fatal(err)
+	}
+	if err := deleteDidPoolWithNumber(startNumber); err != nil {
+		t.Fatalf("error deleting did pool start number: %v", err)
+	}
+
+	owners := generateDidPoolResource(&didPoolStruct{
+		didPoolRes,
+		startNumber,
+		endNumber,
+		nullValue, // No description
+		nullValue, // No comments
+		nullValue, // No provider
+	}) + generateIvrConfigResource(&ivrConfigStruct{
+		resourceID: ivrRes,
+		name:       "tf test ivr " + uuid.NewString(),
+		depends_on: "genesyscloud_telephony_providers_edges_did_pool." + didPoolRes,
+	}) + generateBasicGroupResource(
+		groupRes,
+		"tf test group "+uuid.NewString(),
+		`lifecycle {
+			ignore_changes = [addresses]
+		}`,
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// Assign to the IVR
+				Config: owners + generateDidAssignmentResource(
+					didAssignmentRes,
+					phoneNumber,
+					didOwnerTypeIvr,
+					"genesyscloud_architect_ivr."+ivrRes+".id",
+					"genesyscloud_telephony_providers_edges_did_pool."+didPoolRes+".id",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_telephony_did_assignment."+didAssignmentRes, "phone_number", phoneNumber),
+					resource.TestCheckResourceAttr("genesyscloud_telephony_did_assignment."+didAssignmentRes, "owner_type", didOwnerTypeIvr),
+					resource.TestCheckResourceAttrPair("genesyscloud_telephony_did_assignment."+didAssignmentRes, "owner_id", "genesyscloud_architect_ivr."+ivrRes, "id"),
+					resource.TestCheckResourceAttrPair("genesyscloud_telephony_did_assignment."+didAssignmentRes, "did_pool_id", "genesyscloud_telephony_providers_edges_did_pool."+didPoolRes, "id"),
+				),
+			},
+			{
+				// Move to the group
+				Config: owners + generateDidAssignmentResource(
+					didAssignmentRes,
+					phoneNumber,
+					didOwnerTypeGroup,
+					"genesyscloud_group."+groupRes+".id",
+					"genesyscloud_telephony_providers_edges_did_pool."+didPoolRes+".id",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_telephony_did_assignment."+didAssignmentRes, "owner_type", didOwnerTypeGroup),
+					resource.TestCheckResourceAttrPair("genesyscloud_telephony_did_assignment."+didAssignmentRes, "owner_id", "genesyscloud_group."+groupRes, "id"),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:      "genesyscloud_telephony_did_assignment." + didAssignmentRes,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+		CheckDestroy: testVerifyDidAssignmentsDestroyed,
+	})
+}
+
+func TestDidAssignmentOwnerAddresses(t *testing.T) {
+	phoneNumber := "+13175550100"
+
+	// Users get the first work phone that is not in use
+	userAddresses, err := addDidUserAddress([]platformclientv2.Contact{
+		{Address: platformclientv2.String("+13175550199"), MediaType: platformclientv2.String("PHONE"), VarType: platformclientv2.String("WORK")},
+		{Address: platformclientv2.String("user@example.com"), MediaType: platformclientv2.String("EMAIL"), VarType: platformclientv2.String("WORK2")},
+	}, phoneNumber)
+	if err != nil {
+		t.Fatalf("failed to add user address: %v", err)
+	}
+	if len(userAddresses) != 3 || *userAddresses[2].Address != phoneNumber || *userAddresses[2].VarType != "WORK2" {
+		t.Errorf("unexpected user addresses %v", userAddresses)
+	}
+	if userAddresses = removeDidUserAddress(userAddresses, phoneNumber); len(userAddresses) != 2 {
+		t.Errorf("expected the user address to be removed, got %v", userAddresses)
+	}
+
+	fullUserAddresses := make([]platformclientv2.Contact, 0)
+	for _, addressType := range didUserAddressTypes {
+		fullUserAddresses = append(fullUserAddresses, platformclientv2.Contact{MediaType: platformclientv2.String("PHONE"), VarType: platformclientv2.String(addressType)})
+	}
+	if _, err := addDidUserAddress(fullUserAddresses, phoneNumber); err == nil {
+		t.Errorf("expected an error when all work phones are in use")
+	}
+
+	// Groups get the first group phone that is not in use
+	groupAddresses, err := addDidGroupAddress([]platformclientv2.Groupcontact{
+		{Address: platformclientv2.String("+13175550199"), VarType: platformclientv2.String("GROUPRING")},
+	}, phoneNumber)
+	if err != nil {
+		t.Fatalf("failed to add group address: %v", err)
+	}
+	if len(groupAddresses) != 2 || *groupAddresses[1].VarType != "GROUPPHONE" || *groupAddresses[1].MediaType != groupPhoneType {
+		t.Errorf("unexpected group addresses %v", groupAddresses)
+	}
+	if _, err := addDidGroupAddress(groupAddresses[:1], "+13175550199"); err != nil {
+		t.Errorf("expected a number to replace its own address: %v", err)
+	}
+
+	dnis := removeDidNumber([]string{"+13175550199", phoneNumber}, phoneNumber)
+	if !reflect.DeepEqual(dnis, []string{"+13175550199"}) {
+		t.Errorf("unexpected DNIS %v", dnis)
+	}
+
+	// Phones get the first line without an address
+	lines := []platformclientv2.Line{
+		{Properties: &map[string]interface{}{"station_identity_address": map[string]interface{}{"value": map[string]interface{}{"instance": "+13175550199"}}}},
+		{},
+	}
+	if err := addDidPhoneLineAddress(lines, phoneNumber); err != nil {
+		t.Fatalf("failed to add phone line address: %v", err)
+	}
+	if getPhoneLineAddress(lines[1]) != phoneNumber {
+		t.Errorf("expected the second line to have the address, got %v", lines)
+	}
+	if err := addDidPhoneLineAddress(lines, "+13175550101"); err == nil {
+		t.Errorf("expected an error when all lines have an address")
+	}
+	removeDidPhoneLineAddress(lines, phoneNumber)
+	if getPhoneLineAddress(lines[1]) != "" || getPhoneLineAddress(lines[0]) != "+13175550199" {
+		t.Errorf("expected only the second line address to be removed, got %v", lines)
+	}
+}
+
+func TestDidPoolContains(t *testing.T) {
+	didPool := platformclientv2.Didpool{
+		StartPhoneNumber: platformclientv2.String("+13175550100"),
+		EndPhoneNumber:   platformclientv2.String("+13175550199"),
+	}
+	for number, expected := range map[string]bool{
+		"+13175550100":  true,
+		"+13175550150":  true,
+		"+13175550199":  true,
+		"+13175550200":  false,
+		"+1317555010":   false,
+		"+131755501000": false,
+		"not a number":  false,
+	} {
+		if contains := didPoolContains(didPool, number); contains != expected {
+			t.Errorf("%s: expected %v, got %v", number, expected, contains)
+		}
+	}
+}
+
+func testVerifyDidAssignmentsDestroyed(state *terraform.State) error {
+	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != "genesyscloud_telephony_did_assignment" {
+			continue
+		}
+		did, err := getDidByPhoneNumber(rs.Primary.ID, edgesAPI)
+		if err != nil {
+			return err
+		}
+		if did != nil && did.Owner != nil {
+			return fmt.Errorf("Phone number %s is still assigned", rs.Primary.ID)
+		}
+	}
+	// Success. All assignments destroyed
+	return nil
+}
+
+func generateDidAssignmentResource(
+	resourceID string,
+	phoneNumber string,
+	ownerType string,
+	ownerID string,
+	didPoolID string) string {
+	return fmt.Sprintf(`resource "genesyscloud_telephony_did_assignment" "%s" {
+		phone_number = "%s"
+		owner_type   = "%s"
+		owner_id     = %s
+		did_pool_id  = %s
+	}
+	`, resourceID, phoneNumber, ownerType, ownerID, didPoolID)
+}
@@ -0,0 +1,508 @@
+package genesyscloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mypurecloud/platform-client-sdk-go/v109/platformclientv2"
+)
+
+func ResourcePhoneBulk() *schema.Resource {
+	return &schema.Resource{
+		Description: `Genesys Cloud Telephony Phone Bulk. Provisions the phones of a site from a CSV hardware inventory.
+
+Each row has a ` + "`mac`" + ` and may set ` + "`name`, `extension`, `user`, `line_addresses` and `phone_base_settings`" + `. The MAC address is used as the hardware ID of the phone and names default to the MAC address.
+Users are referenced by email or ID and phone base settings by name or ID. Line addresses are separated by ` + "`;`" + `.
+
+Phones of WebRTC phone base settings are assigned to their user. The stations of other phones are set as the default station of their user. Extensions are set on the work phone address of the user.
+New rows create phones and fail if another phone already has the same MAC address. Changed rows update their phones and rows removed from the inventory delete their phones. Extensions and default stations are left on users when their phones are deleted.
+Rows are applied in parallel and rows that fail are reported individually and retried on the next apply. When the inventory is first applied, failed rows are reported as warnings so the phones of the other rows are kept.`,
+
+		CreateContext: CreateWithPooledClient(createPhoneBulk),
+		ReadContext:   ReadWithPooledClient(readPhoneBulk),
+		UpdateContext: UpdateWithPooledClient(updatePhoneBulk),
+		DeleteContext: DeleteWithPooledClient(deletePhoneBulk),
+		CustomizeDiff: customizePhoneBulkDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"source_file": {
+				Description:  "Path to the hardware inventory. Must be a .csv file.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: ValidatePath,
+			},
+			"site_id": {
+				Description: "The site ID of the phones.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"phone_base_settings_id": {
+				Description: "Phone Base Settings ID of rows that do not set `phone_base_settings`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"max_concurrency": {
+				Description:  "Maximum number of rows applied at the same time.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      5,
+				ValidateFunc: validation.IntBetween(1, 20),
+			},
+			"row_hashes": {
+				Description: "Hash of each applied row, keyed by MAC address. Used to detect changed rows.",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"phone_ids": {
+				Description: "ID of the phone of each row, keyed by MAC address.",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// customizePhoneBulkDiff parses the inventory during plan so invalid rows fail early and changed rows produce a diff
+func customizePhoneBulkDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if !diff.NewValueKnown("source_file") {
+		if err := diff.SetNewComputed("row_hashes"); err != nil {
+			return err
+		}
+		return diff.SetNewComputed("phone_ids")
+	}
+
+	rows, diagErr := readPhoneInventory(diff.Get("source_file").(string))
+	if diagErr.HasError() {
+		return diagErrorsToError(diagErr)
+	}
+
+	hashes := getPhoneInventoryHashes(rows)
+	if reflect.DeepEqual(diff.Get("row_hashes").(map[string]interface{}), hashes) {
+		return nil
+	}
+	if err := diff.SetNew("row_hashes", hashes); err != nil {
+		return err
+	}
+	return diff.SetNewComputed("phone_ids")
+}
+
+func createPhoneBulk(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sourceFile := d.Get("source_file").(string)
+	log.Printf("Creating phones from inventory %s", sourceFile)
+	d.SetId(uuid.NewString())
+
+	diagErr := syncPhoneBulk(ctx, d, meta, map[string]interface{}{}, map[string]interface{}{})
+	if diagErr.HasError() {
+		if len(d.Get("phone_ids").(map[string]interface{})) == 0 {
+			d.SetId("")
+			return diagErr
+		}
+		// Failing the create would taint the resource and replacing it would delete every phone of the inventory.
+		// Failed rows have no hash so they are retried on the next apply.
+		diagErr = diagErrorsToWarnings(diagErr)
+	}
+
+	log.Printf("Created phones from inventory %s", sourceFile)
+	return append(diagErr, readPhoneBulk(ctx, d, meta)...)
+}
+
+func readPhoneBulk(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+
+	sourceFile := d.Get("source_file").(string)
+	log.Printf("Reading phones from inventory %s", sourceFile)
+	return WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, ResourcePhoneBulk())
+
+		phoneIds := d.Get("phone_ids").(map[string]interface{})
+		hashes := d.Get("row_hashes").(map[string]interface{})
+
+		phones, diagErr := getAllPhones(ctx, sdkConfig)
+		if diagErr != nil {
+			return retry.NonRetryableError(diagErrorsToError(diagErr))
+		}
+		for mac, phoneId := range phoneIds {
+			if _, ok := phones[phoneId.(string)]; !ok {
+				// Phones deleted outside of Terraform are dropped so the next apply recreates them
+				log.Printf("Phone %s from inventory %s no longer exists", mac, sourceFile)
+				delete(phoneIds, mac)
+				delete(hashes, mac)
+			}
+		}
+		d.Set("phone_ids", phoneIds)
+		d.Set("row_hashes", hashes)
+
+		log.Printf("Read phones from inventory %s", sourceFile)
+		return cc.CheckState()
+	})
+}
+
+func updatePhoneBulk(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	oldHashes, _ := d.GetChange("row_hashes")
+	oldPhoneIds, _ := d.GetChange("phone_ids")
+
+	log.Printf("Updating phones from inventory %s", d.Get("source_file").(string))
+	diagErr := syncPhoneBulk(ctx, d, meta, oldHashes.(map[string]interface{}), oldPhoneIds.(map[string]interface{}))
+	if diagErr.HasError() {
+		return diagErr
+	}
+
+	log.Printf("Updated phones from inventory %s", d.Get("source_file").(string))
+	return append(diagErr, readPhoneBulk(ctx, d, meta)...)
+}
+
+func deletePhoneBulk(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	phoneIds := d.Get("phone_ids").(map[string]interface{})
+	log.Printf("Deleting %d phones from inventory %s", len(phoneIds), d.Get("source_file").(string))
+
+	var diagErr diag.Diagnostics
+	var mutex sync.Mutex
+	runWithConcurrency(d.Get("max_concurrency").(int), sortedKeys(phoneIds), func(mac string) {
+		if err := deletePhoneById(ctx, phoneIds[mac].(string), meta); err != nil {
+			mutex.Lock()
+			diagErr = append(diagErr, diag.Diagnostic{Severity: diag.Error, Summary: fmt.Sprintf("Failed to delete phone %s: %v", mac, err)})
+			mutex.Unlock()
+		}
+	})
+	return diagErr
+}
+
+// syncPhoneBulk deletes the phones of removed rows and applies new and changed rows. Phones are deleted first so
+// their line addresses and users can be used by other rows. Rows that fail keep their previous hash so they are
+// retried on the next apply.
+func syncPhoneBulk(ctx context.Context, d *schema.ResourceData, meta interface{}, oldHashes map[string]interface{}, oldPhoneIds map[string]interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	maxConcurrency := d.Get("max_concurrency").(int)
+
+	rows, diagErr := readPhoneInventory(d.Get("source_file").(string))
+	if diagErr.HasError() {
+		return diagErr
+	}
+	newHashes := getPhoneInventoryHashes(rows)
+
+	hashes := make(map[string]interface{})
+	phoneIds := make(map[string]interface{})
+	for mac, phoneId := range oldPhoneIds {
+		phoneIds[mac] = phoneId
+		if hash, ok := oldHashes[mac]; ok {
+			hashes[mac] = hash
+		}
+	}
+	defer func() {
+		d.Set("row_hashes", hashes)
+		d.Set("phone_ids", phoneIds)
+	}()
+
+	var mutex sync.Mutex
+
+	// Delete the phones of removed rows
+	var removed []string
+	for mac := range oldPhoneIds {
+		if _, ok := newHashes[mac]; !ok {
+			removed = append(removed, mac)
+		}
+	}
+	sort.Strings(removed)
+	runWithConcurrency(maxConcurrency, removed, func(mac string) {
+		log.Printf("Deleting phone %s removed from inventory %s", mac, d.Get("source_file").(string))
+		if err := deletePhoneById(ctx, oldPhoneIds[mac].(string), meta); err != nil {
+			mutex.Lock()
+			diagErr = append(diagErr, diag.Diagnostic{Severity: diag.Error, Summary: fmt.Sprintf("Failed to delete phone %s removed from the inventory: %v", mac, err)})
+			mutex.Unlock()
+			return
+		}
+		mutex.Lock()
+		delete(phoneIds, mac)
+		delete(hashes, mac)
+		mutex.Unlock()
+	})
+
+	// Every row is applied again when the site or the default phone base settings change
+	reapply := d.HasChanges("site_id", "phone_base_settings_id")
+	var changedRows []phoneInventoryRow
+	for _, row := range rows {
+		if reapply || phoneIds[row.Mac] == nil || hashes[row.Mac] != newHashes[row.Mac] {
+			changedRows = append(changedRows, row)
+		}
+	}
+
+	settings, settingsErr := loadPhoneInventorySettings(ctx, sdkConfig, changedRows, d.Get("phone_base_settings_id").(string))
+	if settingsErr.HasError() {
+		return append(diagErr, settingsErr...)
+	}
+
+	runWithConcurrency(maxConcurrency, changedRows, func(row phoneInventoryRow) {
+		mutex.Lock()
+		phoneId, _ := phoneIds[row.Mac].(string)
+		mutex.Unlock()
+
+		phoneId, err := applyPhoneInventoryRow(ctx, row, phoneId, d.Get("site_id").(string), settings, meta)
+		mutex.Lock()
+		defer mutex.Unlock()
+		if phoneId != "" {
+			phoneIds[row.Mac] = phoneId
+		}
+		if err != nil {
+			diagErr = append(diagErr, phoneInventoryRowError(row.Row, row.Mac, err.Error()))
+			return
+		}
+		hashes[row.Mac] = newHashes[row.Mac]
+	})
+
+	return diagErr
+}
+
+// applyPhoneInventoryRow creates or updates the phone of a row and assigns it to the row's user.
+// The phone ID is returned even if a later step fails.
+func applyPhoneInventoryRow(ctx context.Context, row phoneInventoryRow, phoneId string, siteId string, settings phoneInventorySettings, meta interface{}) (string, error) {
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
+	usersAPI := platformclientv2.NewUsersApiWithConfig(sdkConfig)
+
+	phoneBaseSettingsId, err := settings.resolve(row.PhoneBaseSettings)
+	if err != nil {
+		return phoneId, err
+	}
+	phoneMetaBaseId := settings.phoneMetaBaseIds[phoneBaseSettingsId]
+
+	userId := row.User
+	if strings.Contains(row.User, "@") {
+		if userId, err = getUserIdByEmail(row.User, usersAPI); err != nil {
+			return phoneId, err
+		}
+		if userId == "" {
+			return phoneId, fmt.Errorf("user %s not found", row.User)
+		}
+	}
+
+	if phoneId == "" {
+		collision, err := getPhoneByHardwareId(row.Mac, edgesAPI)
+		if err != nil {
+			return "", err
+		}
+		if collision != nil {
+			return "", fmt.Errorf("MAC %s is already used by phone %s", row.Mac, *collision.Id)
+		}
+	}
+
+	isWebRtc := isWebRtcPhoneMetaBase(phoneMetaBaseId)
+	d, err := resourceDataFromConfig(ctx, ResourcePhone(), buildPhoneInventoryConfig(row, siteId, phoneBaseSettingsId, phoneMetaBaseId, userId, isWebRtc))
+	if err != nil {
+		return phoneId, err
+	}
+
+	if phoneId == "" {
+		log.Printf("Creating phone %s from inventory row %d", row.Mac, row.Row)
+		if diagErr := createPhone(ctx, d, meta); diagErr.HasError() {
+			return d.Id(), diagErrorsToError(diagErr)
+		}
+		phoneId = d.Id()
+	} else {
+		log.Printf("Updating phone %s from inventory row %d", row.Mac, row.Row)
+		d.SetId(phoneId)
+		if diagErr := updatePhone(ctx, d, meta); diagErr.HasError() {
+			return phoneId, diagErrorsToError(diagErr)
+		}
+	}
+
+	if userId == "" {
+		return phoneId, nil
+	}
+	if !isWebRtc {
+		// WebRTC phones are assigned to their user when they are created
+		if diagErr := setUserDefaultStation(ctx, sdkConfig, userId, phoneId); diagErr != nil {
+			return phoneId, diagErrorsToError(diagErr)
+		}
+	}
+	if row.Extension != "" {
+		diagErr := updateDidUserAddresses(userId, sdkConfig, func(addresses []platformclientv2.Contact) ([]platformclientv2.Contact, error) {
+			return setUserPhoneExtension(addresses, row.Extension), nil
+		})
+		if diagErr != nil {
+			return phoneId, diagErrorsToError(diagErr)
+		}
+	}
+	return phoneId, nil
+}
+
+// buildPhoneInventoryConfig maps a row to genesyscloud_telephony_providers_edges_phone configuration
+func buildPhoneInventoryConfig(row phoneInventoryRow, siteId string, phoneBaseSettingsId string, phoneMetaBaseId string, userId string, isWebRtc bool) map[string]interface{} {
+	name := row.Name
+	if name == "" {
+		name = row.Mac
+	}
+	config := map[string]interface{}{
+		"name":                   name,
+		"site_id":                siteId,
+		"phone_base_settings_id": phoneBaseSettingsId,
+		"phone_meta_base_id":     phoneMetaBaseId,
+		"hardware_id":            row.Mac,
+	}
+	if len(row.LineAddresses) > 0 {
+		lineAddresses := make([]interface{}, 0, len(row.LineAddresses))
+		for _, lineAddress := range row.LineAddresses {
+			lineAddresses = append(lineAddresses, lineAddress)
+		}
+		config["line_addresses"] = lineAddresses
+	}
+	if isWebRtc && userId != "" {
+		config["web_rtc_user_id"] = userId
+	}
+	return config
+}
+
+// setUserPhoneExtension sets the extension of the work phone address, adding the address if the user has none
+func setUserPhoneExtension(addresses []platformclientv2.Contact, extension string) []platformclientv2.Contact {
+	for i, address := range addresses {
+		if address.MediaType != nil && *address.MediaType == "PHONE" && address.VarType != nil && *address.VarType == "WORK" {
+			addresses[i].Extension = &extension
+			return addresses
+		}
+	}
+	mediaType := "PHONE"
+	addressType := "WORK"
+	return append(addresses, platformclientv2.Contact{
+		Extension: &extension,
+		MediaType: &mediaType,
+		VarType:   &addressType,
+	})
+}
+
+// setUserDefaultStation sets the station of a phone as the default station of a user
+func setUserDefaultStation(ctx context.Context, sdkConfig *platformclientv2.Configuration, userId string, phoneId string) diag.Diagnostics {
+	usersAPI := platformclientv2.NewUsersApiWithConfig(sdkConfig)
+
+	stationId, diagErr := getPhoneStationId(ctx, sdkConfig, phoneId)
+	if diagErr != nil {
+		return diagErr
+	}
+
+	return RetryWhen(IsStatus400, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+		resp, putErr := usersAPI.PutUserStationDefaultstationStationId(userId, stationId)
+		if putErr != nil {
+			return resp, diag.Errorf("Failed to set station %s as the default station of user %s: %s", stationId, userId, putErr)
+		}
+		return resp, nil
+	})
+}
+
+// getPhoneByHardwareId returns the phone that is not deleted with a hardware ID, or nil if there is none
+func getPhoneByHardwareId(hardwareId string, edgesAPI *platformclientv2.TelephonyProvidersEdgeApi) (*platformclientv2.Phone, error) {
+	const pageSize = 100
+	for pageNum := 1; ; pageNum++ {
+		phones, _, getErr := edgesAPI.GetTelephonyProvidersEdgesPhones(pageNum, pageSize, "", "", "", "", "", "", "", hardwareId, "", "", "", "", "", nil, nil)
+		if getErr != nil {
+			return nil, fmt.Errorf("failed to get phones with hardware ID %s: %v", hardwareId, getErr)
+		}
+		if phones.Entities == nil || len(*phones.Entities) == 0 {
+			return nil, nil
+		}
+		for _, phone := range *phones.Entities {
+			if phone.State != nil && *phone.State == "deleted" {
+				continue
+			}
+			if normalizePhoneMac(getPhoneHardwareId(&phone)) == hardwareId {
+				return &phone, nil
+			}
+		}
+	}
+}
+
+func deletePhoneById(ctx context.Context, phoneId string, meta interface{}) error {
+	d := ResourcePhone().Data(nil)
+	d.SetId(phoneId)
+	if diagErr := deletePhone(ctx, d, meta); diagErr.HasError() {
+		return diagErrorsToError(diagErr)
+	}
+	return nil
+}
+
+func isWebRtcPhoneMetaBase(phoneMetaBaseId string) bool {
+	return strings.Contains(strings.ToLower(phoneMetaBaseId), "webrtc")
+}
+
+// phoneInventorySettings maps lower case names and IDs of phone base settings to their IDs and the phone base
+// settings used by an inventory to their phone meta base
+type phoneInventorySettings struct {
+	defaultId        string
+	ids              map[string]string
+	phoneMetaBaseIds map[string]string
+}
+
+func (s phoneInventorySettings) resolve(value string) (string, error) {
+	if value == "" {
+		if s.defaultId == "" {
+			return "", fmt.Errorf("phone_base_settings must be set when the resource has no phone_base_settings_id")
+		}
+		return s.defaultId, nil
+	}
+	id, ok := s.ids[strings.ToLower(value)]
+	if !ok {
+		return "", fmt.Errorf("phone base settings %q not found", value)
+	}
+	return id, nil
+}
+
+// loadPhoneInventorySettings lists the phone base settings and looks up the phone meta base of those used by the rows
+func loadPhoneInventorySettings(ctx context.Context, sdkConfig *platformclientv2.Configuration, rows []phoneInventoryRow, defaultId string) (phoneInventorySettings, diag.Diagnostics) {
+	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
+	settings := phoneInventorySettings{
+		defaultId:        defaultId,
+		ids:              make(map[string]string),
+		phoneMetaBaseIds: make(map[string]string),
+	}
+
+	used := make(map[string]bool)
+	for _, row := range rows {
+		if row.PhoneBaseSettings == "" && defaultId != "" {
+			used[defaultId] = true
+		}
+	}
+	for _, row := range rows {
+		if row.PhoneBaseSettings == "" {
+			continue
+		}
+		if len(settings.ids) == 0 {
+			allSettings, diagErr := getAllPhoneBaseSettings(ctx, sdkConfig)
+			if diagErr != nil {
+				return settings, diagErr
+			}
+			for id, entity := range allSettings {
+				settings.ids[strings.ToLower(id)] = id
+				settings.ids[strings.ToLower(entity.Name)] = id
+			}
+		}
+		if id, ok := settings.ids[strings.ToLower(row.PhoneBaseSettings)]; ok {
+			used[id] = true
+		}
+	}
+
+	for _, id := range sortedKeys(used) {
+		phoneMetaBaseId, err := getPhoneMetaBaseId(edgesAPI, id)
+		if err != nil {
+			return settings, diag.Errorf("Failed to get phone meta base of phone base settings %s: %s", id, err)
+		}
+		settings.phoneMetaBaseIds[id] = phoneMetaBaseId
+	}
+	return settings, nil
+}
@@ -0,0 +1,169 @@
+package genesyscloud
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v109/platformclientv2"
+)
+
+func TestAccResourcePhoneBulk(t *testing.T) {
+	var (
+		bulkResource          = "test-phone-bulk"
+		phoneBaseSettingsRes  = "test-bulk-phone-base-settings"
+		phoneBaseSettingsName = "Terraform Bulk Phone Base Settings-" + uuid.NewString()
+		userRes               = "test-bulk-phone-user"
+		userEmail             = "terraform-bulk-phone-" + uuid.NewString() + "@example.com"
+		mac1                  = strings.ReplaceAll(uuid.NewString(), "-", "")[:12]
+		mac2                  = strings.ReplaceAll(uuid.NewString(), "-", "")[:12]
+		extension             = strconv.Itoa(70000 + rand.Intn(9999))
+		inventoryPath         = filepath.Join(t.TempDir(), "phones.csv")
+		removedPhoneID        string
+	)
+
+	writeInventory := func(rows ...string) {
+		content := "mac,name,extension,user\n" + strings.Join(rows, "\n") + "\n"
+		if err := os.WriteFile(inventoryPath, []byte(content), 0644); err != nil {
+			t.Fatalf("failed to write inventory: %v", err)
+		}
+	}
+	row1 := fmt.Sprintf("%s,Terraform Bulk Phone One,%s,%s", mac1, extension, userEmail)
+	row2 := fmt.Sprintf("%s,,,", mac2)
+	config := generateOrganizationMe() +
+		// The extension is set on the user by the bulk resource
+		generateUserWithCustomAttrs(userRes, userEmail, "Terraform Bulk Phone User", "lifecycle {\n ignore_changes = [addresses]\n }") +
+		generatePhoneBaseSettingsResourceWithCustomAttrs(
+			phoneBaseSettingsRes,
+			phoneBaseSettingsName,
+			"phoneBaseSettings description",
+			"generic_sip.json",
+		) +
+		generatePhoneBulkResource(
+			bulkResource,
+			inventoryPath,
+			"data.genesyscloud_organizations_me.me.default_site_id",
+			"genesyscloud_telephony_providers_edges_phonebasesettings."+phoneBaseSettingsRes+".id",
+			"genesyscloud_user."+userRes,
+		)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// Create two phones, the first for a user
+				PreConfig: func() { writeInventory(row1, row2) },
+				Config:    config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_telephony_phone_bulk."+bulkResource, "phone_ids.%", "2"),
+					resource.TestCheckResourceAttr("genesyscloud_telephony_phone_bulk."+bulkResource, "row_hashes.%", "2"),
+					testVerifyBulkPhone(bulkResource, mac1, "Terraform Bulk Phone One"),
+					testVerifyBulkPhone(bulkResource, mac2, mac2),
+					testVerifyBulkPhoneUserExtension("genesyscloud_user."+userRes, extension),
+					func(state *terraform.State) error {
+						removedPhoneID = state.RootModule().Resources["genesyscloud_telephony_phone_bulk."+bulkResource].Primary.Attributes["phone_ids."+mac2]
+						return nil
+					},
+				),
+			},
+			{
+				// Removing a row deletes its phone
+				PreConfig: func() { writeInventory(strings.Replace(row1, "One", "Renamed", 1)) },
+				Config:    config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_telephony_phone_bulk."+bulkResource, "phone_ids.%", "1"),
+					testVerifyBulkPhone(bulkResource, mac1, "Terraform Bulk Phone Renamed"),
+					testVerifyBulkPhoneDeleted(&removedPhoneID),
+				),
+			},
+		},
+		CheckDestroy: testVerifyBulkPhonesDestroyed,
+	})
+}
+
+func generatePhoneBulkResource(resourceID string, sourceFile string, siteID string, phoneBaseSettingsID string, dependsOn string) string {
+	return fmt.Sprintf(`resource "genesyscloud_telephony_phone_bulk" "%s" {
+		source_file            = "%s"
+		site_id                = %s
+		phone_base_settings_id = %s
+		max_concurrency        = 2
+		depends_on             = [%s]
+	}
+	`, resourceID, filepath.ToSlash(sourceFile), siteID, phoneBaseSettingsID, dependsOn)
+}
+
+func testVerifyBulkPhone(bulkResource string, mac string, name string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		attributes := state.RootModule().Resources["genesyscloud_telephony_phone_bulk."+bulkResource].Primary.Attributes
+		phone, _, err := platformclientv2.NewTelephonyProvidersEdgeApi().GetTelephonyProvidersEdgesPhone(attributes["phone_ids."+mac])
+		if err != nil {
+			return fmt.Errorf("failed to read phone %s: %v", mac, err)
+		}
+		if *phone.Name != name {
+			return fmt.Errorf("expected phone %s to be named %s, got %s", mac, name, *phone.Name)
+		}
+		if hardwareId := getPhoneHardwareId(phone); hardwareId != mac {
+			return fmt.Errorf("expected phone %s to have hardware ID %s, got %s", *phone.Id, mac, strconv.Quote(hardwareId))
+		}
+		return nil
+	}
+}
+
+func testVerifyBulkPhoneUserExtension(userResource string, extension string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		userID := state.RootModule().Resources[userResource].Primary.ID
+		user, _, err := platformclientv2.NewUsersApi().GetUser(userID, nil, "", "")
+		if err != nil {
+			return fmt.Errorf("failed to read user %s: %v", userID, err)
+		}
+		if user.Addresses != nil {
+			for _, address := range *user.Addresses {
+				if address.Extension != nil && *address.Extension == extension {
+					return nil
+				}
+			}
+		}
+		return fmt.Errorf("expected user %s to have extension %s", userID, extension)
+	}
+}
+
+func testVerifyBulkPhoneDeleted(phoneID *string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		phone, resp, err := platformclientv2.NewTelephonyProvidersEdgeApi().GetTelephonyProvidersEdgesPhone(*phoneID)
+		if err != nil {
+			if IsStatus404(resp) {
+				return nil
+			}
+			return fmt.Errorf("unexpected error: %s", err)
+		}
+		if phone.State != nil && *phone.State == "deleted" {
+			return nil
+		}
+		return fmt.Errorf("phone %s still exists", *phoneID)
+	}
+}
+
+func testVerifyBulkPhonesDestroyed(state *terraform.State) error {
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != "genesyscloud_telephony_phone_bulk" {
+			continue
+		}
+		for key, value := range rs.Primary.Attributes {
+			if !strings.HasPrefix(key, "phone_ids.") || key == "phone_ids.%" {
+				continue
+			}
+			if err := testVerifyBulkPhoneDeleted(&value)(state); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
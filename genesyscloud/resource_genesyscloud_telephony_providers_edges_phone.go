@@ -127,6 +127,12 @@ func ResourcePhone() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 			},
+			"hardware_id": {
+				Description: "Hardware ID of the phone, such as the MAC address of a hardware phone.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
 			"line_addresses": {
 				Description: "Ordered list of Line DIDs for standalone phones.  Each phone number must be in an E.164 phone number format.",
 				Type:        schema.TypeList,
@@ -206,15 +212,7 @@ func createPhone(ctx context.Context, d *schema.ResourceData, meta interface{})
 		Capabilities:      capabilities,
 	}
 
-	if isStandalone {
-		createPhone.Properties = &map[string]interface{}{
-			"phone_standalone": &map[string]interface{}{
-				"value": &map[string]interface{}{
-					"instance": true,
-				},
-			},
-		}
-	}
+	createPhone.Properties = buildSdkPhoneProperties(d, isStandalone)
 
 	if webRtcUserId != "" {
 		createPhone.WebRtcUser = BuildSdkDomainEntityRef(d, "web_rtc_user_id")
@@ -278,6 +276,8 @@ func readPhone(ctx context.Context, d *schema.ResourceData, meta interface{}) di
 			d.Set("line_addresses", flattenPhoneLines(currentPhone.Lines))
 		}
 
+		d.Set("hardware_id", getPhoneHardwareId(currentPhone))
+
 		if currentPhone.Capabilities != nil {
 			d.Set("capabilities", flattenPhoneCapabilities(currentPhone.Capabilities))
 		}
@@ -372,15 +372,7 @@ func updatePhone(ctx context.Context, d *schema.ResourceData, meta interface{})
 		Lines:             lines,
 	}
 
-	if isStandalone {
-		updatePhoneBody.Properties = &map[string]interface{}{
-			"phone_standalone": &map[string]interface{}{
-				"value": &map[string]interface{}{
-					"instance": true,
-				},
-			},
-		}
-	}
+	updatePhoneBody.Properties = buildSdkPhoneProperties(d, isStandalone)
 
 	if webRtcUserId != "" {
 		updatePhoneBody.WebRtcUser = BuildSdkDomainEntityRef(d, "web_rtc_user_id")
@@ -454,6 +446,39 @@ func buildSdkPhoneBaseSettings(d *schema.ResourceData, idAttr string) *platformc
 	return &platformclientv2.Phonebasesettings{Id: &idVal}
 }
 
+// buildSdkPhoneProperties returns the standalone flag and hardware ID of a phone, or nil if neither is set
+func buildSdkPhoneProperties(d *schema.ResourceData, isStandalone bool) *map[string]interface{} {
+	properties := make(map[string]interface{})
+	if isStandalone {
+		properties["phone_standalone"] = &map[string]interface{}{
+			"value": &map[string]interface{}{
+				"instance": true,
+			},
+		}
+	}
+	if hardwareId := d.Get("hardware_id").(string); hardwareId != "" {
+		properties["phone_hardwareId"] = &map[string]interface{}{
+			"value": &map[string]interface{}{
+				"instance": hardwareId,
+			},
+		}
+	}
+	if len(properties) == 0 {
+		return nil
+	}
+	return &properties
+}
+
+func getPhoneHardwareId(phone *platformclientv2.Phone) string {
+	if phone.Properties == nil {
+		return ""
+	}
+	property, _ := (*phone.Properties)["phone_hardwareId"].(map[string]interface{})
+	value, _ := property["value"].(map[string]interface{})
+	hardwareId, _ := value["instance"].(string)
+	return hardwareId
+}
+
 func getPhoneMetaBaseId(api *platformclientv2.TelephonyProvidersEdgeApi, phoneBaseSettingsId string) (string, error) {
 	phoneBase, _, err := api.GetTelephonyProvidersEdgesPhonebasesetting(phoneBaseSettingsId)
 	if err != nil {
@@ -46,7 +46,7 @@ func ResourcePhoneBaseSettings() *schema.Resource {
 				Required:    true,
 			},
 			"properties": {
-				Description:      "phone base settings properties",
+				Description:      "phone base settings properties. Property names, value types and allowed values are validated at plan time against the JSON schema of the `phone_meta_base_id`.",
 				Type:             schema.TypeString,
 				Optional:         true,
 				Computed:         true,
@@ -30,8 +30,9 @@ func ResourceSite() *schema.Resource {
 		UpdateContext: UpdateWithPooledClient(updateSite),
 		DeleteContext: DeleteWithPooledClient(deleteSite),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importSite,
 		},
+		CustomizeDiff: validateEdgeAutoUpdateConfigDiff,
 		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -88,142 +89,46 @@ func ResourceSite() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"time_zone": {
-							Description: "The timezone of the window in which any updates to the edges assigned to the site can be applied. The minimum size of the window is 2 hours.",
-							Type:        schema.TypeString,
-							Required:    true,
+							Description:      "The timezone of the window in which any updates to the edges assigned to the site can be applied. The minimum size of the window is 2 hours.",
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validateTimeZone,
 						},
 						"rrule": {
-							Description: "The recurrence rule for updating the Edges assigned to the site. The only supported frequencies are daily and weekly. Weekly frequencies require a day list with at least oneday specified. All other configurations are not supported.",
-							Type:        schema.TypeString,
-							Required:    true,
+							Description:      "The recurrence rule for updating the Edges assigned to the site. The only supported frequencies are daily and weekly. Weekly frequencies require a day list with at least oneday specified. All other configurations are not supported.",
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validateRrule(rruleFreqDaily, rruleFreqWeekly),
 						},
 						"start": {
-							Description: "Date time is represented as an ISO-8601 string without a timezone. For example: yyyy-MM-ddTHH:mm:ss.SSS",
-							Type:        schema.TypeString,
-							Required:    true,
+							Description:      "Date time is represented as an ISO-8601 string without a timezone. For example: yyyy-MM-ddTHH:mm:ss.SSS",
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validateLocalDateTimes,
 						},
 						"end": {
-							Description: "Date time is represented as an ISO-8601 string without a timezone. For example: yyyy-MM-ddTHH:mm:ss.SSS",
-							Type:        schema.TypeString,
-							Required:    true,
+							Description:      "Date time is represented as an ISO-8601 string without a timezone. For example: yyyy-MM-ddTHH:mm:ss.SSS",
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validateLocalDateTimes,
 						},
+						"next_occurrences": nextOccurrencesSchema("The next windows in which the edges assigned to the site can be updated, in the time zone of the window."),
 					},
 				},
 			},
 			"number_plans": {
-				Description: "Number plans for the site. The order of the plans in the resource file determines the priority of the plans. Specifying number plans will not result in the default plans being overwritten.",
+				Description: "Number plans for the site. The order of the plans in the resource file determines the priority of the plans. Specifying number plans will not result in the default plans being overwritten. Plans that are not listed and were not previously listed, such as plans managed by `genesyscloud_telephony_providers_edges_site_number_plan`, are ignored.",
 				Type:        schema.TypeList,
 				Optional:    true,
 				Computed:    true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"name": {
-							Description: "The name of the entity.",
-							Type:        schema.TypeString,
-							Required:    true,
-						},
-						"match_type": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: validation.StringInSlice([]string{"digitLength", "e164NumberList", "interCountryCode", "intraCountryCode", "numberList", "regex"}, false),
-						},
-						"normalized_format": {
-							Description: "Use regular expression capture groups to build the normalized number",
-							Type:        schema.TypeString,
-							Optional:    true,
-						},
-						"match_format": {
-							Description: "Use regular expression capture groups to build the normalized number",
-							Type:        schema.TypeString,
-							Optional:    true,
-						},
-						"numbers": {
-							Description: "Numbers must be 2-9 digits long. Numbers within ranges must be the same length. (e.g. 888, 888-999, 55555-77777, 800).",
-							Type:        schema.TypeList,
-							Optional:    true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"start": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
-									"end": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
-								},
-							},
-						},
-						"digit_length": {
-							Description: "Allowed values are between 1-20 digits.",
-							Type:        schema.TypeList,
-							Optional:    true,
-							MaxItems:    1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"start": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
-									"end": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
-								},
-							},
-						},
-						"classification": {
-							Description: "Used to classify this number plan",
-							Type:        schema.TypeString,
-							Required:    true,
-						},
-					},
-				},
+				Elem:        &schema.Resource{Schema: siteNumberPlanSchema()},
 			},
 			"outbound_routes": {
-				Description: "Outbound Routes for the site. The default outbound route will not be delete if routes are specified",
+				Description: "Outbound Routes for the site. Routes that are not listed are deleted when the site is created. Afterwards, routes that are not listed and were not previously listed, such as routes managed by `genesyscloud_telephony_providers_edges_site_outbound_route`, are ignored.",
 				Type:        schema.TypeList,
 				Optional:    true,
 				Computed:    true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"name": {
-							Description: "The name of the entity.",
-							Type:        schema.TypeString,
-							Required:    true,
-						},
-						"description": {
-							Description: "The resource's description.",
-							Type:        schema.TypeString,
-							Optional:    true,
-						},
-						"classification_types": {
-							Description: "Used to classify this outbound route.",
-							Type:        schema.TypeList,
-							Required:    true,
-							Elem:        &schema.Schema{Type: schema.TypeString},
-						},
-						"enabled": {
-							Description: "Enable or disable the outbound route",
-							Type:        schema.TypeBool,
-							Optional:    true,
-							Default:     false,
-						},
-						"distribution": {
-							Description:  "Valid values: SEQUENTIAL, RANDOM.",
-							Type:         schema.TypeString,
-							Optional:     true,
-							Default:      "SEQUENTIAL",
-							ValidateFunc: validation.StringInSlice([]string{"SEQUENTIAL", "RANDOM"}, false),
-						},
-						"external_trunk_base_ids": {
-							Description: "Trunk base settings of trunkType \"EXTERNAL\". This base must also be set on an edge logical interface for correct routing. The order of the IDs determines the distribution if \"distribution\" is set to \"SEQUENTIAL\"",
-							Type:        schema.TypeList,
-							Optional:    true,
-							Elem:        &schema.Schema{Type: schema.TypeString},
-						},
-					},
-				},
+				Elem:        &schema.Resource{Schema: siteOutboundRouteSchema()},
 			},
 			"primary_sites": {
 				Description: `Used for primary phone edge assignment on physical edges only.  List of primary sites the phones can be assigned to. If no primary_sites are defined, the site id for this site will be used as the primary site id.`,
@@ -293,11 +198,12 @@ func SiteExporter() *resourceExporter.ResourceExporter {
 	return &resourceExporter.ResourceExporter{
 		GetResourcesFunc: GetAllWithPooledClient(getSites),
 		RefAttrs: map[string]*resourceExporter.RefAttrSettings{
-			"location_id": {RefType: "genesyscloud_location"},
-			"outbound_routes.external_trunk_base_ids": {RefType: "genesyscloud_telephony_providers_edges_trunkbasesettings"},
+			"location_id":     {RefType: "genesyscloud_location"},
 			"primary_sites":   {RefType: "genesyscloud_telephony_providers_edges_site"},
 			"secondary_sites": {RefType: "genesyscloud_telephony_providers_edges_site"},
 		},
+		// Number plans and outbound routes are exported as standalone resources
+		ExcludedAttributes: []string{"number_plans", "outbound_routes", "edge_auto_update_config.next_occurrences"},
 	}
 }
 
@@ -326,6 +232,36 @@ func validateMediaRegions(regions *[]string, sdkConfig *platformclientv2.Configu
 	return nil
 }
 
+// importSite imports a site with all of its number plans and outbound routes, except the default plans
+func importSite(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
+
+	numberPlans, _, err := edgesAPI.GetTelephonyProvidersEdgesSiteNumberplans(d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get number plans for site %s: %s", d.Id(), err)
+	}
+	dNumberPlans := make([]interface{}, 0)
+	for _, numberPlan := range numberPlans {
+		if !isDefaultPlan(*numberPlan.Name) {
+			dNumberPlans = append(dNumberPlans, flattenSdkSiteNumberPlan(numberPlan))
+		}
+	}
+	d.Set("number_plans", dNumberPlans)
+
+	outboundRoutes, err := getSiteOutboundRoutes(d.Id(), edgesAPI)
+	if err != nil {
+		return nil, err
+	}
+	dOutboundRoutes := make([]interface{}, 0)
+	for _, outboundRoute := range outboundRoutes {
+		dOutboundRoutes = append(dOutboundRoutes, flattenSdkSiteOutboundRoute(outboundRoute))
+	}
+	d.Set("outbound_routes", dOutboundRoutes)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func createSite(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	name := d.Get("name").(string)
 	locationId := d.Get("location_id").(string)
@@ -699,63 +635,15 @@ func updateSiteNumberPlans(d *schema.ResourceData, edgesAPI *platformclientv2.Te
 		if nps := d.Get("number_plans").([]interface{}); nps != nil {
 			numberPlansFromTf := make([]platformclientv2.Numberplan, 0)
 			for _, np := range nps {
-				npMap := np.(map[string]interface{})
-				numberPlanFromTf := platformclientv2.Numberplan{}
-
-				if name := npMap["name"].(string); name != "" {
-					numberPlanFromTf.Name = &name
-				}
-
-				if matchType := npMap["match_type"].(string); matchType != "" {
-					numberPlanFromTf.MatchType = &matchType
-				}
-
-				if matchFormat := npMap["match_format"].(string); matchFormat != "" {
-					numberPlanFromTf.Match = &matchFormat
-				}
-
-				if normalizedFormat := npMap["normalized_format"].(string); normalizedFormat != "" {
-					numberPlanFromTf.NormalizedFormat = &normalizedFormat
-				}
-
-				if classification := npMap["classification"].(string); classification != "" {
-					numberPlanFromTf.Classification = &classification
-				}
-
-				if numbers, ok := npMap["numbers"].([]interface{}); ok && len(numbers) > 0 {
-					sdkNumbers := make([]platformclientv2.Number, 0)
-					for _, number := range numbers {
-						numberMap := number.(map[string]interface{})
-						sdkNumber := platformclientv2.Number{}
-						if start, ok := numberMap["start"].(string); ok {
-							sdkNumber.Start = &start
-						}
-						if end, ok := numberMap["end"].(string); ok {
-							sdkNumber.End = &end
-						}
-						sdkNumbers = append(sdkNumbers, sdkNumber)
-					}
-					numberPlanFromTf.Numbers = &sdkNumbers
-				}
-
-				if digitLength, ok := npMap["digit_length"].([]interface{}); ok && len(digitLength) > 0 {
-					sdkDigitlengthMap := digitLength[0].(map[string]interface{})
-					sdkDigitlength := platformclientv2.Digitlength{}
-					if start, ok := sdkDigitlengthMap["start"].(string); ok {
-						sdkDigitlength.Start = &start
-					}
-					if end, ok := sdkDigitlengthMap["end"].(string); ok {
-						sdkDigitlength.End = &end
-					}
-					numberPlanFromTf.DigitLength = &sdkDigitlength
-				}
-
-				numberPlansFromTf = append(numberPlansFromTf, numberPlanFromTf)
+				numberPlansFromTf = append(numberPlansFromTf, buildSdkSiteNumberPlan(np.(map[string]interface{})))
 			}
 
 			// The default plans won't be assigned yet if there isn't a wait
 			time.Sleep(5 * time.Second)
 
+			lock := lockSiteNumberPlans(d.Id())
+			defer lock.Unlock()
+
 			numberPlansFromAPI, _, err := edgesAPI.GetTelephonyProvidersEdgesSiteNumberplans(d.Id())
 			if err != nil {
 				return diag.Errorf("Failed to get number plans for site %s: %s", d.Id(), err)
@@ -779,9 +667,13 @@ func updateSiteNumberPlans(d *schema.ResourceData, edgesAPI *platformclientv2.Te
 				}
 			}
 
+			previouslyManaged := siteManagedNames(d, "number_plans")
 			for _, numberPlanFromAPI := range numberPlansFromAPI {
-				// Keep the default plans assigned.
-				if isDefaultPlan(*numberPlanFromAPI.Name) {
+				if _, ok := nameInPlans(*numberPlanFromAPI.Name, numberPlansFromTf); ok {
+					continue
+				}
+				// Keep the default plans assigned, and the plans managed by genesyscloud_telephony_providers_edges_site_number_plan
+				if isDefaultPlan(*numberPlanFromAPI.Name) || !lists.ItemInSlice(*numberPlanFromAPI.Name, previouslyManaged) {
 					updatedNumberPlans = append(updatedNumberPlans, numberPlanFromAPI)
 				}
 			}
@@ -813,54 +705,15 @@ func updateSiteOutboundRoutes(d *schema.ResourceData, edgesAPI *platformclientv2
 		if ors := d.Get("outbound_routes").([]interface{}); ors != nil {
 			outboundRoutesFromTf := make([]platformclientv2.Outboundroutebase, 0)
 			for _, or := range ors {
-				orMap := or.(map[string]interface{})
-				outboundRouteFromTf := platformclientv2.Outboundroutebase{}
-
-				if name := orMap["name"].(string); name != "" {
-					outboundRouteFromTf.Name = &name
-				}
-				if description := orMap["description"].(string); description != "" {
-					outboundRouteFromTf.Description = &description
-				}
-				if classificationTypes, ok := orMap["classification_types"].([]interface{}); ok && len(classificationTypes) > 0 {
-					cts := make([]string, 0)
-					for _, classificationType := range classificationTypes {
-						cts = append(cts, classificationType.(string))
-					}
-					outboundRouteFromTf.ClassificationTypes = &cts
-				}
-				if enabled, ok := orMap["enabled"].(bool); ok {
-					outboundRouteFromTf.Enabled = &enabled
-				}
-				if distribution := orMap["distribution"].(string); distribution != "" {
-					outboundRouteFromTf.Distribution = &distribution
-				}
-				if externalTrunkBaseIds, ok := orMap["external_trunk_base_ids"].([]interface{}); ok && len(externalTrunkBaseIds) > 0 {
-					ids := make([]platformclientv2.Domainentityref, 0)
-					for _, externalTrunkBaseId := range externalTrunkBaseIds {
-						externalTrunkBaseIdStr := externalTrunkBaseId.(string)
-						ids = append(ids, platformclientv2.Domainentityref{Id: &externalTrunkBaseIdStr})
-					}
-					outboundRouteFromTf.ExternalTrunkBases = &ids
-				}
-
-				outboundRoutesFromTf = append(outboundRoutesFromTf, outboundRouteFromTf)
+				outboundRoutesFromTf = append(outboundRoutesFromTf, buildSdkSiteOutboundRoute(or.(map[string]interface{})))
 			}
 
 			// The default outbound routes won't be assigned yet if there isn't a wait
 			time.Sleep(5 * time.Second)
 
-			outboundRoutesFromAPI := make([]platformclientv2.Outboundroutebase, 0)
-			for pageNum := 1; ; pageNum++ {
-				const pageSize = 100
-				outboundRoutes, _, err := edgesAPI.GetTelephonyProvidersEdgesSiteOutboundroutes(d.Id(), pageSize, pageNum, "", "", "")
-				if err != nil {
-					return diag.Errorf("Failed to get outbound routes for site %s: %s", d.Id(), err)
-				}
-				if outboundRoutes.Entities == nil || len(*outboundRoutes.Entities) == 0 {
-					break
-				}
-				outboundRoutesFromAPI = append(outboundRoutesFromAPI, *outboundRoutes.Entities...)
+			outboundRoutesFromAPI, err := getSiteOutboundRoutes(d.Id(), edgesAPI)
+			if err != nil {
+				return diag.FromErr(err)
 			}
 
 			for _, outboundRouteFromTf := range outboundRoutesFromTf {
@@ -885,16 +738,22 @@ func updateSiteOutboundRoutes(d *schema.ResourceData, edgesAPI *platformclientv2
 				}
 			}
 
+			// A new site only has its default routes. Afterwards, only routes that were in the site's outbound_routes
+			// are deleted, so routes managed by genesyscloud_telephony_providers_edges_site_outbound_route are kept.
+			previouslyManaged := siteManagedNames(d, "outbound_routes")
 			for _, outboundRouteFromAPI := range outboundRoutesFromAPI {
-				// Delete route if no reference to it
-				if _, ok := nameInOutboundRoutes(*outboundRouteFromAPI.Name, outboundRoutesFromTf); !ok {
-					resp, err := edgesAPI.DeleteTelephonyProvidersEdgesSiteOutboundroute(d.Id(), *outboundRouteFromAPI.Id)
-					if err != nil {
-						if IsStatus404(resp) {
-							return nil
-						}
-						return diag.Errorf("Failed to delete outbound route from site %s: %s", d.Id(), err)
+				if _, ok := nameInOutboundRoutes(*outboundRouteFromAPI.Name, outboundRoutesFromTf); ok {
+					continue
+				}
+				if !d.IsNewResource() && !lists.ItemInSlice(*outboundRouteFromAPI.Name, previouslyManaged) {
+					continue
+				}
+				resp, err := edgesAPI.DeleteTelephonyProvidersEdgesSiteOutboundroute(d.Id(), *outboundRouteFromAPI.Id)
+				if err != nil {
+					if IsStatus404(resp) {
+						return nil
 					}
+					return diag.Errorf("Failed to delete outbound route from site %s: %s", d.Id(), err)
 				}
 			}
 
@@ -905,6 +764,44 @@ func updateSiteOutboundRoutes(d *schema.ResourceData, edgesAPI *platformclientv2
 	return nil
 }
 
+// siteManagedNames returns the names of the number plans or outbound routes the site managed before the change
+func siteManagedNames(d *schema.ResourceData, attr string) []string {
+	oldItems, _ := d.GetChange(attr)
+	var names []string
+	if oldList, ok := oldItems.([]interface{}); ok {
+		for _, item := range oldList {
+			if itemMap, ok := item.(map[string]interface{}); ok {
+				if name, ok := itemMap["name"].(string); ok && name != "" {
+					names = append(names, name)
+				}
+			}
+		}
+	}
+	return names
+}
+
+// siteItemNames returns the names of the number plans or outbound routes the site manages
+func siteItemNames(d *schema.ResourceData, attr string) []string {
+	var names []string
+	for _, item := range d.Get(attr).([]interface{}) {
+		if itemMap, ok := item.(map[string]interface{}); ok {
+			if name, ok := itemMap["name"].(string); ok && name != "" {
+				names = append(names, name)
+			}
+		}
+	}
+	return names
+}
+
+// siteItemMap returns the attributes of a standalone number plan or outbound route in the form of an item of a site
+func siteItemMap(d *schema.ResourceData, itemSchema map[string]*schema.Schema) map[string]interface{} {
+	itemMap := make(map[string]interface{})
+	for key := range itemSchema {
+		itemMap[key] = d.Get(key)
+	}
+	return itemMap
+}
+
 func isDefaultPlan(name string) bool {
 	defaultPlans := []string{"Emergency", "Extension", "National", "International", "Network", "Suicide Prevent"}
 	for _, defaultPlan := range defaultPlans {
@@ -925,117 +822,52 @@ func readSiteNumberPlans(d *schema.ResourceData, edgesAPI *platformclientv2.Tele
 		return retry.NonRetryableError(fmt.Errorf("Failed to read number plans for site %s: %s", d.Id(), getErr))
 	}
 
+	// Plans the site doesn't manage are ignored
+	managedNames := siteItemNames(d, "number_plans")
 	dNumberPlans := make([]interface{}, 0)
-	if len(numberPlans) > 0 {
-		for _, numberPlan := range numberPlans {
-			if isDefaultPlan(*numberPlan.Name) {
-				continue
-			}
-
-			dNumberPlan := make(map[string]interface{})
-			dNumberPlan["name"] = *numberPlan.Name
-
-			if numberPlan.Match != nil {
-				dNumberPlan["match_format"] = *numberPlan.Match
-			}
-			if numberPlan.NormalizedFormat != nil {
-				dNumberPlan["normalized_format"] = *numberPlan.NormalizedFormat
-			}
-			if numberPlan.Classification != nil {
-				dNumberPlan["classification"] = *numberPlan.Classification
-			}
-			if numberPlan.MatchType != nil {
-				dNumberPlan["match_type"] = *numberPlan.MatchType
-			}
-
-			if numberPlan.Numbers != nil {
-				numbers := make([]interface{}, 0)
-				for _, number := range *numberPlan.Numbers {
-					numberMap := make(map[string]interface{})
-					if number.Start != nil {
-						numberMap["start"] = *number.Start
-					}
-					if number.End != nil {
-						numberMap["end"] = *number.End
-					}
-					numbers = append(numbers, numberMap)
-				}
-				dNumberPlan["numbers"] = numbers
-			}
-			if numberPlan.DigitLength != nil {
-				digitLength := make([]interface{}, 0)
-				digitLengthMap := make(map[string]interface{})
-				if numberPlan.DigitLength.Start != nil {
-					digitLengthMap["start"] = *numberPlan.DigitLength.Start
-				}
-				if numberPlan.DigitLength.End != nil {
-					digitLengthMap["end"] = *numberPlan.DigitLength.End
-				}
-				digitLength = append(digitLength, digitLengthMap)
-				dNumberPlan["digit_length"] = digitLength
-			}
-
-			dNumberPlans = append(dNumberPlans, dNumberPlan)
+	for _, numberPlan := range numberPlans {
+		if isDefaultPlan(*numberPlan.Name) || !lists.ItemInSlice(*numberPlan.Name, managedNames) {
+			continue
 		}
-		d.Set("number_plans", dNumberPlans)
-	} else {
-		d.Set("number_plans", nil)
+		dNumberPlans = append(dNumberPlans, flattenSdkSiteNumberPlan(numberPlan))
 	}
+	d.Set("number_plans", dNumberPlans)
 
 	return nil
 }
 
-func readSiteOutboundRoutes(d *schema.ResourceData, edgesAPI *platformclientv2.TelephonyProvidersEdgeApi) *retry.RetryError {
+func getSiteOutboundRoutes(siteId string, edgesAPI *platformclientv2.TelephonyProvidersEdgeApi) ([]platformclientv2.Outboundroutebase, error) {
 	outboundRoutes := make([]platformclientv2.Outboundroutebase, 0)
 	for pageNum := 1; ; pageNum++ {
 		const pageSize = 100
-		outboundRouteEntityListing, _, err := edgesAPI.GetTelephonyProvidersEdgesSiteOutboundroutes(d.Id(), pageSize, pageNum, "", "", "")
+		outboundRouteEntityListing, _, err := edgesAPI.GetTelephonyProvidersEdgesSiteOutboundroutes(siteId, pageSize, pageNum, "", "", "")
 		if err != nil {
-			return retry.NonRetryableError(fmt.Errorf("Failed to get outbound routes for site %s: %s", d.Id(), err))
+			return nil, fmt.Errorf("Failed to get outbound routes for site %s: %s", siteId, err)
 		}
 		if outboundRouteEntityListing.Entities == nil || len(*outboundRouteEntityListing.Entities) == 0 {
 			break
 		}
 		outboundRoutes = append(outboundRoutes, *outboundRouteEntityListing.Entities...)
 	}
+	return outboundRoutes, nil
+}
 
-	dOutboundRoutes := make([]interface{}, 0)
-
-	if len(outboundRoutes) > 0 {
-		for _, outboundRoute := range outboundRoutes {
-			dOutboundRoute := make(map[string]interface{})
-			dOutboundRoute["name"] = *outboundRoute.Name
-
-			if outboundRoute.Description != nil {
-				dOutboundRoute["description"] = *outboundRoute.Description
-			}
-
-			if outboundRoute.ClassificationTypes != nil {
-				dOutboundRoute["classification_types"] = *outboundRoute.ClassificationTypes
-			}
-
-			if outboundRoute.Enabled != nil {
-				dOutboundRoute["enabled"] = *outboundRoute.Enabled
-			}
-
-			if outboundRoute.Distribution != nil {
-				dOutboundRoute["distribution"] = *outboundRoute.Distribution
-			}
-
-			if len(*outboundRoute.ExternalTrunkBases) > 0 {
-				externalTrunkBaseIds := make([]string, 0)
-				for _, externalTrunkBase := range *outboundRoute.ExternalTrunkBases {
-					externalTrunkBaseIds = append(externalTrunkBaseIds, *externalTrunkBase.Id)
-				}
-				dOutboundRoute["external_trunk_base_ids"] = externalTrunkBaseIds
-			}
+func readSiteOutboundRoutes(d *schema.ResourceData, edgesAPI *platformclientv2.TelephonyProvidersEdgeApi) *retry.RetryError {
+	outboundRoutes, err := getSiteOutboundRoutes(d.Id(), edgesAPI)
+	if err != nil {
+		return retry.NonRetryableError(err)
+	}
 
-			dOutboundRoutes = append(dOutboundRoutes, dOutboundRoute)
+	// Routes the site doesn't manage are ignored
+	managedNames := siteItemNames(d, "outbound_routes")
+	dOutboundRoutes := make([]interface{}, 0)
+	for _, outboundRoute := range outboundRoutes {
+		if !lists.ItemInSlice(*outboundRoute.Name, managedNames) {
+			continue
 		}
-		d.Set("outbound_routes", dOutboundRoutes)
-	} else {
-		d.Set("outbound_routes", nil)
+		dOutboundRoutes = append(dOutboundRoutes, flattenSdkSiteOutboundRoute(outboundRoute))
 	}
+	d.Set("outbound_routes", dOutboundRoutes)
 
 	return nil
 }
@@ -1050,10 +882,83 @@ func flattenSdkEdgeAutoUpdateConfig(edgeAutoUpdateConfig *platformclientv2.Edgea
 	edgeAutoUpdateConfigMap["rrule"] = *edgeAutoUpdateConfig.Rrule
 	edgeAutoUpdateConfigMap["start"] = timeutil.Strftime(edgeAutoUpdateConfig.Start, "%Y-%m-%dT%H:%M:%S.%f")
 	edgeAutoUpdateConfigMap["end"] = timeutil.Strftime(edgeAutoUpdateConfig.End, "%Y-%m-%dT%H:%M:%S.%f")
+	edgeAutoUpdateConfigMap["next_occurrences"] = flattenEdgeAutoUpdateNextOccurrences(edgeAutoUpdateConfigMap)
 
 	return []interface{}{edgeAutoUpdateConfigMap}
 }
 
+// flattenEdgeAutoUpdateNextOccurrences lists the next update windows. Windows that cannot be computed are left empty.
+func flattenEdgeAutoUpdateNextOccurrences(edgeAutoUpdateConfigMap map[string]interface{}) []interface{} {
+	start, end, location, err := parseEdgeAutoUpdateWindow(edgeAutoUpdateConfigMap)
+	if err != nil {
+		log.Printf("Failed to compute next edge auto update windows: %v", err)
+		return nil
+	}
+	rule, err := parseRecurrenceRule(edgeAutoUpdateConfigMap["rrule"].(string), rruleFreqDaily, rruleFreqWeekly)
+	if err != nil {
+		log.Printf("[WARN] Not computing next edge auto update windows of rrule %s: %v", edgeAutoUpdateConfigMap["rrule"], err)
+		return nil
+	}
+	return flattenRecurrenceWindows(getRecurrenceWindows(rule, start, end, localNow(location), nextOccurrencesCount))
+}
+
+func parseEdgeAutoUpdateWindow(edgeAutoUpdateConfigMap map[string]interface{}) (time.Time, time.Time, *time.Location, error) {
+	location, err := time.LoadLocation(edgeAutoUpdateConfigMap["time_zone"].(string))
+	if err != nil {
+		return time.Time{}, time.Time{}, nil, err
+	}
+	start, err := parseLocalDateTime(edgeAutoUpdateConfigMap["start"].(string))
+	if err != nil {
+		return time.Time{}, time.Time{}, nil, err
+	}
+	end, err := parseLocalDateTime(edgeAutoUpdateConfigMap["end"].(string))
+	if err != nil {
+		return time.Time{}, time.Time{}, nil, err
+	}
+	return start, end, location, nil
+}
+
+// validateEdgeAutoUpdateConfigDiff checks the update window is at least 2 hours long, weekly windows set their days
+// and windows do not overlap. The days and overlaps are not checked for rules whose windows cannot be computed.
+func validateEdgeAutoUpdateConfigDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if !diff.NewValueKnown("edge_auto_update_config") {
+		return nil
+	}
+	edgeAutoUpdateConfigList := diff.Get("edge_auto_update_config").([]interface{})
+	if len(edgeAutoUpdateConfigList) == 0 || edgeAutoUpdateConfigList[0] == nil {
+		return nil
+	}
+	edgeAutoUpdateConfigMap := edgeAutoUpdateConfigList[0].(map[string]interface{})
+	for _, attr := range []string{"time_zone", "rrule", "start", "end"} {
+		if edgeAutoUpdateConfigMap[attr].(string) == "" {
+			// Unknown values are validated during apply
+			return nil
+		}
+	}
+
+	start, end, _, err := parseEdgeAutoUpdateWindow(edgeAutoUpdateConfigMap)
+	if err != nil {
+		return fmt.Errorf("invalid edge_auto_update_config: %v", err)
+	}
+	if end.Sub(start) < 2*time.Hour {
+		return fmt.Errorf("invalid edge_auto_update_config: the window from %s to %s must be at least 2 hours", formatLocalDateTime(start), formatLocalDateTime(end))
+	}
+
+	rrule := edgeAutoUpdateConfigMap["rrule"].(string)
+	rule, err := parseRecurrenceRule(rrule, rruleFreqDaily, rruleFreqWeekly)
+	if err != nil {
+		log.Printf("[WARN] Not checking edge auto update rrule %s for overlapping windows: %v", rrule, err)
+		return nil
+	}
+	if rule.Freq == rruleFreqWeekly && len(rule.ByDay) == 0 {
+		return fmt.Errorf("invalid edge_auto_update_config: weekly rules must set BYDAY")
+	}
+	if err := validateRecurrenceWindows(rule, start, end); err != nil {
+		return fmt.Errorf("invalid edge_auto_update_config: %v", err)
+	}
+	return nil
+}
+
 func buildSdkEdgeAutoUpdateConfig(d *schema.ResourceData) (*platformclientv2.Edgeautoupdateconfig, error) {
 	if edgeAutoUpdateConfig := d.Get("edge_auto_update_config"); edgeAutoUpdateConfig != nil {
 		if edgeAutoUpdateConfigList := edgeAutoUpdateConfig.([]interface{}); len(edgeAutoUpdateConfigList) > 0 {
@@ -0,0 +1,446 @@
+package genesyscloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+
+	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mypurecloud/platform-client-sdk-go/v109/platformclientv2"
+)
+
+// The number plans of a site can only be updated together, so updates to the plans of a site are serialized
+var siteNumberPlanLocks sync.Map
+
+// siteNumberPlanSchema is the schema of a number plan, shared by the number_plans of a site and the standalone number
+// plan resource
+func siteNumberPlanSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Description: "The name of the entity.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"match_type": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice([]string{"digitLength", "e164NumberList", "interCountryCode", "intraCountryCode", "numberList", "regex"}, false),
+		},
+		"normalized_format": {
+			Description: "Use regular expression capture groups to build the normalized number",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"match_format": {
+			Description: "Use regular expression capture groups to build the normalized number",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"numbers": {
+			Description: "Numbers must be 2-9 digits long. Numbers within ranges must be the same length. (e.g. 888, 888-999, 55555-77777, 800).",
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"start": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"end": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+				},
+			},
+		},
+		"digit_length": {
+			Description: "Allowed values are between 1-20 digits.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"start": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"end": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+				},
+			},
+		},
+		"classification": {
+			Description: "Used to classify this number plan",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+	}
+}
+
+func ResourceSiteNumberPlan() *schema.Resource {
+	numberPlanSchema := siteNumberPlanSchema()
+	numberPlanSchema["site_id"] = &schema.Schema{
+		Description: "ID of the site. Changing the site_id attribute will cause the number plan to be dropped and recreated with a new ID.",
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+	}
+	numberPlanSchema["priority"] = &schema.Schema{
+		Description:  "Priority of the number plan. Plans are evaluated in ascending order of priority. If not set, the plan is evaluated after the existing plans of the site.",
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validation.IntAtLeast(0),
+	}
+
+	return &schema.Resource{
+		Description: `Genesys Cloud Site Number Plan. Manages one number plan of a site, independently of the site.
+
+Number plans managed by this resource must not also be listed in the ` + "`number_plans`" + ` of the site. If the site already has a plan with the same name, such as a default plan, the plan is adopted. Deleting an adopted default plan leaves it on the site.`,
+
+		CreateContext: CreateWithPooledClient(createSiteNumberPlan),
+		ReadContext:   ReadWithPooledClient(readSiteNumberPlan),
+		UpdateContext: UpdateWithPooledClient(updateSiteNumberPlan),
+		DeleteContext: DeleteWithPooledClient(deleteSiteNumberPlan),
+		Importer: &schema.ResourceImporter{
+			StateContext: importSiteItem,
+		},
+		SchemaVersion: 1,
+		Schema:        numberPlanSchema,
+	}
+}
+
+func getAllSiteNumberPlans(ctx context.Context, sdkConfig *platformclientv2.Configuration) (resourceExporter.ResourceIDMetaMap, diag.Diagnostics) {
+	resources := make(resourceExporter.ResourceIDMetaMap)
+	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
+
+	sites, diagErr := getSites(ctx, sdkConfig)
+	if diagErr != nil {
+		return nil, diagErr
+	}
+	for siteId, site := range sites {
+		numberPlans, resp, err := edgesAPI.GetTelephonyProvidersEdgesSiteNumberplans(siteId)
+		if err != nil {
+			if IsStatus404(resp) {
+				continue
+			}
+			return nil, diag.Errorf("Failed to get number plans for site %s: %s", siteId, err)
+		}
+		for _, numberPlan := range numberPlans {
+			// Default plans are created with the site
+			if isDefaultPlan(*numberPlan.Name) {
+				continue
+			}
+			resources[*numberPlan.Id] = &resourceExporter.ResourceMeta{
+				Name:     site.Name + "_" + *numberPlan.Name,
+				IdPrefix: siteId + "/",
+			}
+		}
+	}
+	return resources, nil
+}
+
+func SiteNumberPlanExporter() *resourceExporter.ResourceExporter {
+	return &resourceExporter.ResourceExporter{
+		GetResourcesFunc: GetAllWithPooledClient(getAllSiteNumberPlans),
+		RefAttrs: map[string]*resourceExporter.RefAttrSettings{
+			"site_id": {RefType: "genesyscloud_telephony_providers_edges_site"},
+		},
+	}
+}
+
+func createSiteNumberPlan(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	siteId := d.Get("site_id").(string)
+	numberPlan := buildSiteNumberPlanFromResourceData(d)
+
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
+
+	log.Printf("Creating number plan %s for site %s", *numberPlan.Name, siteId)
+	numberPlanId, diagErr := putSiteNumberPlan(siteId, "", numberPlan, edgesAPI)
+	if diagErr != nil {
+		return diagErr
+	}
+	d.SetId(numberPlanId)
+
+	log.Printf("Created number plan %s for site %s", d.Id(), siteId)
+	return readSiteNumberPlan(ctx, d, meta)
+}
+
+func readSiteNumberPlan(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	siteId := d.Get("site_id").(string)
+
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
+
+	log.Printf("Reading number plan %s of site %s", d.Id(), siteId)
+	return WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		numberPlan, resp, getErr := edgesAPI.GetTelephonyProvidersEdgesSiteNumberplan(siteId, d.Id())
+		if getErr != nil {
+			if IsStatus404(resp) {
+				return retry.RetryableError(fmt.Errorf("Failed to read number plan %s of site %s: %s", d.Id(), siteId, getErr))
+			}
+			return retry.NonRetryableError(fmt.Errorf("Failed to read number plan %s of site %s: %s", d.Id(), siteId, getErr))
+		}
+
+		cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, ResourceSiteNumberPlan())
+		for key, value := range flattenSdkSiteNumberPlan(*numberPlan) {
+			d.Set(key, value)
+		}
+		d.Set("priority", nil)
+		if numberPlan.Priority != nil {
+			d.Set("priority", *numberPlan.Priority)
+		}
+
+		log.Printf("Read number plan %s of site %s", d.Id(), siteId)
+		return cc.CheckState()
+	})
+}
+
+func updateSiteNumberPlan(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	siteId := d.Get("site_id").(string)
+	numberPlan := buildSiteNumberPlanFromResourceData(d)
+
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
+
+	log.Printf("Updating number plan %s of site %s", d.Id(), siteId)
+	if _, diagErr := putSiteNumberPlan(siteId, d.Id(), numberPlan, edgesAPI); diagErr != nil {
+		return diagErr
+	}
+
+	log.Printf("Updated number plan %s of site %s", d.Id(), siteId)
+	return readSiteNumberPlan(ctx, d, meta)
+}
+
+func deleteSiteNumberPlan(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	siteId := d.Get("site_id").(string)
+
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
+
+	lock := lockSiteNumberPlans(siteId)
+	defer lock.Unlock()
+
+	log.Printf("Deleting number plan %s of site %s", d.Id(), siteId)
+	return RetryWhen(IsStatus400, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+		numberPlans, resp, err := edgesAPI.GetTelephonyProvidersEdgesSiteNumberplans(siteId)
+		if err != nil {
+			if IsStatus404(resp) {
+				// Site doesn't exist
+				return nil, nil
+			}
+			return resp, diag.Errorf("Failed to get number plans for site %s: %s", siteId, err)
+		}
+
+		updatedNumberPlans := make([]platformclientv2.Numberplan, 0)
+		for _, numberPlan := range numberPlans {
+			if numberPlan.Id != nil && *numberPlan.Id == d.Id() {
+				// Default plans can't be removed from a site
+				if isDefaultPlan(*numberPlan.Name) {
+					log.Printf("Number plan %s of site %s is a default plan and is not removed", d.Id(), siteId)
+					return nil, nil
+				}
+				continue
+			}
+			updatedNumberPlans = append(updatedNumberPlans, numberPlan)
+		}
+
+		_, resp, err = edgesAPI.PutTelephonyProvidersEdgesSiteNumberplans(siteId, updatedNumberPlans)
+		if err != nil {
+			return resp, diag.Errorf("Failed to delete number plan %s of site %s: %s", d.Id(), siteId, err)
+		}
+		log.Printf("Deleted number plan %s of site %s", d.Id(), siteId)
+		return resp, nil
+	})
+}
+
+// putSiteNumberPlan adds or replaces one number plan of a site, keeping the other plans of the site. A plan is
+// replaced if it has the ID, or if no ID is given and it has the same name. Returns the ID of the plan.
+func putSiteNumberPlan(siteId string, numberPlanId string, numberPlan platformclientv2.Numberplan, edgesAPI *platformclientv2.TelephonyProvidersEdgeApi) (string, diag.Diagnostics) {
+	lock := lockSiteNumberPlans(siteId)
+	defer lock.Unlock()
+
+	diagErr := RetryWhen(IsStatus400, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+		numberPlans, resp, err := edgesAPI.GetTelephonyProvidersEdgesSiteNumberplans(siteId)
+		if err != nil {
+			return resp, diag.Errorf("Failed to get number plans for site %s: %s", siteId, err)
+		}
+
+		updatedNumberPlans := make([]platformclientv2.Numberplan, 0, len(numberPlans)+1)
+		replaced := false
+		for _, existingPlan := range numberPlans {
+			if (numberPlanId != "" && existingPlan.Id != nil && *existingPlan.Id == numberPlanId) ||
+				(numberPlanId == "" && existingPlan.Name != nil && *existingPlan.Name == *numberPlan.Name) {
+				plan := existingPlan
+				plan.Name = numberPlan.Name
+				plan.Classification = numberPlan.Classification
+				plan.Numbers = numberPlan.Numbers
+				plan.DigitLength = numberPlan.DigitLength
+				plan.Match = numberPlan.Match
+				plan.MatchType = numberPlan.MatchType
+				plan.NormalizedFormat = numberPlan.NormalizedFormat
+				if numberPlan.Priority != nil {
+					plan.Priority = numberPlan.Priority
+				}
+				updatedNumberPlans = append(updatedNumberPlans, plan)
+				replaced = true
+				continue
+			}
+			updatedNumberPlans = append(updatedNumberPlans, existingPlan)
+		}
+		if !replaced {
+			if numberPlanId != "" {
+				return nil, diag.Errorf("Number plan %s of site %s no longer exists", numberPlanId, siteId)
+			}
+			updatedNumberPlans = append(updatedNumberPlans, numberPlan)
+		}
+		sortSiteNumberPlans(updatedNumberPlans)
+
+		numberPlans, resp, err = edgesAPI.PutTelephonyProvidersEdgesSiteNumberplans(siteId, updatedNumberPlans)
+		if err != nil {
+			return resp, diag.Errorf("Failed to update number plans for site %s: %s", siteId, err)
+		}
+		if plan, ok := nameInPlans(*numberPlan.Name, numberPlans); ok && plan.Id != nil {
+			numberPlanId = *plan.Id
+		}
+		return resp, nil
+	})
+	if diagErr != nil {
+		return "", diagErr
+	}
+	if numberPlanId == "" {
+		return "", diag.Errorf("Number plan %s was not added to site %s", *numberPlan.Name, siteId)
+	}
+	return numberPlanId, nil
+}
+
+// sortSiteNumberPlans orders number plans by priority. Plans without a priority keep their position after the plans
+// with one.
+func sortSiteNumberPlans(numberPlans []platformclientv2.Numberplan) {
+	sort.SliceStable(numberPlans, func(i, j int) bool {
+		return numberPlanPriority(numberPlans[i]) < numberPlanPriority(numberPlans[j])
+	})
+}
+
+func lockSiteNumberPlans(siteId string) *sync.Mutex {
+	lock, _ := siteNumberPlanLocks.LoadOrStore(siteId, &sync.Mutex{})
+	mutex := lock.(*sync.Mutex)
+	mutex.Lock()
+	return mutex
+}
+
+func buildSiteNumberPlanFromResourceData(d *schema.ResourceData) platformclientv2.Numberplan {
+	numberPlan := buildSdkSiteNumberPlan(siteItemMap(d, siteNumberPlanSchema()))
+	if priority, ok := d.GetOk("priority"); ok && d.HasChange("priority") {
+		priorityInt := priority.(int)
+		numberPlan.Priority = &priorityInt
+	}
+	return numberPlan
+}
+
+func buildSdkSiteNumberPlan(npMap map[string]interface{}) platformclientv2.Numberplan {
+	numberPlan := platformclientv2.Numberplan{}
+
+	if name := npMap["name"].(string); name != "" {
+		numberPlan.Name = &name
+	}
+	if matchType := npMap["match_type"].(string); matchType != "" {
+		numberPlan.MatchType = &matchType
+	}
+	if matchFormat := npMap["match_format"].(string); matchFormat != "" {
+		numberPlan.Match = &matchFormat
+	}
+	if normalizedFormat := npMap["normalized_format"].(string); normalizedFormat != "" {
+		numberPlan.NormalizedFormat = &normalizedFormat
+	}
+	if classification := npMap["classification"].(string); classification != "" {
+		numberPlan.Classification = &classification
+	}
+	if numbers, ok := npMap["numbers"].([]interface{}); ok && len(numbers) > 0 {
+		sdkNumbers := make([]platformclientv2.Number, 0)
+		for _, number := range numbers {
+			numberMap := number.(map[string]interface{})
+			sdkNumber := platformclientv2.Number{}
+			if start, ok := numberMap["start"].(string); ok {
+				sdkNumber.Start = &start
+			}
+			if end, ok := numberMap["end"].(string); ok {
+				sdkNumber.End = &end
+			}
+			sdkNumbers = append(sdkNumbers, sdkNumber)
+		}
+		numberPlan.Numbers = &sdkNumbers
+	}
+	if digitLength, ok := npMap["digit_length"].([]interface{}); ok && len(digitLength) > 0 {
+		sdkDigitlengthMap := digitLength[0].(map[string]interface{})
+		sdkDigitlength := platformclientv2.Digitlength{}
+		if start, ok := sdkDigitlengthMap["start"].(string); ok {
+			sdkDigitlength.Start = &start
+		}
+		if end, ok := sdkDigitlengthMap["end"].(string); ok {
+			sdkDigitlength.End = &end
+		}
+		numberPlan.DigitLength = &sdkDigitlength
+	}
+	return numberPlan
+}
+
+func flattenSdkSiteNumberPlan(numberPlan platformclientv2.Numberplan) map[string]interface{} {
+	npMap := map[string]interface{}{
+		"name":              *numberPlan.Name,
+		"match_format":      nil,
+		"normalized_format": nil,
+		"classification":    nil,
+		"match_type":        nil,
+		"numbers":           nil,
+		"digit_length":      nil,
+	}
+	if numberPlan.Match != nil {
+		npMap["match_format"] = *numberPlan.Match
+	}
+	if numberPlan.NormalizedFormat != nil {
+		npMap["normalized_format"] = *numberPlan.NormalizedFormat
+	}
+	if numberPlan.Classification != nil {
+		npMap["classification"] = *numberPlan.Classification
+	}
+	if numberPlan.MatchType != nil {
+		npMap["match_type"] = *numberPlan.MatchType
+	}
+	if numberPlan.Numbers != nil {
+		numbers := make([]interface{}, 0)
+		for _, number := range *numberPlan.Numbers {
+			numberMap := make(map[string]interface{})
+			if number.Start != nil {
+				numberMap["start"] = *number.Start
+			}
+			if number.End != nil {
+				numberMap["end"] = *number.End
+			}
+			numbers = append(numbers, numberMap)
+		}
+		npMap["numbers"] = numbers
+	}
+	if numberPlan.DigitLength != nil {
+		digitLengthMap := make(map[string]interface{})
+		if numberPlan.DigitLength.Start != nil {
+			digitLengthMap["start"] = *numberPlan.DigitLength.Start
+		}
+		if numberPlan.DigitLength.End != nil {
+			digitLengthMap["end"] = *numberPlan.DigitLength.End
+		}
+		npMap["digit_length"] = []interface{}{digitLengthMap}
+	}
+	return npMap
+}
@@ -0,0 +1,190 @@
+package genesyscloud
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v109/platformclientv2"
+)
+
+func TestAccResourceSiteNumberPlan(t *testing.T) {
+	t.Parallel()
+	var (
+		siteRes         = "site"
+		numberPlanRes   = "number-plan"
+		name            = "site " + uuid.NewString()
+		locationRes     = "test-location1"
+		emergencyNumber = "+13173124747"
+	)
+
+	_, err := AuthorizeSdk()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = DeleteLocationWithNumber(emergencyNumber); err != nil {
+		t.Fatal(err)
+	}
+
+	location := GenerateLocationResource(
+		locationRes,
+		"Terraform location"+uuid.NewString(),
+		"HQ1",
+		[]string{},
+		GenerateLocationEmergencyNum(
+			emergencyNumber,
+			nullValue, // Default number type
+		), GenerateLocationAddress(
+			"7601 Interactive Way",
+			"Indianapolis",
+			"IN",
+			"US",
+			"46278",
+		))
+
+	// The site manages one plan and the standalone resource another
+	site := GenerateSiteResourceWithCustomAttrs(
+		siteRes,
+		name,
+		"test site description",
+		"genesyscloud_location."+locationRes+".id",
+		"Cloud",
+		false,
+		"[\"us-west-2\"]",
+		strconv.Quote("+19205551212"),
+		strconv.Quote("Wilco plumbing"),
+		generateSiteNumberPlansWithCustomAttrs(
+			"Site plan",
+			"site",
+			`^\\*([0-9]{3})$`,
+			"regex",
+			"+1317555$1",
+		),
+	) + location
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				Config: site + generateSiteNumberPlanResource(
+					numberPlanRes,
+					"genesyscloud_telephony_providers_edges_site."+siteRes+".id",
+					"Carrier plan",
+					"carrier",
+					"numberList",
+					generateSiteNumberPlansNumber("114", "115"),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_site_number_plan."+numberPlanRes, "name", "Carrier plan"),
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_site_number_plan."+numberPlanRes, "classification", "carrier"),
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_site_number_plan."+numberPlanRes, "numbers.0.start", "114"),
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_site_number_plan."+numberPlanRes, "numbers.0.end", "115"),
+					resource.TestCheckResourceAttrSet("genesyscloud_telephony_providers_edges_site_number_plan."+numberPlanRes, "priority"),
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_site."+siteRes, "number_plans.#", "1"),
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_site."+siteRes, "number_plans.0.name", "Site plan"),
+				),
+			},
+			{
+				// Update the standalone plan
+				Config: site + generateSiteNumberPlanResource(
+					numberPlanRes,
+					"genesyscloud_telephony_providers_edges_site."+siteRes+".id",
+					"Carrier plan",
+					"carrier",
+					"digitLength",
+					generateSiteNumberPlansDigitLength("4", "6"),
+					"priority = 0",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_site_number_plan."+numberPlanRes, "match_type", "digitLength"),
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_site_number_plan."+numberPlanRes, "digit_length.0.start", "4"),
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_site_number_plan."+numberPlanRes, "digit_length.0.end", "6"),
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_site_number_plan."+numberPlanRes, "numbers.#", "0"),
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_site_number_plan."+numberPlanRes, "priority", "0"),
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_site."+siteRes, "number_plans.#", "1"),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:      "genesyscloud_telephony_providers_edges_site_number_plan." + numberPlanRes,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: importSiteItemId("genesyscloud_telephony_providers_edges_site_number_plan." + numberPlanRes),
+			},
+		},
+		CheckDestroy: testVerifySitesDestroyed,
+	})
+}
+
+func TestSortSiteNumberPlans(t *testing.T) {
+	numberPlans := []platformclientv2.Numberplan{
+		{Name: platformclientv2.String("no priority 1")},
+		{Name: platformclientv2.String("priority 5"), Priority: platformclientv2.Int(5)},
+		{Name: platformclientv2.String("no priority 2")},
+		{Name: platformclientv2.String("priority 0"), Priority: platformclientv2.Int(0)},
+	}
+	sortSiteNumberPlans(numberPlans)
+
+	names := make([]string, 0)
+	for _, numberPlan := range numberPlans {
+		names = append(names, *numberPlan.Name)
+	}
+	expected := []string{"priority 0", "priority 5", "no priority 1", "no priority 2"}
+	if !reflect.DeepEqual(names, expected) {
+		t.Errorf("expected %v, got %v", expected, names)
+	}
+}
+
+func TestSiteNumberPlanFlatten(t *testing.T) {
+	numberPlan := platformclientv2.Numberplan{
+		Name:           platformclientv2.String("Carrier plan"),
+		MatchType:      platformclientv2.String("numberList"),
+		Classification: platformclientv2.String("carrier"),
+		Numbers:        &[]platformclientv2.Number{{Start: platformclientv2.String("114"), End: platformclientv2.String("115")}},
+	}
+
+	// The standalone resource builds the same plan as an item of a site
+	d := schema.TestResourceDataRaw(t, ResourceSiteNumberPlan().Schema, map[string]interface{}{
+		"site_id":        "site-1",
+		"name":           "Carrier plan",
+		"match_type":     "numberList",
+		"classification": "carrier",
+		"numbers":        []interface{}{map[string]interface{}{"start": "114", "end": "115"}},
+	})
+	built := buildSiteNumberPlanFromResourceData(d)
+	if !reflect.DeepEqual(built, numberPlan) {
+		t.Errorf("expected %v, got %v", numberPlan, built)
+	}
+
+	for key, value := range flattenSdkSiteNumberPlan(numberPlan) {
+		if err := d.Set(key, value); err != nil {
+			t.Fatalf("failed to set %s: %v", key, err)
+		}
+	}
+	if d.Get("numbers.0.end").(string) != "115" || d.Get("digit_length.#").(int) != 0 {
+		t.Errorf("unexpected flattened number plan %v", d.State())
+	}
+}
+
+func generateSiteNumberPlanResource(
+	resourceID string,
+	siteID string,
+	name string,
+	classification string,
+	matchType string,
+	otherAttrs ...string) string {
+	return fmt.Sprintf(`resource "genesyscloud_telephony_providers_edges_site_number_plan" "%s" {
+		site_id        = %s
+		name           = "%s"
+		classification = "%s"
+		match_type     = "%s"
+		%s
+	}
+	`, resourceID, siteID, name, classification, matchType, strings.Join(otherAttrs, "\n"))
+}
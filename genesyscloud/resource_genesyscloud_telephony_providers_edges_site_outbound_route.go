@@ -0,0 +1,327 @@
+package genesyscloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	lists "terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mypurecloud/platform-client-sdk-go/v109/platformclientv2"
+)
+
+// siteOutboundRouteSchema is the schema of an outbound route, shared by the outbound_routes of a site and the
+// standalone outbound route resource
+func siteOutboundRouteSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Description: "The name of the entity.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"description": {
+			Description: "The resource's description.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"classification_types": {
+			Description: "Used to classify this outbound route.",
+			Type:        schema.TypeList,
+			Required:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"enabled": {
+			Description: "Enable or disable the outbound route",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"distribution": {
+			Description:  "Valid values: SEQUENTIAL, RANDOM.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "SEQUENTIAL",
+			ValidateFunc: validation.StringInSlice([]string{"SEQUENTIAL", "RANDOM"}, false),
+		},
+		"external_trunk_base_ids": {
+			Description: "Trunk base settings of trunkType \"EXTERNAL\". This base must also be set on an edge logical interface for correct routing. The order of the IDs determines the distribution if \"distribution\" is set to \"SEQUENTIAL\"",
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+	}
+}
+
+func ResourceSiteOutboundRoute() *schema.Resource {
+	outboundRouteSchema := siteOutboundRouteSchema()
+	outboundRouteSchema["site_id"] = &schema.Schema{
+		Description: "ID of the site. Changing the site_id attribute will cause the outbound route to be dropped and recreated with a new ID.",
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+	}
+
+	return &schema.Resource{
+		Description: `Genesys Cloud Site Outbound Route. Manages one outbound route of a site, independently of the site.
+
+Outbound routes managed by this resource must not also be listed in the ` + "`outbound_routes`" + ` of the site. If the site already has a route with the same name, such as the default outbound route, the route is adopted.`,
+
+		CreateContext: CreateWithPooledClient(createSiteOutboundRoute),
+		ReadContext:   ReadWithPooledClient(readSiteOutboundRoute),
+		UpdateContext: UpdateWithPooledClient(updateSiteOutboundRoute),
+		DeleteContext: DeleteWithPooledClient(deleteSiteOutboundRoute),
+		Importer: &schema.ResourceImporter{
+			StateContext: importSiteItem,
+		},
+		SchemaVersion: 1,
+		Schema:        outboundRouteSchema,
+	}
+}
+
+func getAllSiteOutboundRoutes(ctx context.Context, sdkConfig *platformclientv2.Configuration) (resourceExporter.ResourceIDMetaMap, diag.Diagnostics) {
+	resources := make(resourceExporter.ResourceIDMetaMap)
+	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
+
+	sites, diagErr := getSites(ctx, sdkConfig)
+	if diagErr != nil {
+		return nil, diagErr
+	}
+	for siteId, site := range sites {
+		outboundRoutes, err := getSiteOutboundRoutes(siteId, edgesAPI)
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		for _, outboundRoute := range outboundRoutes {
+			if outboundRoute.State != nil && *outboundRoute.State == "deleted" {
+				continue
+			}
+			resources[*outboundRoute.Id] = &resourceExporter.ResourceMeta{
+				Name:     site.Name + "_" + *outboundRoute.Name,
+				IdPrefix: siteId + "/",
+			}
+		}
+	}
+	return resources, nil
+}
+
+func SiteOutboundRouteExporter() *resourceExporter.ResourceExporter {
+	return &resourceExporter.ResourceExporter{
+		GetResourcesFunc: GetAllWithPooledClient(getAllSiteOutboundRoutes),
+		RefAttrs: map[string]*resourceExporter.RefAttrSettings{
+			"site_id":                 {RefType: "genesyscloud_telephony_providers_edges_site"},
+			"external_trunk_base_ids": {RefType: "genesyscloud_telephony_providers_edges_trunkbasesettings"},
+		},
+	}
+}
+
+// importSiteItem imports an item of a site with an ID of the form <site ID>/<item ID>
+func importSiteItem(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Invalid import ID %s. Expected <site ID>/<ID>", d.Id())
+	}
+	d.Set("site_id", idParts[0])
+	d.SetId(idParts[1])
+	return []*schema.ResourceData{d}, nil
+}
+
+func createSiteOutboundRoute(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	siteId := d.Get("site_id").(string)
+	outboundRoute := buildSdkSiteOutboundRoute(siteItemMap(d, siteOutboundRouteSchema()))
+
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
+
+	// The default outbound route of a new site may not be assigned yet
+	diagErr := WithRetries(ctx, 60*time.Second, func() *retry.RetryError {
+		outboundRoutes, err := getSiteOutboundRoutes(siteId, edgesAPI)
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		if existingRoute, ok := nameInOutboundRoutes(*outboundRoute.Name, outboundRoutes); ok {
+			log.Printf("Adopting outbound route %s of site %s", *outboundRoute.Name, siteId)
+			outboundRoute.Version = existingRoute.Version
+			_, _, err := edgesAPI.PutTelephonyProvidersEdgesSiteOutboundroute(siteId, *existingRoute.Id, outboundRoute)
+			if err != nil {
+				return retry.RetryableError(fmt.Errorf("Failed to update outbound route %s of site %s: %s", *outboundRoute.Name, siteId, err))
+			}
+			d.SetId(*existingRoute.Id)
+			return nil
+		}
+
+		log.Printf("Creating outbound route %s for site %s", *outboundRoute.Name, siteId)
+		createdRoute, _, err := edgesAPI.PostTelephonyProvidersEdgesSiteOutboundroutes(siteId, outboundRoute)
+		if err != nil {
+			return retry.RetryableError(fmt.Errorf("Failed to create outbound route %s for site %s: %s", *outboundRoute.Name, siteId, err))
+		}
+		d.SetId(*createdRoute.Id)
+		return nil
+	})
+	if diagErr != nil {
+		return diagErr
+	}
+
+	log.Printf("Created outbound route %s for site %s", d.Id(), siteId)
+	return readSiteOutboundRoute(ctx, d, meta)
+}
+
+func readSiteOutboundRoute(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	siteId := d.Get("site_id").(string)
+
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
+
+	log.Printf("Reading outbound route %s of site %s", d.Id(), siteId)
+	return WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		outboundRoute, resp, getErr := edgesAPI.GetTelephonyProvidersEdgesSiteOutboundroute(siteId, d.Id())
+		if getErr != nil {
+			if IsStatus404(resp) {
+				return retry.RetryableError(fmt.Errorf("Failed to read outbound route %s of site %s: %s", d.Id(), siteId, getErr))
+			}
+			return retry.NonRetryableError(fmt.Errorf("Failed to read outbound route %s of site %s: %s", d.Id(), siteId, getErr))
+		}
+		if outboundRoute.State != nil && *outboundRoute.State == "deleted" {
+			d.SetId("")
+			return nil
+		}
+
+		cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, ResourceSiteOutboundRoute())
+		for key, value := range flattenSdkSiteOutboundRoute(*outboundRoute) {
+			d.Set(key, value)
+		}
+
+		log.Printf("Read outbound route %s of site %s", d.Id(), siteId)
+		return cc.CheckState()
+	})
+}
+
+func updateSiteOutboundRoute(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	siteId := d.Get("site_id").(string)
+	outboundRoute := buildSdkSiteOutboundRoute(siteItemMap(d, siteOutboundRouteSchema()))
+
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
+
+	diagErr := RetryWhen(IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+		currentRoute, resp, getErr := edgesAPI.GetTelephonyProvidersEdgesSiteOutboundroute(siteId, d.Id())
+		if getErr != nil {
+			return resp, diag.Errorf("Failed to read outbound route %s of site %s: %s", d.Id(), siteId, getErr)
+		}
+		outboundRoute.Version = currentRoute.Version
+
+		log.Printf("Updating outbound route %s of site %s", d.Id(), siteId)
+		_, resp, err := edgesAPI.PutTelephonyProvidersEdgesSiteOutboundroute(siteId, d.Id(), outboundRoute)
+		if err != nil {
+			return resp, diag.Errorf("Failed to update outbound route %s of site %s: %s", d.Id(), siteId, err)
+		}
+		return resp, nil
+	})
+	if diagErr != nil {
+		return diagErr
+	}
+
+	log.Printf("Updated outbound route %s of site %s", d.Id(), siteId)
+	return readSiteOutboundRoute(ctx, d, meta)
+}
+
+func deleteSiteOutboundRoute(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	siteId := d.Get("site_id").(string)
+
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
+
+	log.Printf("Deleting outbound route %s of site %s", d.Id(), siteId)
+	resp, err := edgesAPI.DeleteTelephonyProvidersEdgesSiteOutboundroute(siteId, d.Id())
+	if err != nil {
+		if IsStatus404(resp) {
+			return nil
+		}
+		return diag.Errorf("Failed to delete outbound route %s of site %s: %s", d.Id(), siteId, err)
+	}
+
+	return WithRetries(ctx, 30*time.Second, func() *retry.RetryError {
+		outboundRoute, resp, err := edgesAPI.GetTelephonyProvidersEdgesSiteOutboundroute(siteId, d.Id())
+		if err != nil {
+			if IsStatus404(resp) {
+				log.Printf("Deleted outbound route %s of site %s", d.Id(), siteId)
+				return nil
+			}
+			return retry.NonRetryableError(fmt.Errorf("Error deleting outbound route %s of site %s: %s", d.Id(), siteId, err))
+		}
+		if outboundRoute.State != nil && *outboundRoute.State == "deleted" {
+			log.Printf("Deleted outbound route %s of site %s", d.Id(), siteId)
+			return nil
+		}
+		return retry.RetryableError(fmt.Errorf("Outbound route %s of site %s still exists", d.Id(), siteId))
+	})
+}
+
+func buildSdkSiteOutboundRoute(orMap map[string]interface{}) platformclientv2.Outboundroutebase {
+	outboundRoute := platformclientv2.Outboundroutebase{}
+
+	if name := orMap["name"].(string); name != "" {
+		outboundRoute.Name = &name
+	}
+	if description := orMap["description"].(string); description != "" {
+		outboundRoute.Description = &description
+	}
+	if classificationTypes, ok := orMap["classification_types"].([]interface{}); ok && len(classificationTypes) > 0 {
+		cts := lists.InterfaceListToStrings(classificationTypes)
+		outboundRoute.ClassificationTypes = &cts
+	}
+	if enabled, ok := orMap["enabled"].(bool); ok {
+		outboundRoute.Enabled = &enabled
+	}
+	if distribution := orMap["distribution"].(string); distribution != "" {
+		outboundRoute.Distribution = &distribution
+	}
+	if externalTrunkBaseIds, ok := orMap["external_trunk_base_ids"].([]interface{}); ok && len(externalTrunkBaseIds) > 0 {
+		ids := make([]platformclientv2.Domainentityref, 0)
+		for _, externalTrunkBaseId := range externalTrunkBaseIds {
+			externalTrunkBaseIdStr := externalTrunkBaseId.(string)
+			ids = append(ids, platformclientv2.Domainentityref{Id: &externalTrunkBaseIdStr})
+		}
+		outboundRoute.ExternalTrunkBases = &ids
+	}
+	return outboundRoute
+}
+
+func flattenSdkSiteOutboundRoute(outboundRoute platformclientv2.Outboundroutebase) map[string]interface{} {
+	orMap := map[string]interface{}{
+		"name":                    *outboundRoute.Name,
+		"description":             nil,
+		"classification_types":    nil,
+		"enabled":                 nil,
+		"distribution":            nil,
+		"external_trunk_base_ids": nil,
+	}
+	if outboundRoute.Description != nil {
+		orMap["description"] = *outboundRoute.Description
+	}
+	if outboundRoute.ClassificationTypes != nil {
+		orMap["classification_types"] = *outboundRoute.ClassificationTypes
+	}
+	if outboundRoute.Enabled != nil {
+		orMap["enabled"] = *outboundRoute.Enabled
+	}
+	if outboundRoute.Distribution != nil {
+		orMap["distribution"] = *outboundRoute.Distribution
+	}
+	if outboundRoute.ExternalTrunkBases != nil && len(*outboundRoute.ExternalTrunkBases) > 0 {
+		externalTrunkBaseIds := make([]string, 0)
+		for _, externalTrunkBase := range *outboundRoute.ExternalTrunkBases {
+			externalTrunkBaseIds = append(externalTrunkBaseIds, *externalTrunkBase.Id)
+		}
+		orMap["external_trunk_base_ids"] = externalTrunkBaseIds
+	}
+	return orMap
+}
@@ -0,0 +1,195 @@
+package genesyscloud
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v109/platformclientv2"
+)
+
+func TestAccResourceSiteOutboundRoute(t *testing.T) {
+	t.Parallel()
+	var (
+		siteRes          = "site"
+		outboundRouteRes = "outbound-route"
+		name             = "site " + uuid.NewString()
+		locationRes      = "test-location1"
+		emergencyNumber  = "+13173124746"
+	)
+
+	_, err := AuthorizeSdk()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = DeleteLocationWithNumber(emergencyNumber); err != nil {
+		t.Fatal(err)
+	}
+
+	location := GenerateLocationResource(
+		locationRes,
+		"Terraform location"+uuid.NewString(),
+		"HQ1",
+		[]string{},
+		GenerateLocationEmergencyNum(
+			emergencyNumber,
+			nullValue, // Default number type
+		), GenerateLocationAddress(
+			"7601 Interactive Way",
+			"Indianapolis",
+			"IN",
+			"US",
+			"46278",
+		))
+
+	trunkBaseSettings := generateTrunkBaseSettingsResourceWithCustomAttrs(
+		"trunkBaseSettings",
+		"test trunk base settings "+uuid.NewString(),
+		"test description",
+		"external_sip.json",
+		"EXTERNAL",
+		false)
+
+	// The site manages one route and the standalone resource another
+	site := GenerateSiteResourceWithCustomAttrs(
+		siteRes,
+		name,
+		"test site description",
+		"genesyscloud_location."+locationRes+".id",
+		"Cloud",
+		false,
+		"[\"us-west-2\"]",
+		strconv.Quote("+19205551212"),
+		strconv.Quote("Wilco plumbing"),
+		generateSiteOutboundRoutesWithCustomAttrs(
+			"site route",
+			"managed by the site",
+			strconv.Quote("National"),
+			"",
+			"SEQUENTIAL",
+			false),
+	) + location + trunkBaseSettings
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				Config: site + generateSiteOutboundRouteResource(
+					outboundRouteRes,
+					"genesyscloud_telephony_providers_edges_site."+siteRes+".id",
+					"carrier route",
+					"managed by the carrier team",
+					strconv.Quote("International"),
+					"genesyscloud_telephony_providers_edges_trunkbasesettings.trunkBaseSettings.id",
+					"RANDOM",
+					false,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_site_outbound_route."+outboundRouteRes, "name", "carrier route"),
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_site_outbound_route."+outboundRouteRes, "classification_types.0", "International"),
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_site_outbound_route."+outboundRouteRes, "distribution", "RANDOM"),
+					resource.TestCheckResourceAttrPair("genesyscloud_telephony_providers_edges_site_outbound_route."+outboundRouteRes, "external_trunk_base_ids.0", "genesyscloud_telephony_providers_edges_trunkbasesettings.trunkBaseSettings", "id"),
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_site."+siteRes, "outbound_routes.#", "1"),
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_site."+siteRes, "outbound_routes.0.name", "site route"),
+				),
+			},
+			{
+				// Update the standalone route
+				Config: site + generateSiteOutboundRouteResource(
+					outboundRouteRes,
+					"genesyscloud_telephony_providers_edges_site."+siteRes+".id",
+					"carrier route",
+					"updated by the carrier team",
+					strconv.Quote("International")+", "+strconv.Quote("Network"),
+					"genesyscloud_telephony_providers_edges_trunkbasesettings.trunkBaseSettings.id",
+					"SEQUENTIAL",
+					true,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_site_outbound_route."+outboundRouteRes, "description", "updated by the carrier team"),
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_site_outbound_route."+outboundRouteRes, "classification_types.1", "Network"),
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_site_outbound_route."+outboundRouteRes, "enabled", trueValue),
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_site."+siteRes, "outbound_routes.#", "1"),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:      "genesyscloud_telephony_providers_edges_site_outbound_route." + outboundRouteRes,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: importSiteItemId("genesyscloud_telephony_providers_edges_site_outbound_route." + outboundRouteRes),
+			},
+		},
+		CheckDestroy: testVerifySitesDestroyed,
+	})
+}
+
+func TestSiteOutboundRouteFlatten(t *testing.T) {
+	outboundRoute := platformclientv2.Outboundroutebase{
+		Name:                platformclientv2.String("carrier route"),
+		ClassificationTypes: &[]string{"International"},
+		Enabled:             platformclientv2.Bool(true),
+		Distribution:        platformclientv2.String("RANDOM"),
+		ExternalTrunkBases:  &[]platformclientv2.Domainentityref{{Id: platformclientv2.String("trunk-1")}},
+	}
+
+	// The standalone resource builds the same route as an item of a site
+	d := schema.TestResourceDataRaw(t, ResourceSiteOutboundRoute().Schema, map[string]interface{}{
+		"site_id":                 "site-1",
+		"name":                    "carrier route",
+		"classification_types":    []interface{}{"International"},
+		"enabled":                 true,
+		"distribution":            "RANDOM",
+		"external_trunk_base_ids": []interface{}{"trunk-1"},
+	})
+	built := buildSdkSiteOutboundRoute(siteItemMap(d, siteOutboundRouteSchema()))
+	if !reflect.DeepEqual(built, outboundRoute) {
+		t.Errorf("expected %v, got %v", outboundRoute, built)
+	}
+
+	for key, value := range flattenSdkSiteOutboundRoute(outboundRoute) {
+		if err := d.Set(key, value); err != nil {
+			t.Fatalf("failed to set %s: %v", key, err)
+		}
+	}
+	if d.Get("description").(string) != "" || d.Get("external_trunk_base_ids").([]interface{})[0] != "trunk-1" {
+		t.Errorf("unexpected flattened route %v", d.State())
+	}
+}
+
+func importSiteItemId(resourceName string) resource.ImportStateIdFunc {
+	return func(state *terraform.State) (string, error) {
+		itemRes, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Failed to find resource %s in state", resourceName)
+		}
+		return itemRes.Primary.Attributes["site_id"] + "/" + itemRes.Primary.ID, nil
+	}
+}
+
+func generateSiteOutboundRouteResource(
+	resourceID string,
+	siteID string,
+	name string,
+	description string,
+	classificationTypes string,
+	externalTrunkBaseIds string,
+	distribution string,
+	enabled bool) string {
+	return fmt.Sprintf(`resource "genesyscloud_telephony_providers_edges_site_outbound_route" "%s" {
+		site_id                 = %s
+		name                    = "%s"
+		description             = "%s"
+		classification_types    = [%s]
+		external_trunk_base_ids = [%s]
+		distribution            = "%s"
+		enabled                 = %v
+	}
+	`, resourceID, siteID, name, description, classificationTypes, externalTrunkBaseIds, distribution, enabled)
+}
@@ -127,6 +127,7 @@ func TestAccResourceSite(t *testing.T) {
 					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_site."+siteRes, "edge_auto_update_config.0.rrule", rrule),
 					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_site."+siteRes, "edge_auto_update_config.0.start", start1),
 					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_site."+siteRes, "edge_auto_update_config.0.end", end1),
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_site."+siteRes, "edge_auto_update_config.0.next_occurrences.#", "5"),
 				),
 			},
 			// Update the EdgeAutoUpdateConfig
@@ -151,6 +152,7 @@ func TestAccResourceSite(t *testing.T) {
 					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_site."+siteRes, "edge_auto_update_config.0.rrule", rrule),
 					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_site."+siteRes, "edge_auto_update_config.0.start", start2),
 					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_site."+siteRes, "edge_auto_update_config.0.end", end2),
+					resource.TestCheckResourceAttr("genesyscloud_telephony_providers_edges_site."+siteRes, "edge_auto_update_config.0.next_occurrences.#", "5"),
 				),
 			},
 		},
@@ -56,7 +56,7 @@ func ResourceTrunkBaseSettings() *schema.Resource {
 				Required:    true,
 			},
 			"properties": {
-				Description:      "trunk base settings properties",
+				Description:      "trunk base settings properties. Property names, value types and allowed values are validated at plan time against the JSON schema of the `trunk_meta_base_id`.",
 				Type:             schema.TypeString,
 				Optional:         true,
 				Computed:         true,
@@ -1,6 +1,7 @@
 package genesyscloud
 
 import (
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
@@ -96,6 +97,56 @@ func TestAccResourceTrunkBaseSettings(t *testing.T) {
 	})
 }
 
+func TestValidateBaseSettingsProperties(t *testing.T) {
+	metaBaseSchema := make(map[string]interface{})
+	err := json.Unmarshal([]byte(`{
+		"type": "object",
+		"properties": {
+			"trunk_label": {"type": "object", "properties": {"value": {"type": "object", "properties": {"instance": {"type": "string", "minLength": 1}}}}},
+			"trunk_transport_protocol": {"type": "object", "properties": {"value": {"type": "object", "properties": {"instance": {"type": "string", "enum": ["UDP", "TCP", "TLS"]}}}}},
+			"trunk_transport_sip_dscp_value": {"type": "object", "properties": {"value": {"type": "object", "properties": {"instance": {"type": "integer", "minimum": 0, "maximum": 63}}}}},
+			"trunk_media_codec": {"type": "object", "properties": {"value": {"type": "object", "properties": {"instance": {"type": "array", "items": {"type": "string", "enum": ["audio/pcmu", "audio/pcma"]}}}}}}
+		}
+	}`), &metaBaseSchema)
+	if err != nil {
+		t.Fatalf("failed to parse schema: %v", err)
+	}
+
+	valid := `{
+		"trunk_label": {"type": "object", "value": {"default": "", "instance": "Carrier"}},
+		"trunk_transport_sip_dscp_value": {"value": {"instance": 25}},
+		"trunk_media_codec": {"value": {"instance": ["audio/pcmu"]}}
+	}`
+	if err := validateBaseSettingsProperties(metaBaseSchema, valid); err != nil {
+		t.Errorf("expected valid properties, got %v", err)
+	}
+
+	invalid := `{
+		"trunk_lable": {"value": {"instance": "Carrier"}},
+		"trunk_transport_protocol": {"value": {"instance": "UDPX"}},
+		"trunk_transport_sip_dscp_value": {"value": {"instance": "25"}},
+		"trunk_media_codec": {"value": {"instance": ["audio/pcmu", "audio/opus"]}}
+	}`
+	err = validateBaseSettingsProperties(metaBaseSchema, invalid)
+	if err == nil {
+		t.Fatalf("expected invalid properties to fail")
+	}
+	for _, expected := range []string{
+		`properties.trunk_lable: unknown property. Did you mean "trunk_label"?`,
+		`properties.trunk_transport_protocol.value.instance: string "UDPX" is not one of the allowed values "UDP", "TCP", "TLS"`,
+		`properties.trunk_transport_sip_dscp_value.value.instance: expected integer, got string`,
+		`properties.trunk_media_codec.value.instance[1]: string "audio/opus" is not one of the allowed values "audio/pcmu", "audio/pcma"`,
+	} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf("expected %q in error %q", expected, err.Error())
+		}
+	}
+
+	if err := validateBaseSettingsProperties(metaBaseSchema, `{"trunk_transport_sip_dscp_value": {"value": {"instance": 64}}}`); err == nil || !strings.Contains(err.Error(), "integer 64 is greater than the maximum 63") {
+		t.Errorf("expected a value over the maximum to fail, got %v", err)
+	}
+}
+
 func testVerifyTrunkBaseSettingsDestroyed(state *terraform.State) error {
 	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApi()
 	for _, rs := range state.RootModule().Resources {
@@ -22,6 +22,12 @@ import (
 	"github.com/nyaruka/phonenumbers"
 )
 
+const (
+	userOnDestroyDelete     = "delete"
+	userOnDestroyDeactivate = "deactivate"
+	userOnDestroyRetain     = "retain"
+)
+
 var (
 	contactTypeEmail = "EMAIL"
 
@@ -211,7 +217,21 @@ func UserExporter() *resourceExporter.ResourceExporter {
 			"routing_languages": {"language_id"},
 			"locations":         {"location_id"},
 		},
-		AllowZeroValues: []string{"routing_skills.proficiency", "routing_languages.proficiency"},
+		AllowZeroValues: []string{
+			"routing_skills.proficiency",
+			"routing_languages.proficiency",
+			"routing_utilization.media_utilization.maximum_capacity",
+			"routing_utilization.label_utilizations.maximum_capacity",
+		},
+		// The media type blocks of routing_utilization are deprecated in favour of media_utilization
+		ExcludedAttributes: []string{
+			"deactivated",
+			"routing_utilization.call",
+			"routing_utilization.callback",
+			"routing_utilization.chat",
+			"routing_utilization.email",
+			"routing_utilization.message",
+		},
 	}
 }
 
@@ -226,6 +246,7 @@ func ResourceUser() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: customizeUserDiff,
 		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
 			"email": {
@@ -250,6 +271,28 @@ func ResourceUser() *schema.Resource {
 				Optional:     true,
 				Default:      "active",
 				ValidateFunc: validation.StringInSlice([]string{"active", "inactive"}, false),
+				// Users deactivated by deactivate_at stay inactive even though state is still configured as active
+				DiffSuppressFunc: func(_, old, new string, d *schema.ResourceData) bool {
+					return old == "inactive" && new == "active" && userDeactivateAtPassed(d)
+				},
+			},
+			"deactivate_at": {
+				Description:  "RFC 3339 timestamp (e.g. 2024-06-30T17:00:00Z) after which the user is moved to the inactive state. The user is deactivated on the first apply after this time.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"deactivated": {
+				Description: "True if the user has been moved to the inactive state because `deactivate_at` has passed.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"on_destroy": {
+				Description:  "What happens to the user when this resource is destroyed (delete | deactivate | retain). `deactivate` removes the user's routing skills, queue memberships and roles and moves the user to the inactive state, keeping the user and its history such as recordings. A user deactivated this way is reactivated when a resource with the same email is created again. Other inactive users with the same email, recognized by still having roles, are not adopted and must be imported. `retain` leaves the user unchanged.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      userOnDestroyDelete,
+				ValidateFunc: validation.StringInSlice([]string{userOnDestroyDelete, userOnDestroyDeactivate, userOnDestroyRetain}, false),
 			},
 			"division_id": {
 				Description: "The division to which this user will belong. If not set, the home division will be used.",
@@ -286,6 +329,12 @@ func ResourceUser() *schema.Resource {
 				ConfigMode:  schema.SchemaConfigModeAttr,
 				Elem:        userSkillResource,
 			},
+			"ignore_unmanaged_routing_skills": {
+				Description: "If true, skills that are not set in `routing_skills`, e.g. skills assigned by `genesyscloud_routing_skill_assignments`, are neither read nor removed.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
 			"routing_languages": {
 				Description: "Languages and proficiencies for this user. If not set, this resource will not manage user languages.",
 				Type:        schema.TypeSet,
@@ -385,53 +434,7 @@ func ResourceUser() *schema.Resource {
 				Computed:    true,
 				ConfigMode:  schema.SchemaConfigModeAttr,
 				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"call": {
-							Description: "Call media settings. If not set, this reverts to the default media type settings.",
-							Type:        schema.TypeList,
-							MaxItems:    1,
-							Optional:    true,
-							Computed:    true,
-							ConfigMode:  schema.SchemaConfigModeAttr,
-							Elem:        utilizationSettingsResource,
-						},
-						"callback": {
-							Description: "Callback media settings. If not set, this reverts to the default media type settings.",
-							Type:        schema.TypeList,
-							MaxItems:    1,
-							Optional:    true,
-							Computed:    true,
-							ConfigMode:  schema.SchemaConfigModeAttr,
-							Elem:        utilizationSettingsResource,
-						},
-						"message": {
-							Description: "Message media settings. If not set, this reverts to the default media type settings.",
-							Type:        schema.TypeList,
-							MaxItems:    1,
-							Optional:    true,
-							Computed:    true,
-							ConfigMode:  schema.SchemaConfigModeAttr,
-							Elem:        utilizationSettingsResource,
-						},
-						"email": {
-							Description: "Email media settings. If not set, this reverts to the default media type settings.",
-							Type:        schema.TypeList,
-							MaxItems:    1,
-							Optional:    true,
-							Computed:    true,
-							ConfigMode:  schema.SchemaConfigModeAttr,
-							Elem:        utilizationSettingsResource,
-						},
-						"chat": {
-							Description: "Chat media settings. If not set, this reverts to the default media type settings.",
-							Type:        schema.TypeList,
-							MaxItems:    1,
-							Optional:    true,
-							Computed:    true,
-							ConfigMode:  schema.SchemaConfigModeAttr,
-							Elem:        utilizationSettingsResource,
-						},
-					},
+					Schema: utilizationSettingsSchema(true),
 				},
 			},
 		},
@@ -442,7 +445,7 @@ func createUser(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 	email := d.Get("email").(string)
 	name := d.Get("name").(string)
 	password := d.Get("password").(string)
-	state := d.Get("state").(string)
+	state := getUserState(d)
 	divisionID := d.Get("division_id").(string)
 	department := d.Get("department").(string)
 	title := d.Get("title").(string)
@@ -487,6 +490,25 @@ func createUser(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 				d.SetId(*id)
 				return restoreDeletedUser(ctx, d, meta, usersAPI)
 			}
+
+			// Check for a user left inactive by on_destroy = "deactivate"
+			id, diagErr = getUserIdWithState(email, "inactive", usersAPI)
+			if diagErr != nil {
+				return diagErr
+			}
+			if id != nil {
+				// Deactivating removes every role, so inactive users that still have roles were deactivated some other way
+				grants, _, diagErr := getAssignedGrants(*id, platformclientv2.NewAuthorizationApiWithConfig(sdkConfig))
+				if diagErr != nil {
+					return diagErr
+				}
+				if len(grants) > 0 {
+					return diag.Errorf("Failed to create user %s: inactive user %s has this email but was not deactivated by on_destroy = \"deactivate\". Import the user to manage it", email, *id)
+				}
+				log.Printf("Reactivating inactive user %s", email)
+				d.SetId(*id)
+				return updateUser(ctx, d, meta)
+			}
 		}
 		return diag.Errorf("Failed to create user %s: %s", email, err)
 	}
@@ -564,11 +586,19 @@ func readUser(ctx context.Context, d *schema.ResourceData, meta interface{}) dia
 
 		cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, ResourceUser())
 
+		// Imported and exported users have no email yet. Their skills are all read.
+		ownedSkillIds := getOwnedUserSkillIds(d)
+
 		// Required attributes
 		d.Set("name", *currentUser.Name)
 		d.Set("email", *currentUser.Email)
 		d.Set("division_id", *currentUser.Division.Id)
 		d.Set("state", *currentUser.State)
+		d.Set("deactivated", *currentUser.State == "inactive" && userDeactivateAtPassed(d))
+		if d.Get("on_destroy").(string) == "" {
+			// Not set on import
+			d.Set("on_destroy", userOnDestroyDelete)
+		}
 
 		if currentUser.Department != nil {
 			d.Set("department", *currentUser.Department)
@@ -595,7 +625,7 @@ func readUser(ctx context.Context, d *schema.ResourceData, meta interface{}) dia
 		}
 
 		d.Set("addresses", flattenUserAddresses(d, currentUser.Addresses))
-		d.Set("routing_skills", flattenUserSkills(currentUser.Skills))
+		d.Set("routing_skills", flattenUserSkills(filterUserSkills(currentUser.Skills, ownedSkillIds)))
 		d.Set("routing_languages", flattenUserLanguages(currentUser.Languages))
 		d.Set("locations", flattenUserLocations(currentUser.Locations))
 		d.Set("profile_skills", flattenUserProfileSkills(currentUser.ProfileSkills))
@@ -614,7 +644,7 @@ func readUser(ctx context.Context, d *schema.ResourceData, meta interface{}) dia
 func updateUser(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	name := d.Get("name").(string)
 	email := d.Get("email").(string)
-	state := d.Get("state").(string)
+	state := getUserState(d)
 	department := d.Get("department").(string)
 	title := d.Get("title").(string)
 	manager := d.Get("manager").(string)
@@ -631,7 +661,7 @@ func updateUser(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 	log.Printf("Updating user %s", email)
 
 	// If state changes, it is the only modifiable field, so it must be updated separately
-	if d.HasChange("state") {
+	if d.HasChanges("state", "deactivated") {
 		log.Printf("Updating state for user %s", email)
 		patchErr := patchUser(d.Id(), platformclientv2.Updateuser{
 			State: &state,
@@ -692,6 +722,14 @@ func deleteUser(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 	sdkConfig := meta.(*ProviderMeta).ClientConfig
 	usersAPI := platformclientv2.NewUsersApiWithConfig(sdkConfig)
 
+	switch d.Get("on_destroy").(string) {
+	case userOnDestroyRetain:
+		log.Printf("Retaining user %s", email)
+		return nil
+	case userOnDestroyDeactivate:
+		return offboardUser(d, sdkConfig)
+	}
+
 	log.Printf("Deleting user %s", email)
 	err := RetryWhen(IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
 		// Directory occasionally returns version errors on deletes if an object was updated at the same time.
@@ -741,6 +779,10 @@ func patchUserWithState(id string, state string, update platformclientv2.Updateu
 }
 
 func getDeletedUserId(email string, usersAPI *platformclientv2.UsersApi) (*string, diag.Diagnostics) {
+	return getUserIdWithState(email, "deleted", usersAPI)
+}
+
+func getUserIdWithState(email string, state string, usersAPI *platformclientv2.UsersApi) (*string, diag.Diagnostics) {
 	exactType := "EXACT"
 	results, _, getErr := usersAPI.PostUsersSearch(platformclientv2.Usersearchrequest{
 		Query: &[]platformclientv2.Usersearchcriteria{
@@ -751,7 +793,7 @@ func getDeletedUserId(email string, usersAPI *platformclientv2.UsersApi) (*strin
 			},
 			{
 				Fields:  &[]string{"state"},
-				Values:  &[]string{"deleted"},
+				Values:  &[]string{state},
 				VarType: &exactType,
 			},
 		},
@@ -766,9 +808,127 @@ func getDeletedUserId(email string, usersAPI *platformclientv2.UsersApi) (*strin
 	return nil, nil
 }
 
+// offboardUser removes a user's routing skills, queue memberships and roles and moves the user to the inactive state
+func offboardUser(d *schema.ResourceData, sdkConfig *platformclientv2.Configuration) diag.Diagnostics {
+	email := d.Get("email").(string)
+	usersAPI := platformclientv2.NewUsersApiWithConfig(sdkConfig)
+	routingAPI := platformclientv2.NewRoutingApiWithConfig(sdkConfig)
+	authAPI := platformclientv2.NewAuthorizationApiWithConfig(sdkConfig)
+
+	log.Printf("Deactivating user %s", email)
+	_, resp, err := usersAPI.PutUserRoutingskillsBulk(d.Id(), []platformclientv2.Userroutingskillpost{})
+	if err != nil {
+		if IsStatus404(resp) {
+			// User already deleted
+			return nil
+		}
+		return diag.Errorf("Failed to remove skills from user %s: %s", email, err)
+	}
+
+	queueIds, diagErr := getUserQueueIds(d.Id(), usersAPI)
+	if diagErr != nil {
+		return diagErr
+	}
+	for _, queueId := range queueIds {
+		if diagErr := updateMembersInChunks(queueId, []string{d.Id()}, true, routingAPI); diagErr != nil {
+			return diagErr
+		}
+	}
+
+	grants, _, diagErr := getAssignedGrants(d.Id(), authAPI)
+	if diagErr != nil {
+		return diagErr
+	}
+	var grantPairs []string
+	for _, grant := range grants {
+		grantPairs = append(grantPairs, createRoleDivisionPair(*grant.Role.Id, *grant.Division.Id))
+	}
+	if diagErr := removeSubjectRoleGrants(d.Id(), grantPairs, authAPI); diagErr != nil {
+		return diagErr
+	}
+
+	if err := deactivateUser(d.Id(), usersAPI); err != nil {
+		return diag.Errorf("Failed to deactivate user %s: %s", email, err)
+	}
+	log.Printf("Deactivated user %s", email)
+	return nil
+}
+
+// deactivateUser moves a user to the inactive state. Users that no longer exist are ignored.
+func deactivateUser(userId string, usersAPI *platformclientv2.UsersApi) error {
+	state := "inactive"
+	_, resp, getErr := usersAPI.GetUser(userId, nil, "", "")
+	if getErr != nil {
+		if IsStatus404(resp) {
+			return nil
+		}
+		return getErr
+	}
+	if diagErr := patchUser(userId, platformclientv2.Updateuser{State: &state}, usersAPI); diagErr != nil {
+		return diagErrorsToError(diagErr)
+	}
+	return nil
+}
+
+// getUserQueueIds returns the queues a user is a member of, whether joined or not
+func getUserQueueIds(userId string, usersAPI *platformclientv2.UsersApi) ([]string, diag.Diagnostics) {
+	const pageSize = 100
+	var queueIds []string
+	for _, joined := range []bool{true, false} {
+		for pageNum := 1; ; pageNum++ {
+			queues, _, err := usersAPI.GetUserQueues(userId, pageSize, pageNum, joined, nil)
+			if err != nil {
+				return nil, diag.Errorf("Failed to get queues for user %s: %s", userId, err)
+			}
+			if queues.Entities == nil || len(*queues.Entities) == 0 {
+				break
+			}
+			for _, queue := range *queues.Entities {
+				queueIds = append(queueIds, *queue.Id)
+			}
+			if queues.PageCount == nil || pageNum >= *queues.PageCount {
+				break
+			}
+		}
+	}
+	return queueIds, nil
+}
+
+// customizeUserDiff plans the move to the inactive state once deactivate_at has passed
+func customizeUserDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if !diff.NewValueKnown("deactivate_at") {
+		return nil
+	}
+	deactivated := deactivateAtPassed(diff.Get("deactivate_at").(string))
+	if diff.Get("deactivated").(bool) != deactivated {
+		return diff.SetNew("deactivated", deactivated)
+	}
+	return nil
+}
+
+// getUserState returns the configured state, or inactive once deactivate_at has passed
+func getUserState(d *schema.ResourceData) string {
+	if userDeactivateAtPassed(d) {
+		return "inactive"
+	}
+	return d.Get("state").(string)
+}
+
+func userDeactivateAtPassed(d *schema.ResourceData) bool {
+	return deactivateAtPassed(d.Get("deactivate_at").(string))
+}
+
+func deactivateAtPassed(deactivateAt string) bool {
+	if deactivateAt == "" {
+		return false
+	}
+	deactivateTime, err := time.Parse(time.RFC3339, deactivateAt)
+	return err == nil && !time.Now().Before(deactivateTime)
+}
+
 func restoreDeletedUser(ctx context.Context, d *schema.ResourceData, meta interface{}, usersAPI *platformclientv2.UsersApi) diag.Diagnostics {
 	email := d.Get("email").(string)
-	state := d.Get("state").(string)
+	state := getUserState(d)
 
 	log.Printf("Restoring deleted user %s", email)
 	patchErr := patchUserWithState(d.Id(), "deleted", platformclientv2.Updateuser{
@@ -1045,7 +1205,8 @@ func flattenUserEmployerInfo(empInfo *platformclientv2.Employerinfo) []interface
 }
 
 func readUserRoutingUtilization(d *schema.ResourceData, usersAPI *platformclientv2.UsersApi) diag.Diagnostics {
-	settings, resp, getErr := usersAPI.GetRoutingUserUtilization(d.Id())
+	routingAPI := platformclientv2.NewRoutingApiWithConfig(usersAPI.Configuration)
+	settings, resp, getErr := getUtilizationSettings(routingAPI, "/api/v2/routing/users/"+d.Id()+"/utilization")
 	if getErr != nil {
 		if IsStatus404(resp) {
 			d.SetId("") // User doesn't exist
@@ -1054,18 +1215,13 @@ func readUserRoutingUtilization(d *schema.ResourceData, usersAPI *platformclient
 		return diag.Errorf("Failed to read Routing Utilization for user %s: %s", d.Id(), getErr)
 	}
 
-	if settings != nil && settings.Utilization != nil {
+	if settings.Utilization != nil {
 		// If the settings are org-wide, set to empty to indicate no settings on the user
-		if settings.Level != nil && *settings.Level == "Organization" {
+		if settings.Level == "Organization" {
 			d.Set("routing_utilization", []interface{}{})
 		} else {
-			allSettings := map[string]interface{}{}
-			for sdkType, schemaType := range utilizationMediaTypes {
-				if mediaSettings, ok := (*settings.Utilization)[sdkType]; ok {
-					allSettings[schemaType] = flattenUtilizationSetting(mediaSettings)
-				}
-			}
-			d.Set("routing_utilization", []interface{}{allSettings})
+			currentMediaUtilization := d.Get("routing_utilization.0.media_utilization").(*schema.Set)
+			d.Set("routing_utilization", []interface{}{flattenUtilizationSettings(settings, currentMediaUtilization)})
 		}
 	} else {
 		d.Set("routing_utilization", nil)
@@ -1074,10 +1230,14 @@ func readUserRoutingUtilization(d *schema.ResourceData, usersAPI *platformclient
 	return nil
 }
 
+// updateUserSkills replaces the skills of the user with the configured skills. With ignore_unmanaged_routing_skills only
+// the configured skills are added and updated and skills that were previously configured are removed. Other skills are
+// owned elsewhere, e.g. by genesyscloud_routing_skill_assignments, and are left alone.
 func updateUserSkills(d *schema.ResourceData, usersAPI *platformclientv2.UsersApi) diag.Diagnostics {
 	if d.HasChange("routing_skills") {
 		if skillsConfig := d.Get("routing_skills"); skillsConfig != nil {
 			sdkSkills := make([]platformclientv2.Userroutingskillpost, 0)
+			newSkillIds := make([]string, 0)
 
 			skillsList := skillsConfig.(*schema.Set).List()
 			for _, configSkill := range skillsList {
@@ -1085,14 +1245,43 @@ func updateUserSkills(d *schema.ResourceData, usersAPI *platformclientv2.UsersAp
 				skillID := skillMap["skill_id"].(string)
 				skillProf := skillMap["proficiency"].(float64)
 
+				newSkillIds = append(newSkillIds, skillID)
 				sdkSkills = append(sdkSkills, platformclientv2.Userroutingskillpost{
 					Id:          &skillID,
 					Proficiency: &skillProf,
 				})
 			}
 
+			if !d.Get("ignore_unmanaged_routing_skills").(bool) {
+				return RetryWhen(IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+					_, resp, err := usersAPI.PutUserRoutingskillsBulk(d.Id(), sdkSkills)
+					if err != nil {
+						return resp, diag.Errorf("Failed to update skills for user %s: %s", d.Id(), err)
+					}
+					return nil, nil
+				})
+			}
+
+			oldSkills, _ := d.GetChange("routing_skills")
+			var oldSkillIds []string
+			if oldSkills != nil {
+				for _, oldSkill := range oldSkills.(*schema.Set).List() {
+					oldSkillIds = append(oldSkillIds, oldSkill.(map[string]interface{})["skill_id"].(string))
+				}
+			}
+
+			for _, skillID := range lists.SliceDifference(oldSkillIds, newSkillIds) {
+				diagErr := removeUserSkill(d.Id(), skillID, usersAPI)
+				if diagErr != nil {
+					return diagErr
+				}
+			}
+
+			if len(sdkSkills) == 0 {
+				return nil
+			}
 			return RetryWhen(IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
-				_, resp, err := usersAPI.PutUserRoutingskillsBulk(d.Id(), sdkSkills)
+				_, resp, err := usersAPI.PatchUserRoutingskillsBulk(d.Id(), sdkSkills)
 				if err != nil {
 					return resp, diag.Errorf("Failed to update skills for user %s: %s", d.Id(), err)
 				}
@@ -1103,6 +1292,44 @@ func updateUserSkills(d *schema.ResourceData, usersAPI *platformclientv2.UsersAp
 	return nil
 }
 
+func removeUserSkill(userID string, skillID string, usersAPI *platformclientv2.UsersApi) diag.Diagnostics {
+	return RetryWhen(IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+		resp, err := usersAPI.DeleteUserRoutingskill(userID, skillID)
+		if err != nil && !IsStatus404(resp) {
+			return resp, diag.Errorf("Failed to remove skill %s from user %s: %s", skillID, userID, err)
+		}
+		return nil, nil
+	})
+}
+
+// getOwnedUserSkillIds returns the skills managed by a user resource that ignores unmanaged skills, or nil if every
+// skill of the user is managed or the user is being imported
+func getOwnedUserSkillIds(d *schema.ResourceData) map[string]bool {
+	if !d.Get("ignore_unmanaged_routing_skills").(bool) || d.Get("email").(string) == "" {
+		return nil
+	}
+	skillIds := make(map[string]bool)
+	if skills, ok := d.Get("routing_skills").(*schema.Set); ok {
+		for _, skill := range skills.List() {
+			skillIds[skill.(map[string]interface{})["skill_id"].(string)] = true
+		}
+	}
+	return skillIds
+}
+
+func filterUserSkills(skills *[]platformclientv2.Userroutingskill, skillIds map[string]bool) *[]platformclientv2.Userroutingskill {
+	if skills == nil || skillIds == nil {
+		return skills
+	}
+	filtered := make([]platformclientv2.Userroutingskill, 0)
+	for _, skill := range *skills {
+		if skillIds[*skill.Id] {
+			filtered = append(filtered, skill)
+		}
+	}
+	return &filtered
+}
+
 func updateUserLanguages(d *schema.ResourceData, usersAPI *platformclientv2.UsersApi) diag.Diagnostics {
 	if d.HasChange("routing_languages") {
 		if languages := d.Get("routing_languages"); languages != nil {
@@ -1245,18 +1472,13 @@ func updateUserRoutingUtilization(d *schema.ResourceData, usersAPI *platformclie
 	if d.HasChange("routing_utilization") {
 		if utilConfig := d.Get("routing_utilization").([]interface{}); utilConfig != nil {
 			if len(utilConfig) > 0 { // Specified but empty utilization list will reset to org-wide defaults
-				sdkSettings := make(map[string]platformclientv2.Mediautilization)
-				allSettings := utilConfig[0].(map[string]interface{})
-				for sdkType, schemaType := range utilizationMediaTypes {
-					if mediaSettings, ok := allSettings[schemaType]; ok && len(mediaSettings.([]interface{})) > 0 {
-						sdkSettings[sdkType] = buildSdkMediaUtilization(mediaSettings.([]interface{}))
-					}
+				settings, err := buildUtilizationSettings(d, "routing_utilization.0.")
+				if err != nil {
+					return diag.Errorf("Invalid Routing Utilization for user %s: %s", d.Id(), err)
 				}
 				// Update settings
-				_, _, err := usersAPI.PutRoutingUserUtilization(d.Id(), platformclientv2.Utilization{
-					Utilization: &sdkSettings,
-				})
-				if err != nil {
+				routingAPI := platformclientv2.NewRoutingApiWithConfig(usersAPI.Configuration)
+				if err := putUtilizationSettings(routingAPI, "/api/v2/routing/users/"+d.Id()+"/utilization", settings); err != nil {
 					return diag.Errorf("Failed to update Routing Utilization for user %s: %s", d.Id(), err)
 				}
 			} else {
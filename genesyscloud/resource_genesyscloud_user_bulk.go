@@ -0,0 +1,609 @@
+package genesyscloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	lists "terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v109/platformclientv2"
+)
+
+func ResourceUserBulk() *schema.Resource {
+	return &schema.Resource{
+		Description: `Genesys Cloud User Bulk. Provisions users from a CSV or JSON roster.
+
+Each row has an ` + "`email`" + ` and ` + "`name`" + ` and may set ` + "`division`, `manager` (email), `department`, `title`, `skills`, `languages`, `locations` and `queues`" + `. Divisions, skills, languages, locations and queues are referenced by name or ID.
+In CSV files lists are separated by ` + "`;`" + ` and proficiencies follow a ` + "`:`" + `, e.g. ` + "`Sales:4;Billing:2`" + `. In JSON files skills and languages are objects of name to proficiency and locations and queues are lists.
+
+New rows create users, or restore deleted users with the same email. Users that already exist in the org are adopted. Changed rows update their users and rows removed from the roster deactivate their users. Empty columns are not managed, and skills and queue memberships are only added.
+Rows are applied in parallel and rows that fail are reported individually and retried on the next apply. When the roster is first applied, failed rows are reported as warnings so the users of the other rows are kept.`,
+
+		CreateContext: CreateWithPooledClient(createUserBulk),
+		ReadContext:   ReadWithPooledClient(readUserBulk),
+		UpdateContext: UpdateWithPooledClient(updateUserBulk),
+		DeleteContext: DeleteWithPooledClient(deleteUserBulk),
+		CustomizeDiff: customizeUserBulkDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"source_file": {
+				Description:  "Path to the roster. Must be a .csv or .json file.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: ValidatePath,
+			},
+			"max_concurrency": {
+				Description:  "Maximum number of rows applied at the same time.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      5,
+				ValidateFunc: validation.IntBetween(1, 20),
+			},
+			"row_hashes": {
+				Description: "Hash of each applied row, keyed by lower case email. Used to detect changed rows.",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"user_ids": {
+				Description: "ID of the user of each row, keyed by lower case email.",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// customizeUserBulkDiff parses the roster during plan so invalid rows fail early and changed rows produce a diff
+func customizeUserBulkDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if !diff.NewValueKnown("source_file") {
+		if err := diff.SetNewComputed("row_hashes"); err != nil {
+			return err
+		}
+		return diff.SetNewComputed("user_ids")
+	}
+
+	rows, diagErr := readUserRoster(diff.Get("source_file").(string))
+	if diagErr.HasError() {
+		return diagErrorsToError(diagErr)
+	}
+
+	hashes := getUserRosterHashes(rows)
+	if reflect.DeepEqual(diff.Get("row_hashes").(map[string]interface{}), hashes) {
+		return nil
+	}
+	if err := diff.SetNew("row_hashes", hashes); err != nil {
+		return err
+	}
+	return diff.SetNewComputed("user_ids")
+}
+
+func createUserBulk(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sourceFile := d.Get("source_file").(string)
+	log.Printf("Creating users from roster %s", sourceFile)
+	d.SetId(uuid.NewString())
+
+	diagErr := syncUserBulk(ctx, d, meta, map[string]interface{}{}, map[string]interface{}{})
+	if diagErr.HasError() {
+		if len(d.Get("user_ids").(map[string]interface{})) == 0 {
+			d.SetId("")
+			return diagErr
+		}
+		// Failing the create would taint the resource and replacing it would deactivate every user of the roster.
+		// Failed rows have no hash so they are retried on the next apply.
+		diagErr = diagErrorsToWarnings(diagErr)
+	}
+
+	log.Printf("Created users from roster %s", sourceFile)
+	return append(diagErr, readUserBulk(ctx, d, meta)...)
+}
+
+func readUserBulk(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	usersAPI := platformclientv2.NewUsersApiWithConfig(sdkConfig)
+
+	sourceFile := d.Get("source_file").(string)
+	log.Printf("Reading users from roster %s", sourceFile)
+	return WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, ResourceUserBulk())
+
+		userIds := d.Get("user_ids").(map[string]interface{})
+		hashes := d.Get("row_hashes").(map[string]interface{})
+
+		activeUserIds, err := getActiveUserIds(userIds, usersAPI)
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+		for email, userId := range userIds {
+			if !activeUserIds[userId.(string)] {
+				// Users deleted or deactivated outside of Terraform are dropped so the next apply restores them
+				log.Printf("User %s from roster %s is no longer active", email, sourceFile)
+				delete(userIds, email)
+				delete(hashes, email)
+			}
+		}
+		d.Set("user_ids", userIds)
+		d.Set("row_hashes", hashes)
+
+		log.Printf("Read users from roster %s", sourceFile)
+		return cc.CheckState()
+	})
+}
+
+func updateUserBulk(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	oldHashes, _ := d.GetChange("row_hashes")
+	oldUserIds, _ := d.GetChange("user_ids")
+
+	log.Printf("Updating users from roster %s", d.Get("source_file").(string))
+	diagErr := syncUserBulk(ctx, d, meta, oldHashes.(map[string]interface{}), oldUserIds.(map[string]interface{}))
+	if diagErr.HasError() {
+		return diagErr
+	}
+
+	log.Printf("Updated users from roster %s", d.Get("source_file").(string))
+	return append(diagErr, readUserBulk(ctx, d, meta)...)
+}
+
+func deleteUserBulk(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	usersAPI := platformclientv2.NewUsersApiWithConfig(sdkConfig)
+
+	userIds := d.Get("user_ids").(map[string]interface{})
+	log.Printf("Deactivating %d users from roster %s", len(userIds), d.Get("source_file").(string))
+
+	var diagErr diag.Diagnostics
+	var mutex sync.Mutex
+	runWithConcurrency(d.Get("max_concurrency").(int), sortedKeys(userIds), func(email string) {
+		if err := deactivateUser(userIds[email].(string), usersAPI); err != nil {
+			mutex.Lock()
+			diagErr = append(diagErr, diag.Diagnostic{Severity: diag.Error, Summary: fmt.Sprintf("Failed to deactivate user %s: %v", email, err)})
+			mutex.Unlock()
+		}
+	})
+	return diagErr
+}
+
+// syncUserBulk applies new and changed rows, sets managers and deactivates the users of removed rows.
+// Rows that fail keep their previous hash so they are retried on the next apply.
+func syncUserBulk(ctx context.Context, d *schema.ResourceData, meta interface{}, oldHashes map[string]interface{}, oldUserIds map[string]interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	usersAPI := platformclientv2.NewUsersApiWithConfig(sdkConfig)
+	maxConcurrency := d.Get("max_concurrency").(int)
+
+	rows, diagErr := readUserRoster(d.Get("source_file").(string))
+	if diagErr.HasError() {
+		return diagErr
+	}
+	newHashes := getUserRosterHashes(rows)
+
+	hashes := make(map[string]interface{})
+	userIds := make(map[string]interface{})
+	for email, userId := range oldUserIds {
+		userIds[email] = userId
+		if hash, ok := oldHashes[email]; ok {
+			hashes[email] = hash
+		}
+	}
+	defer func() {
+		d.Set("row_hashes", hashes)
+		d.Set("user_ids", userIds)
+	}()
+
+	var changedRows []userRosterRow
+	for _, row := range rows {
+		key := userRosterKey(row.Email)
+		if userIds[key] == nil || hashes[key] != newHashes[key] {
+			changedRows = append(changedRows, row)
+		}
+	}
+
+	catalogs, diagErr := loadUserRosterCatalogs(ctx, sdkConfig, changedRows)
+	if diagErr.HasError() {
+		return diagErr
+	}
+
+	var mutex sync.Mutex
+	addRowError := func(row userRosterRow, err error) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		diagErr = append(diagErr, userRosterRowError(row.Row, row.Email, err.Error()))
+	}
+
+	// Create and update users. Managers are set afterwards as they may be created by other rows.
+	succeeded := make(map[string]bool)
+	runWithConcurrency(maxConcurrency, changedRows, func(row userRosterRow) {
+		key := userRosterKey(row.Email)
+
+		mutex.Lock()
+		userId, _ := userIds[key].(string)
+		mutex.Unlock()
+
+		userId, err := applyUserRosterRow(ctx, row, userId, catalogs, meta)
+		mutex.Lock()
+		if userId != "" {
+			userIds[key] = userId
+		}
+		mutex.Unlock()
+		if err != nil {
+			addRowError(row, err)
+			return
+		}
+
+		mutex.Lock()
+		succeeded[key] = true
+		mutex.Unlock()
+	})
+
+	runWithConcurrency(maxConcurrency, changedRows, func(row userRosterRow) {
+		key := userRosterKey(row.Email)
+		if !succeeded[key] {
+			return
+		}
+		if row.Manager != "" {
+			mutex.Lock()
+			managerId, _ := userIds[userRosterKey(row.Manager)].(string)
+			mutex.Unlock()
+			if err := setUserRosterManager(userIds[key].(string), row.Manager, managerId, usersAPI); err != nil {
+				addRowError(row, err)
+				return
+			}
+		}
+
+		mutex.Lock()
+		hashes[key] = newHashes[key]
+		mutex.Unlock()
+	})
+
+	// Deactivate the users of removed rows
+	var removed []string
+	for email := range oldUserIds {
+		if _, ok := newHashes[email]; !ok {
+			removed = append(removed, email)
+		}
+	}
+	sort.Strings(removed)
+	runWithConcurrency(maxConcurrency, removed, func(email string) {
+		log.Printf("Deactivating user %s removed from roster %s", email, d.Get("source_file").(string))
+		if err := deactivateUser(oldUserIds[email].(string), usersAPI); err != nil {
+			mutex.Lock()
+			diagErr = append(diagErr, diag.Diagnostic{Severity: diag.Error, Summary: fmt.Sprintf("Failed to deactivate user %s removed from the roster: %v", email, err)})
+			mutex.Unlock()
+			return
+		}
+		mutex.Lock()
+		delete(userIds, email)
+		delete(hashes, email)
+		mutex.Unlock()
+	})
+
+	return diagErr
+}
+
+// applyUserRosterRow creates or updates the user of a row and adds it to the row's queues.
+// The user ID is returned even if a later step fails.
+func applyUserRosterRow(ctx context.Context, row userRosterRow, userId string, catalogs userRosterCatalogs, meta interface{}) (string, error) {
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	usersAPI := platformclientv2.NewUsersApiWithConfig(sdkConfig)
+	routingAPI := platformclientv2.NewRoutingApiWithConfig(sdkConfig)
+
+	config, queueIds, err := buildUserRosterConfig(row, catalogs)
+	if err != nil {
+		return userId, err
+	}
+	d, err := resourceDataFromConfig(ctx, ResourceUser(), config)
+	if err != nil {
+		return userId, err
+	}
+
+	if userId == "" {
+		existingId, err := getUserIdByEmail(row.Email, usersAPI)
+		if err != nil {
+			return "", err
+		}
+		userId = existingId
+	}
+
+	if userId == "" {
+		// createUser restores a deleted user with the same email
+		log.Printf("Creating user %s from roster row %d", row.Email, row.Row)
+		if diagErr := createUser(ctx, d, meta); diagErr.HasError() {
+			return d.Id(), diagErrorsToError(diagErr)
+		}
+		userId = d.Id()
+	} else {
+		log.Printf("Updating user %s from roster row %d", row.Email, row.Row)
+		d.SetId(userId)
+		if diagErr := updateUserRosterUser(d, usersAPI, sdkConfig); diagErr.HasError() {
+			return userId, diagErrorsToError(diagErr)
+		}
+	}
+
+	for _, queueId := range queueIds {
+		if diagErr := updateMembersInChunks(queueId, []string{userId}, false, routingAPI); diagErr.HasError() {
+			return userId, diagErrorsToError(diagErr)
+		}
+	}
+	return userId, nil
+}
+
+// updateUserRosterUser updates an existing user with the attributes set in its row. Unlike updateUser, attributes
+// that are not part of a roster such as addresses are left alone.
+func updateUserRosterUser(d *schema.ResourceData, usersAPI *platformclientv2.UsersApi, sdkConfig *platformclientv2.Configuration) diag.Diagnostics {
+	currentUser, _, getErr := usersAPI.GetUser(d.Id(), nil, "", "")
+	if getErr != nil {
+		return diag.Errorf("Failed to read user %s: %s", d.Id(), getErr)
+	}
+	if currentUser.State != nil && *currentUser.State != "active" {
+		// State must be updated on its own
+		state := "active"
+		if diagErr := patchUser(d.Id(), platformclientv2.Updateuser{State: &state}, usersAPI); diagErr != nil {
+			return diagErr
+		}
+	}
+
+	name := d.Get("name").(string)
+	update := platformclientv2.Updateuser{Name: &name}
+	if department := d.Get("department").(string); department != "" {
+		update.Department = &department
+	}
+	if title := d.Get("title").(string); title != "" {
+		update.Title = &title
+	}
+	if d.Get("locations").(*schema.Set).Len() > 0 {
+		update.Locations = buildSdkLocations(d)
+	}
+	if diagErr := patchUser(d.Id(), update, usersAPI); diagErr != nil {
+		return diagErr
+	}
+
+	if diagErr := updateObjectDivision(d, "USER", sdkConfig); diagErr != nil {
+		return diagErr
+	}
+	if diagErr := updateUserSkills(d, usersAPI); diagErr != nil {
+		return diagErr
+	}
+	return updateUserLanguages(d, usersAPI)
+}
+
+func setUserRosterManager(userId string, managerEmail string, managerId string, usersAPI *platformclientv2.UsersApi) error {
+	if managerId == "" {
+		var err error
+		if managerId, err = getUserIdByEmail(managerEmail, usersAPI); err != nil {
+			return err
+		}
+		if managerId == "" {
+			return fmt.Errorf("manager %s not found", managerEmail)
+		}
+	}
+	if diagErr := patchUser(userId, platformclientv2.Updateuser{Manager: &managerId}, usersAPI); diagErr != nil {
+		return diagErrorsToError(diagErr)
+	}
+	return nil
+}
+
+// buildUserRosterConfig maps a row to genesyscloud_user configuration. The queue IDs of the row are returned separately.
+func buildUserRosterConfig(row userRosterRow, catalogs userRosterCatalogs) (map[string]interface{}, []string, error) {
+	var unresolved []string
+	resolve := func(kind string, value string) string {
+		id, ok := catalogs[kind].resolve(value)
+		if !ok {
+			unresolved = append(unresolved, fmt.Sprintf("%s %q", kind, value))
+		}
+		return id
+	}
+
+	config := map[string]interface{}{
+		"email": row.Email,
+		"name":  row.Name,
+		"state": "active",
+	}
+	if row.Department != "" {
+		config["department"] = row.Department
+	}
+	if row.Title != "" {
+		config["title"] = row.Title
+	}
+	if row.Division != "" {
+		config["division_id"] = resolve("division", row.Division)
+	}
+	if len(row.Skills) > 0 {
+		skills := make([]interface{}, 0)
+		for _, name := range sortedKeys(row.Skills) {
+			skills = append(skills, map[string]interface{}{"skill_id": resolve("skill", name), "proficiency": row.Skills[name]})
+		}
+		config["routing_skills"] = skills
+		// Skills are only added so skills assigned by other means are kept
+		config["ignore_unmanaged_routing_skills"] = true
+	}
+	if len(row.Languages) > 0 {
+		languages := make([]interface{}, 0)
+		for _, name := range sortedKeys(row.Languages) {
+			languages = append(languages, map[string]interface{}{"language_id": resolve("language", name), "proficiency": row.Languages[name]})
+		}
+		config["routing_languages"] = languages
+	}
+	if len(row.Locations) > 0 {
+		locations := make([]interface{}, 0)
+		for _, name := range row.Locations {
+			locations = append(locations, map[string]interface{}{"location_id": resolve("location", name)})
+		}
+		config["locations"] = locations
+	}
+	var queueIds []string
+	for _, name := range row.Queues {
+		queueIds = append(queueIds, resolve("queue", name))
+	}
+
+	if len(unresolved) > 0 {
+		return nil, nil, fmt.Errorf("not found: %s", strings.Join(unresolved, ", "))
+	}
+	return config, queueIds, nil
+}
+
+// resourceDataFromConfig builds resource data as if the configuration was planned for a new resource, so the
+// resource helpers see every configured attribute as changed
+func resourceDataFromConfig(ctx context.Context, r *schema.Resource, config map[string]interface{}) (*schema.ResourceData, error) {
+	resourceSchema := schema.InternalMap(r.Schema)
+	diff, err := resourceSchema.Diff(ctx, nil, terraform.NewResourceConfigRaw(config), nil, nil, true)
+	if err != nil {
+		return nil, err
+	}
+	return resourceSchema.Data(nil, diff)
+}
+
+func getUserIdByEmail(email string, usersAPI *platformclientv2.UsersApi) (string, error) {
+	exactType := "EXACT"
+	results, _, err := usersAPI.PostUsersSearch(platformclientv2.Usersearchrequest{
+		Query: &[]platformclientv2.Usersearchcriteria{
+			{
+				Fields:  &[]string{"email"},
+				Value:   &email,
+				VarType: &exactType,
+			},
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to search for user %s: %v", email, err)
+	}
+	if results.Results != nil && len(*results.Results) > 0 {
+		return *(*results.Results)[0].Id, nil
+	}
+	return "", nil
+}
+
+// getActiveUserIds returns which of the users are active, looking them up 100 at a time
+func getActiveUserIds(userIds map[string]interface{}, usersAPI *platformclientv2.UsersApi) (map[string]bool, error) {
+	ids := make([]string, 0, len(userIds))
+	for _, userId := range userIds {
+		ids = append(ids, userId.(string))
+	}
+	sort.Strings(ids)
+
+	active := make(map[string]bool)
+	for _, chunk := range lists.ChunkStringSlice(ids, 100) {
+		users, _, err := usersAPI.GetUsers(len(chunk), 1, chunk, nil, "", nil, "", "active")
+		if err != nil {
+			return nil, fmt.Errorf("failed to read users: %v", err)
+		}
+		if users.Entities != nil {
+			for _, user := range *users.Entities {
+				active[*user.Id] = true
+			}
+		}
+	}
+	return active, nil
+}
+
+// userRosterCatalog maps lower case names and IDs of one kind of object to their IDs
+type userRosterCatalog map[string]string
+
+type userRosterCatalogs map[string]userRosterCatalog
+
+func (c userRosterCatalog) resolve(value string) (string, bool) {
+	id, ok := c[strings.ToLower(value)]
+	return id, ok
+}
+
+// loadUserRosterCatalogs lists the divisions, skills, languages, locations and queues referenced by the rows
+func loadUserRosterCatalogs(ctx context.Context, sdkConfig *platformclientv2.Configuration, rows []userRosterRow) (userRosterCatalogs, diag.Diagnostics) {
+	used := make(map[string]bool)
+	for _, row := range rows {
+		used["division"] = used["division"] || row.Division != ""
+		used["skill"] = used["skill"] || len(row.Skills) > 0
+		used["language"] = used["language"] || len(row.Languages) > 0
+		used["location"] = used["location"] || len(row.Locations) > 0
+		used["queue"] = used["queue"] || len(row.Queues) > 0
+	}
+
+	getAllFuncs := map[string]func(context.Context, *platformclientv2.Configuration) (resourceExporter.ResourceIDMetaMap, diag.Diagnostics){
+		"division": getAllAuthDivisions,
+		"skill":    getAllRoutingSkills,
+		"language": getAllRoutingLanguages,
+		"location": getAllLocations,
+		"queue":    getAllRoutingQueues,
+	}
+	catalogs := make(userRosterCatalogs)
+	for kind, getAll := range getAllFuncs {
+		catalogs[kind] = make(userRosterCatalog)
+		if !used[kind] {
+			continue
+		}
+		entities, diagErr := getAll(ctx, sdkConfig)
+		if diagErr != nil {
+			return nil, diagErr
+		}
+		for id, entity := range entities {
+			catalogs[kind][strings.ToLower(id)] = id
+			catalogs[kind][strings.ToLower(entity.Name)] = id
+		}
+	}
+	return catalogs, nil
+}
+
+// runWithConcurrency calls work for each item with at most maxConcurrency calls running at the same time
+func runWithConcurrency[T any](maxConcurrency int, items []T, work func(item T)) {
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, maxConcurrency)
+	for _, item := range items {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(item T) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+			work(item)
+		}(item)
+	}
+	wg.Wait()
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func diagErrorsToError(diagErr diag.Diagnostics) error {
+	var messages []string
+	for _, d := range diagErr {
+		if d.Severity == diag.Error {
+			message := d.Summary
+			if d.Detail != "" {
+				message += ": " + d.Detail
+			}
+			messages = append(messages, message)
+		}
+	}
+	return fmt.Errorf("%s", strings.Join(messages, "\n"))
+}
+
+// diagErrorsToWarnings reports errors as warnings
+func diagErrorsToWarnings(diagErr diag.Diagnostics) diag.Diagnostics {
+	warnings := make(diag.Diagnostics, 0, len(diagErr))
+	for _, d := range diagErr {
+		d.Severity = diag.Warning
+		warnings = append(warnings, d)
+	}
+	return warnings
+}
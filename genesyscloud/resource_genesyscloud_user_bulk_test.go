@@ -0,0 +1,132 @@
+package genesyscloud
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v109/platformclientv2"
+)
+
+func TestAccResourceUserBulk(t *testing.T) {
+	var (
+		bulkResource  = "test-user-bulk"
+		skillResource = "test-bulk-skill"
+		skillName     = "Terraform Bulk Skill-" + uuid.NewString()
+		email1        = "terraform-bulk1-" + uuid.NewString() + "@example.com"
+		email2        = "terraform-bulk2-" + uuid.NewString() + "@example.com"
+		rosterPath    = filepath.Join(t.TempDir(), "roster.csv")
+		removedUserID string
+	)
+
+	writeRoster := func(rows ...string) {
+		content := "email,name,manager,title,skills\n" + strings.Join(rows, "\n") + "\n"
+		if err := os.WriteFile(rosterPath, []byte(content), 0644); err != nil {
+			t.Fatalf("failed to write roster: %v", err)
+		}
+	}
+	row1 := fmt.Sprintf("%s,Bulk Terraform One,,Lead,%s:4", email1, skillName)
+	row2 := fmt.Sprintf("%s,Bulk Terraform Two,%s,,%s:2", email2, email1, skillName)
+	config := generateRoutingSkillResource(skillResource, skillName) +
+		generateUserBulkResource(bulkResource, rosterPath, "genesyscloud_routing_skill."+skillResource)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// Create two users, the second managed by the first
+				PreConfig: func() { writeRoster(row1, row2) },
+				Config:    config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_user_bulk."+bulkResource, "user_ids.%", "2"),
+					resource.TestCheckResourceAttr("genesyscloud_user_bulk."+bulkResource, "row_hashes.%", "2"),
+					testVerifyBulkUserManager(bulkResource, email2, email1),
+					func(state *terraform.State) error {
+						removedUserID = state.RootModule().Resources["genesyscloud_user_bulk."+bulkResource].Primary.Attributes["user_ids."+email2]
+						return nil
+					},
+				),
+			},
+			{
+				// Removing a row deactivates its user
+				PreConfig: func() { writeRoster(strings.Replace(row1, "Lead", "Director", 1)) },
+				Config:    config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_user_bulk."+bulkResource, "user_ids.%", "1"),
+					testVerifyUserState(&removedUserID, "inactive"),
+				),
+			},
+		},
+		CheckDestroy: testVerifyBulkUsersDeactivated(&removedUserID),
+	})
+}
+
+func generateUserBulkResource(resourceID string, sourceFile string, dependsOn string) string {
+	return fmt.Sprintf(`resource "genesyscloud_user_bulk" "%s" {
+		source_file     = "%s"
+		max_concurrency = 2
+		depends_on      = [%s]
+	}
+	`, resourceID, filepath.ToSlash(sourceFile), dependsOn)
+}
+
+func testVerifyBulkUserManager(bulkResource string, email string, managerEmail string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		attributes := state.RootModule().Resources["genesyscloud_user_bulk."+bulkResource].Primary.Attributes
+		user, _, err := platformclientv2.NewUsersApi().GetUser(attributes["user_ids."+email], nil, "", "")
+		if err != nil {
+			return fmt.Errorf("failed to read user %s: %v", email, err)
+		}
+		if user.Manager == nil || *user.Manager == nil || *(*user.Manager).Id != attributes["user_ids."+managerEmail] {
+			return fmt.Errorf("expected user %s to be managed by %s", email, managerEmail)
+		}
+		return nil
+	}
+}
+
+func testVerifyUserState(userID *string, expected string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		user, _, err := platformclientv2.NewUsersApi().GetUser(*userID, nil, "", "")
+		if err != nil {
+			return fmt.Errorf("failed to read user %s: %v", *userID, err)
+		}
+		if *user.State != expected {
+			return fmt.Errorf("expected user %s to be %s, got %s", *userID, expected, *user.State)
+		}
+		return nil
+	}
+}
+
+// testVerifyBulkUsersDeactivated checks the users were deactivated and then deletes them
+func testVerifyBulkUsersDeactivated(extraUserID *string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		usersAPI := platformclientv2.NewUsersApi()
+		userIDs := []string{*extraUserID}
+		for _, rs := range state.RootModule().Resources {
+			if rs.Type != "genesyscloud_user_bulk" {
+				continue
+			}
+			for key, value := range rs.Primary.Attributes {
+				if strings.HasPrefix(key, "user_ids.") && key != "user_ids.%" {
+					userIDs = append(userIDs, value)
+				}
+			}
+		}
+
+		for _, userID := range userIDs {
+			if err := testVerifyUserState(&userID, "inactive")(state); err != nil {
+				return err
+			}
+			if _, _, err := usersAPI.DeleteUser(userID); err != nil {
+				return fmt.Errorf("failed to delete user %s: %v", userID, err)
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,303 @@
+package genesyscloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v109/platformclientv2"
+)
+
+func getAllUserStations(_ context.Context, sdkConfig *platformclientv2.Configuration) (resourceExporter.ResourceIDMetaMap, diag.Diagnostics) {
+	resources := make(resourceExporter.ResourceIDMetaMap)
+	usersAPI := platformclientv2.NewUsersApiWithConfig(sdkConfig)
+
+	for pageNum := 1; ; pageNum++ {
+		const pageSize = 100
+		users, _, getErr := usersAPI.GetUsers(pageSize, pageNum, nil, nil, "", []string{"station"}, "", "")
+		if getErr != nil {
+			return nil, diag.Errorf("Failed to get page of users: %v", getErr)
+		}
+
+		if users.Entities == nil || len(*users.Entities) == 0 {
+			break
+		}
+
+		for _, user := range *users.Entities {
+			if user.Station == nil || *user.Station == nil {
+				continue
+			}
+			if stations := *user.Station; stations.DefaultStation == nil && stations.AssociatedStation == nil {
+				continue
+			}
+			resources[*user.Id] = &resourceExporter.ResourceMeta{Name: *user.Email}
+		}
+	}
+
+	return resources, nil
+}
+
+func UserStationExporter() *resourceExporter.ResourceExporter {
+	return &resourceExporter.ResourceExporter{
+		GetResourcesFunc: GetAllWithPooledClient(getAllUserStations),
+		RefAttrs: map[string]*resourceExporter.RefAttrSettings{
+			"user_id":             {RefType: "genesyscloud_user"},
+			"default_phone_id":    {RefType: "genesyscloud_telephony_providers_edges_phone"},
+			"associated_phone_id": {RefType: "genesyscloud_telephony_providers_edges_phone"},
+		},
+		// Station IDs are locked to an org. Stations are exported by their phones instead.
+		ExcludedAttributes: []string{"default_station_id", "associated_station_id"},
+	}
+}
+
+func ResourceUserStation() *schema.Resource {
+	return &schema.Resource{
+		Description: `Genesys Cloud User Station maintains the default and associated station of a user.
+
+Stations are set either by station ID or by the ID of the phone that owns the station. Phone IDs are used when exporting so the mapping can be applied to another org. Attributes that are not set are not managed.
+Destroying this resource removes the default station and the associated station of the user.`,
+
+		CreateContext: CreateWithPooledClient(createUserStation),
+		ReadContext:   ReadWithPooledClient(readUserStation),
+		UpdateContext: UpdateWithPooledClient(updateUserStation),
+		DeleteContext: DeleteWithPooledClient(deleteUserStation),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Description: "ID of the user. Changing the user_id attribute will cause the user station object to be dropped and recreated with a new ID.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"default_station_id": {
+				Description:   "ID of the default station of the user.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"default_phone_id"},
+			},
+			"default_phone_id": {
+				Description:   "ID of the phone whose station is the default station of the user.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"default_station_id"},
+			},
+			"associated_station_id": {
+				Description:   "ID of the station associated with the user. A station that is associated with another user is associated with this user instead.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"associated_phone_id"},
+			},
+			"associated_phone_id": {
+				Description:   "ID of the phone whose station is associated with the user. A station that is associated with another user is associated with this user instead.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"associated_station_id"},
+			},
+		},
+	}
+}
+
+func createUserStation(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	userID := d.Get("user_id").(string)
+	d.SetId(userID)
+	return updateUserStation(ctx, d, meta)
+}
+
+func readUserStation(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	usersAPI := platformclientv2.NewUsersApiWithConfig(sdkConfig)
+
+	log.Printf("Reading stations of user %s", d.Id())
+	return WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		userStations, resp, getErr := usersAPI.GetUserStation(d.Id())
+		if getErr != nil {
+			if IsStatus404(resp) {
+				return retry.RetryableError(fmt.Errorf("Failed to read stations of user %s: %s", d.Id(), getErr))
+			}
+			return retry.NonRetryableError(fmt.Errorf("Failed to read stations of user %s: %s", d.Id(), getErr))
+		}
+
+		cc := consistency_checker.NewConsistencyCheck(ctx, d, meta, ResourceUserStation())
+		d.Set("user_id", d.Id())
+
+		defaultStationId, defaultPhoneId, err := flattenUserStation(userStations.DefaultStation, sdkConfig)
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+		d.Set("default_station_id", defaultStationId)
+		d.Set("default_phone_id", defaultPhoneId)
+
+		associatedStationId, associatedPhoneId, err := flattenUserStation(userStations.AssociatedStation, sdkConfig)
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+		d.Set("associated_station_id", associatedStationId)
+		d.Set("associated_phone_id", associatedPhoneId)
+
+		log.Printf("Read stations of user %s", d.Id())
+		return cc.CheckState()
+	})
+}
+
+func updateUserStation(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	usersAPI := platformclientv2.NewUsersApiWithConfig(sdkConfig)
+	stationsAPI := platformclientv2.NewStationsApiWithConfig(sdkConfig)
+
+	defaultStationId, diagErr := getUserStationChange(ctx, d, sdkConfig, "default_station_id", "default_phone_id")
+	if diagErr != nil {
+		return diagErr
+	}
+	if defaultStationId != "" {
+		log.Printf("Setting default station of user %s to %s", d.Id(), defaultStationId)
+		diagErr = RetryWhen(IsStatus400, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+			resp, putErr := usersAPI.PutUserStationDefaultstationStationId(d.Id(), defaultStationId)
+			if putErr != nil {
+				return resp, diag.Errorf("Failed to set default station of user %s to %s: %s", d.Id(), defaultStationId, putErr)
+			}
+			return resp, nil
+		})
+		if diagErr != nil {
+			return diagErr
+		}
+	}
+
+	associatedStationId, diagErr := getUserStationChange(ctx, d, sdkConfig, "associated_station_id", "associated_phone_id")
+	if diagErr != nil {
+		return diagErr
+	}
+	if associatedStationId != "" {
+		log.Printf("Associating user %s with station %s", d.Id(), associatedStationId)
+		diagErr = RetryWhen(IsStatus400, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+			station, resp, getErr := stationsAPI.GetStation(associatedStationId)
+			if getErr != nil {
+				return resp, diag.Errorf("Failed to read station %s: %s", associatedStationId, getErr)
+			}
+			if station.UserId != nil && *station.UserId != "" && *station.UserId != d.Id() {
+				log.Printf("Disassociating user %s from station %s", *station.UserId, associatedStationId)
+				if resp, err := stationsAPI.DeleteStationAssociateduser(associatedStationId); err != nil {
+					return resp, diag.Errorf("Error unassigning user from station %s: %v", associatedStationId, err)
+				}
+			}
+
+			resp, putErr := usersAPI.PutUserStationAssociatedstationStationId(d.Id(), associatedStationId)
+			if putErr != nil {
+				return resp, diag.Errorf("Failed to associate user %s with station %s: %s", d.Id(), associatedStationId, putErr)
+			}
+			return resp, nil
+		})
+		if diagErr != nil {
+			return diagErr
+		}
+	}
+
+	log.Printf("Updated stations of user %s", d.Id())
+	return readUserStation(ctx, d, meta)
+}
+
+func deleteUserStation(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	usersAPI := platformclientv2.NewUsersApiWithConfig(sdkConfig)
+
+	log.Printf("Removing stations of user %s", d.Id())
+	if resp, err := usersAPI.DeleteUserStationAssociatedstation(d.Id()); err != nil && !IsStatus404(resp) {
+		return diag.Errorf("Failed to remove associated station of user %s: %s", d.Id(), err)
+	}
+	if resp, err := usersAPI.DeleteUserStationDefaultstation(d.Id()); err != nil && !IsStatus404(resp) {
+		return diag.Errorf("Failed to remove default station of user %s: %s", d.Id(), err)
+	}
+
+	return WithRetries(ctx, 30*time.Second, func() *retry.RetryError {
+		userStations, resp, err := usersAPI.GetUserStation(d.Id())
+		if err != nil {
+			if IsStatus404(resp) {
+				return nil
+			}
+			return retry.NonRetryableError(fmt.Errorf("Error removing stations of user %s: %s", d.Id(), err))
+		}
+		if userStations.DefaultStation != nil || userStations.AssociatedStation != nil {
+			return retry.RetryableError(fmt.Errorf("User %s still has stations", d.Id()))
+		}
+		log.Printf("Removed stations of user %s", d.Id())
+		return nil
+	})
+}
+
+// getUserStationChange returns the station to set when the station or phone attribute changed, or an empty string
+// if neither changed
+func getUserStationChange(ctx context.Context, d *schema.ResourceData, sdkConfig *platformclientv2.Configuration, stationAttr string, phoneAttr string) (string, diag.Diagnostics) {
+	if d.HasChange(phoneAttr) {
+		if phoneId := d.Get(phoneAttr).(string); phoneId != "" {
+			return getPhoneStationId(ctx, sdkConfig, phoneId)
+		}
+	}
+	if d.HasChange(stationAttr) {
+		return d.Get(stationAttr).(string), nil
+	}
+	return "", nil
+}
+
+// flattenUserStation returns the ID of a user's station and of the phone that owns it
+func flattenUserStation(userStation *platformclientv2.Userstation, sdkConfig *platformclientv2.Configuration) (string, string, error) {
+	if userStation == nil || userStation.Id == nil {
+		return "", "", nil
+	}
+	stationsAPI := platformclientv2.NewStationsApiWithConfig(sdkConfig)
+	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
+
+	station, _, getErr := stationsAPI.GetStation(*userStation.Id)
+	if getErr != nil {
+		return "", "", fmt.Errorf("Failed to read station %s: %s", *userStation.Id, getErr)
+	}
+	if station.LineAppearanceId == nil || *station.LineAppearanceId == "" {
+		return *userStation.Id, "", nil
+	}
+
+	phones, _, getErr := edgesAPI.GetTelephonyProvidersEdgesPhones(1, 1, "", "", "", "", "", "", "", "", *station.LineAppearanceId, "", "", "", "", nil, nil)
+	if getErr != nil {
+		return "", "", fmt.Errorf("Failed to get phone of station %s: %s", *userStation.Id, getErr)
+	}
+	if phones.Entities == nil || len(*phones.Entities) == 0 {
+		return *userStation.Id, "", nil
+	}
+	return *userStation.Id, *(*phones.Entities)[0].Id, nil
+}
+
+// getPhoneStationId returns the station of the first line of a phone. Stations are created shortly after their phones.
+func getPhoneStationId(ctx context.Context, sdkConfig *platformclientv2.Configuration, phoneId string) (string, diag.Diagnostics) {
+	edgesAPI := platformclientv2.NewTelephonyProvidersEdgeApiWithConfig(sdkConfig)
+	stationsAPI := platformclientv2.NewStationsApiWithConfig(sdkConfig)
+
+	lineId, err := getLineIdByPhoneId(phoneId, edgesAPI)
+	if err != nil {
+		return "", diag.Errorf("Failed to get line of phone %s: %s", phoneId, err)
+	}
+
+	stationId := ""
+	diagErr := WithRetries(ctx, 60*time.Second, func() *retry.RetryError {
+		stations, _, getErr := stationsAPI.GetStations(1, 1, "", "", "", "", "", lineId)
+		if getErr != nil {
+			return retry.NonRetryableError(fmt.Errorf("Error requesting stations: %s", getErr))
+		}
+		if stations.Entities == nil || len(*stations.Entities) == 0 {
+			return retry.RetryableError(fmt.Errorf("No station found for line %s of phone %s", lineId, phoneId))
+		}
+		stationId = *(*stations.Entities)[0].Id
+		return nil
+	})
+	return stationId, diagErr
+}
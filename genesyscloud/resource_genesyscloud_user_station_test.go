@@ -0,0 +1,131 @@
+package genesyscloud
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v109/platformclientv2"
+)
+
+func TestAccResourceUserStation(t *testing.T) {
+	var (
+		userStationRes        = "test-user-station"
+		userRes               = "test-station-user"
+		userEmail             = "terraform-station-" + uuid.NewString() + "@example.com"
+		phoneRes              = "test-station-phone"
+		phoneBaseSettingsRes  = "test-station-phone-base-settings"
+		phoneBaseSettingsName = "Terraform Station Phone Base Settings-" + uuid.NewString()
+	)
+
+	config := generateOrganizationMe() +
+		generateUserWithCustomAttrs(userRes, userEmail, "Terraform Station User") +
+		generatePhoneBaseSettingsResourceWithCustomAttrs(
+			phoneBaseSettingsRes,
+			phoneBaseSettingsName,
+			"phoneBaseSettings description",
+			"inin_webrtc_softphone.json",
+		) +
+		generatePhoneResourceWithCustomAttrs(&phoneConfig{
+			phoneRes,
+			"test-station-phone-" + uuid.NewString(),
+			"active",
+			"data.genesyscloud_organizations_me.me.default_site_id",
+			"genesyscloud_telephony_providers_edges_phonebasesettings." + phoneBaseSettingsRes + ".id",
+			nil, // no line addresses
+			"genesyscloud_user." + userRes + ".id",
+			"", // no depends on
+		},
+			generatePhoneCapabilities(
+				false,
+				false,
+				false,
+				false,
+				false,
+				false,
+				true,
+				"mac",
+				[]string{strconv.Quote("audio/opus")},
+			),
+		)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// Set the station of the phone as the default station of the user
+				Config: config + generateUserStationResource(
+					userStationRes,
+					"genesyscloud_user."+userRes+".id",
+					"default_phone_id = genesyscloud_telephony_providers_edges_phone."+phoneRes+".id",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("genesyscloud_user_station."+userStationRes, "user_id", "genesyscloud_user."+userRes, "id"),
+					resource.TestCheckResourceAttrPair("genesyscloud_user_station."+userStationRes, "default_phone_id", "genesyscloud_telephony_providers_edges_phone."+phoneRes, "id"),
+					resource.TestCheckResourceAttrSet("genesyscloud_user_station."+userStationRes, "default_station_id"),
+				),
+			},
+			{
+				// Import/Read
+				ResourceName:      "genesyscloud_user_station." + userStationRes,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+		CheckDestroy: testVerifyUserStationsRemoved,
+	})
+}
+
+func TestGetUserStationChange(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ResourceUserStation().Schema, map[string]interface{}{
+		"user_id":            "user-1",
+		"default_station_id": "station-1",
+	})
+
+	stationId, diagErr := getUserStationChange(context.Background(), d, nil, "default_station_id", "default_phone_id")
+	if diagErr != nil || stationId != "station-1" {
+		t.Errorf("expected station-1, got %q %v", stationId, diagErr)
+	}
+
+	// Unset attributes are not managed
+	stationId, diagErr = getUserStationChange(context.Background(), d, nil, "associated_station_id", "associated_phone_id")
+	if diagErr != nil || stationId != "" {
+		t.Errorf("expected no associated station, got %q %v", stationId, diagErr)
+	}
+}
+
+func generateUserStationResource(resourceID string, userID string, attrs string) string {
+	return fmt.Sprintf(`resource "genesyscloud_user_station" "%s" {
+		user_id = %s
+		%s
+	}
+	`, resourceID, userID, attrs)
+}
+
+func testVerifyUserStationsRemoved(state *terraform.State) error {
+	usersAPI := platformclientv2.NewUsersApi()
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != "genesyscloud_user_station" {
+			continue
+		}
+
+		userStations, resp, err := usersAPI.GetUserStation(rs.Primary.ID)
+		if err != nil {
+			if IsStatus404(resp) {
+				// User deleted with its stations
+				continue
+			}
+			return fmt.Errorf("Unexpected error: %s", err)
+		}
+		if userStations.DefaultStation != nil {
+			return fmt.Errorf("User %s still has default station %s", rs.Primary.ID, *userStations.DefaultStation.Id)
+		}
+	}
+	return nil
+}
@@ -5,9 +5,11 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/mypurecloud/platform-client-sdk-go/v109/platformclientv2"
 )
@@ -874,6 +876,111 @@ func TestAccResourceUserRestore(t *testing.T) {
 	})
 }
 
+func TestAccResourceUserOnDestroyDeactivate(t *testing.T) {
+	t.Parallel()
+	var (
+		userResource1 = "test-user"
+		skillResource = "test-skill"
+		email1        = "terraform-" + uuid.NewString() + "@example.com"
+		userName1     = "Terraform Deactivate1"
+		userName2     = "Terraform Deactivate2"
+		skillName     = "Terraform Skill " + uuid.NewString()
+		userID        string
+	)
+
+	skillConfig := generateRoutingSkillResource(skillResource, skillName)
+	userConfig := generateUserWithCustomAttrs(
+		userResource1,
+		email1,
+		userName1,
+		`on_destroy = "deactivate"`,
+		generateUserRoutingSkill("genesyscloud_routing_skill."+skillResource+".id", "3"),
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: GetProviderFactories(providerResources, providerDataSources),
+		Steps: []resource.TestStep{
+			{
+				// Create a user with a skill that is deactivated on destroy
+				Config: skillConfig + userConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_user."+userResource1, "on_destroy", userOnDestroyDeactivate),
+					resource.TestCheckResourceAttr("genesyscloud_user."+userResource1, "deactivated", falseValue),
+					func(state *terraform.State) error {
+						userID = state.RootModule().Resources["genesyscloud_user."+userResource1].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				// Destroy the user but keep the skill
+				Config: skillConfig,
+				Check:  testVerifyUserOffboarded(&userID),
+			},
+			{
+				// Creating the same email reactivates the user
+				Config: skillConfig + generateUserWithCustomAttrs(userResource1, email1, userName2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("genesyscloud_user."+userResource1, "id", &userID),
+					resource.TestCheckResourceAttr("genesyscloud_user."+userResource1, "name", userName2),
+					resource.TestCheckResourceAttr("genesyscloud_user."+userResource1, "state", "active"),
+				),
+			},
+			{
+				// A deactivate_at in the past moves the user to inactive
+				Config: skillConfig + generateUserWithCustomAttrs(userResource1, email1, userName2, `deactivate_at = "2020-01-01T00:00:00Z"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("genesyscloud_user."+userResource1, "state", "inactive"),
+					resource.TestCheckResourceAttr("genesyscloud_user."+userResource1, "deactivated", trueValue),
+				),
+			},
+		},
+		CheckDestroy: testVerifyUsersDestroyed,
+	})
+}
+
+func TestUserDeactivateAt(t *testing.T) {
+	if deactivateAtPassed("") {
+		t.Error("expected an empty deactivate_at to never pass")
+	}
+	if !deactivateAtPassed(time.Now().Add(-time.Minute).Format(time.RFC3339)) {
+		t.Error("expected a past deactivate_at to have passed")
+	}
+	if deactivateAtPassed(time.Now().Add(time.Hour).Format(time.RFC3339)) {
+		t.Error("expected a future deactivate_at to not have passed")
+	}
+
+	d := schema.TestResourceDataRaw(t, ResourceUser().Schema, map[string]interface{}{
+		"email":         "user@example.com",
+		"name":          "User",
+		"deactivate_at": "2020-01-01T00:00:00Z",
+	})
+	if state := getUserState(d); state != "inactive" {
+		t.Errorf("expected state inactive after deactivate_at, got %s", state)
+	}
+	if suppress := ResourceUser().Schema["state"].DiffSuppressFunc; !suppress("state", "inactive", "active", d) {
+		t.Error("expected the configured active state to be suppressed after deactivate_at")
+	}
+}
+
+func testVerifyUserOffboarded(userID *string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		usersAPI := platformclientv2.NewUsersApi()
+		user, _, err := usersAPI.GetUser(*userID, []string{"skills"}, "", "")
+		if err != nil {
+			return fmt.Errorf("Failed to read deactivated user %s: %s", *userID, err)
+		}
+		if *user.State != "inactive" {
+			return fmt.Errorf("Expected user %s to be inactive, got %s", *userID, *user.State)
+		}
+		if user.Skills != nil && len(*user.Skills) > 0 {
+			return fmt.Errorf("Expected skills of user %s to be removed", *userID)
+		}
+		return nil
+	}
+}
+
 func testVerifyUsersDestroyed(state *terraform.State) error {
 	usersAPI := platformclientv2.NewUsersApi()
 	for _, rs := range state.RootModule().Resources {
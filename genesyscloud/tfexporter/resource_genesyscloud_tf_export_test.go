@@ -1598,10 +1598,13 @@ func generateTfExportResource(
 			"genesyscloud_telephony_providers_edges_edge_group",
 			"genesyscloud_telephony_providers_edges_phone",
 			"genesyscloud_telephony_providers_edges_site",
+			"genesyscloud_telephony_providers_edges_site_number_plan",
+			"genesyscloud_telephony_providers_edges_site_outbound_route",
 			"genesyscloud_telephony_providers_edges_phonebasesettings",
 			"genesyscloud_telephony_providers_edges_trunkbasesettings",
 			"genesyscloud_telephony_providers_edges_trunk",
 			"genesyscloud_user_roles",
+			"genesyscloud_user_station",
 			"genesyscloud_webdeployments_configuration",
 			"genesyscloud_webdeployments_deployment",
 			"genesyscloud_knowledge_knowledgebase"
@@ -89,10 +89,13 @@ func (r *registerTestInstance) registerTestResources() {
 	providerResources["genesyscloud_telephony_providers_edges_extension_pool"] = gcloud.ResourceTelephonyExtensionPool()
 	providerResources["genesyscloud_telephony_providers_edges_phone"] = gcloud.ResourcePhone()
 	providerResources["genesyscloud_telephony_providers_edges_site"] = gcloud.ResourceSite()
+	providerResources["genesyscloud_telephony_providers_edges_site_number_plan"] = gcloud.ResourceSiteNumberPlan()
+	providerResources["genesyscloud_telephony_providers_edges_site_outbound_route"] = gcloud.ResourceSiteOutboundRoute()
 	providerResources["genesyscloud_telephony_providers_edges_phonebasesettings"] = gcloud.ResourcePhoneBaseSettings()
 	providerResources["genesyscloud_telephony_providers_edges_trunkbasesettings"] = gcloud.ResourceTrunkBaseSettings()
 	providerResources["genesyscloud_telephony_providers_edges_trunk"] = gcloud.ResourceTrunk()
 	providerResources["genesyscloud_user_roles"] = gcloud.ResourceUserRoles()
+	providerResources["genesyscloud_user_station"] = gcloud.ResourceUserStation()
 	providerResources["genesyscloud_webdeployments_configuration"] = gcloud.ResourceWebDeploymentConfiguration()
 	providerResources["genesyscloud_webdeployments_deployment"] = gcloud.ResourceWebDeployment()
 	providerResources["genesyscloud_widget_deployment"] = gcloud.ResourceWidgetDeployment()
@@ -185,11 +188,14 @@ func (r *registerTestInstance) registerTestExporters() {
 	RegisterExporter("genesyscloud_telephony_providers_edges_extension_pool", gcloud.TelephonyExtensionPoolExporter())
 	RegisterExporter("genesyscloud_telephony_providers_edges_phone", gcloud.PhoneExporter())
 	RegisterExporter("genesyscloud_telephony_providers_edges_site", gcloud.SiteExporter())
+	RegisterExporter("genesyscloud_telephony_providers_edges_site_number_plan", gcloud.SiteNumberPlanExporter())
+	RegisterExporter("genesyscloud_telephony_providers_edges_site_outbound_route", gcloud.SiteOutboundRouteExporter())
 	RegisterExporter("genesyscloud_telephony_providers_edges_phonebasesettings", gcloud.PhoneBaseSettingsExporter())
 	RegisterExporter("genesyscloud_telephony_providers_edges_trunkbasesettings", gcloud.TrunkBaseSettingsExporter())
 	RegisterExporter("genesyscloud_telephony_providers_edges_trunk", gcloud.TrunkExporter())
 	RegisterExporter("genesyscloud_user", gcloud.UserExporter())
 	RegisterExporter("genesyscloud_user_roles", gcloud.UserRolesExporter())
+	RegisterExporter("genesyscloud_user_station", gcloud.UserStationExporter())
 	RegisterExporter("genesyscloud_webdeployments_configuration", gcloud.WebDeploymentConfigurationExporter())
 	RegisterExporter("genesyscloud_webdeployments_deployment", gcloud.WebDeploymentExporter())
 	RegisterExporter("genesyscloud_widget_deployment", gcloud.WidgetDeploymentExporter())
@@ -121,19 +121,8 @@ func updateSubjectRoles(ctx context.Context, d *schema.ResourceData, authAPI *pl
 			}
 
 			grantsToRemove := lists.SliceDifference(existingGrants, configGrants)
-			if len(grantsToRemove) > 0 {
-				// It's possible for a role or division to be removed before this update is processed,
-				// and the bulk remove API returns failure if any roles/divisions no longer exist.
-				// Work around by removing all grants individually and ignore 404s.
-				sdkGrantsToRemove := roleDivPairsToGrants(grantsToRemove)
-				for _, grant := range *sdkGrantsToRemove.Grants {
-					resp, err := authAPI.DeleteAuthorizationSubjectDivisionRole(d.Id(), *grant.DivisionId, *grant.RoleId)
-					if err != nil {
-						if resp == nil || resp.StatusCode != 404 {
-							return diag.Errorf("Failed to remove role grants for subject %s: %s", d.Id(), err)
-						}
-					}
-				}
+			if diagErr := removeSubjectRoleGrants(d.Id(), grantsToRemove, authAPI); diagErr != nil {
+				return diagErr
 			}
 
 			grantsToAdd := lists.SliceDifference(configGrants, existingGrants)
@@ -155,6 +144,26 @@ func updateSubjectRoles(ctx context.Context, d *schema.ResourceData, authAPI *pl
 	return nil
 }
 
+// removeSubjectRoleGrants removes role:division pairs from a subject
+func removeSubjectRoleGrants(subjectID string, grantPairs []string, authAPI *platformclientv2.AuthorizationApi) diag.Diagnostics {
+	if len(grantPairs) == 0 {
+		return nil
+	}
+	// It's possible for a role or division to be removed before this update is processed,
+	// and the bulk remove API returns failure if any roles/divisions no longer exist.
+	// Work around by removing all grants individually and ignore 404s.
+	sdkGrantsToRemove := roleDivPairsToGrants(grantPairs)
+	for _, grant := range *sdkGrantsToRemove.Grants {
+		resp, err := authAPI.DeleteAuthorizationSubjectDivisionRole(subjectID, *grant.DivisionId, *grant.RoleId)
+		if err != nil {
+			if resp == nil || resp.StatusCode != 404 {
+				return diag.Errorf("Failed to remove role grants for subject %s: %s", subjectID, err)
+			}
+		}
+	}
+	return nil
+}
+
 func createRoleDivisionPair(roleID string, divisionID string) string {
 	return roleID + ":" + divisionID
 }
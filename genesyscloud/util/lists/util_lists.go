@@ -52,6 +52,11 @@ func SliceDifference(a, b []string) []string {
 	return diff
 }
 
+// SliceIntersection returns the elements of a that are also in b
+func SliceIntersection(a, b []string) []string {
+	return SliceDifference(a, SliceDifference(a, b))
+}
+
 // Returns true if a and b are equivalent, ignoring the ordering of the items.
 func ListsAreEquivalent(a []string, b []string) bool {
 	if len(a) != len(b) {
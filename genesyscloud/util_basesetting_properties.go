@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -36,6 +37,10 @@ func flattenBaseSettingsProperties(properties interface{}) (string, diag.Diagnos
 }
 
 func customizePhoneBaseSettingsPropertiesDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if err := validateBaseSettingsPropertiesDiff(diff, meta, baseSettingsSchemaCategoryPhone, baseSettingsSchemaCategoryPhone, "phone_meta_base_id"); err != nil {
+		return err
+	}
+
 	// Defaults must be set on missing properties
 	if !diff.NewValueKnown("properties") {
 		// properties value not yet in final state. Nothing to do.
@@ -63,6 +68,13 @@ func customizePhoneBaseSettingsPropertiesDiff(ctx context.Context, diff *schema.
 }
 
 func customizeTrunkBaseSettingsPropertiesDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// Trunk meta-base schemas are grouped by trunk type
+	if trunkType, _ := diff.Get("trunk_type").(string); diff.NewValueKnown("trunk_type") && trunkType != "" {
+		if err := validateBaseSettingsPropertiesDiff(diff, meta, baseSettingsSchemaCategoryTrunk, strings.ToLower(trunkType), "trunk_meta_base_id"); err != nil {
+			return err
+		}
+	}
+
 	// Defaults must be set on missing properties
 	if !diff.NewValueKnown("properties") {
 		// properties value not yet in final state. Nothing to do.
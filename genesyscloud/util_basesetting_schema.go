@@ -0,0 +1,242 @@
+package genesyscloud
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v109/platformclientv2"
+)
+
+const (
+	baseSettingsSchemaCategoryTrunk = "trunk"
+	baseSettingsSchemaCategoryPhone = "phone"
+)
+
+// Meta-base schemas are loaded once per meta-base during a provider run
+var (
+	baseSettingsSchemaMutex sync.Mutex
+	baseSettingsSchemaCache = make(map[string]map[string]interface{})
+)
+
+// getBaseSettingsSchema returns the JSON schema of the properties of a trunk or phone meta-base
+func getBaseSettingsSchema(sdkConfig *platformclientv2.Configuration, schemaCategory string, schemaType string, metaBaseId string) (map[string]interface{}, error) {
+	path := fmt.Sprintf("%s/api/v2/configuration/schemas/edges/vnext/%s/%s/%s", sdkConfig.BasePath, schemaCategory, schemaType, url.PathEscape(metaBaseId))
+
+	baseSettingsSchemaMutex.Lock()
+	defer baseSettingsSchemaMutex.Unlock()
+	if metaBaseSchema, ok := baseSettingsSchemaCache[path]; ok {
+		return metaBaseSchema, nil
+	}
+
+	headerParams := make(map[string]string)
+	for key, value := range sdkConfig.DefaultHeader {
+		headerParams[key] = value
+	}
+	headerParams["Authorization"] = "Bearer " + sdkConfig.AccessToken
+	headerParams["Accept"] = "application/json"
+
+	resp, err := sdkConfig.APIClient.CallAPI(path, http.MethodGet, nil, headerParams, nil, nil, "", nil)
+	if err == nil && resp.Error != nil {
+		err = errors.New(resp.ErrorMessage)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get schema of meta-base %s: %v", metaBaseId, err)
+	}
+
+	metaBaseSchema := make(map[string]interface{})
+	if err := json.Unmarshal(resp.RawBody, &metaBaseSchema); err != nil {
+		return nil, fmt.Errorf("failed to parse schema of meta-base %s: %v", metaBaseId, err)
+	}
+	baseSettingsSchemaCache[path] = metaBaseSchema
+	return metaBaseSchema, nil
+}
+
+// validateBaseSettingsPropertiesDiff validates the properties of trunk or phone base settings against the schema of
+// their meta-base. Validation is skipped if the schema can't be loaded, so the API remains the source of truth.
+func validateBaseSettingsPropertiesDiff(diff *schema.ResourceDiff, meta interface{}, schemaCategory string, schemaType string, metaBaseAttr string) error {
+	if !diff.NewValueKnown("properties") || !diff.NewValueKnown(metaBaseAttr) {
+		return nil
+	}
+	// Properties read from the API have already been accepted
+	if diff.Id() != "" && !diff.HasChange("properties") && !diff.HasChange(metaBaseAttr) {
+		return nil
+	}
+	propertiesJson, _ := diff.Get("properties").(string)
+	metaBaseId, _ := diff.Get(metaBaseAttr).(string)
+	if propertiesJson == "" || metaBaseId == "" {
+		return nil
+	}
+
+	metaBaseSchema, err := getBaseSettingsSchema(meta.(*ProviderMeta).ClientConfig, schemaCategory, schemaType, metaBaseId)
+	if err != nil {
+		log.Printf("Properties are not validated: %v", err)
+		return nil
+	}
+	return validateBaseSettingsProperties(metaBaseSchema, propertiesJson)
+}
+
+// validateBaseSettingsProperties validates a properties JSON string against a meta-base schema. Unknown property
+// names are always rejected. Required values are not checked because missing values are set from the meta-base
+// defaults.
+func validateBaseSettingsProperties(metaBaseSchema map[string]interface{}, propertiesJson string) error {
+	var properties interface{}
+	if err := json.Unmarshal([]byte(propertiesJson), &properties); err != nil {
+		return fmt.Errorf("properties is not valid JSON: %v", err)
+	}
+	if _, ok := properties.(map[string]interface{}); !ok {
+		return fmt.Errorf("properties: expected object, got %s", jsonSchemaTypeOf(properties))
+	}
+
+	messages := validateJsonSchemaValue(metaBaseSchema, properties, "properties", true)
+	if len(messages) > 0 {
+		return fmt.Errorf("invalid properties:\n%s", strings.Join(messages, "\n"))
+	}
+	return nil
+}
+
+// validateJsonSchemaValue validates a value against the subset of JSON schema used by meta-bases: type, enum,
+// properties, additionalProperties, items, minimum, maximum, minLength, maxLength and pattern. If strict is set,
+// properties that the schema does not declare are rejected.
+func validateJsonSchemaValue(jsonSchema map[string]interface{}, value interface{}, path string, strict bool) []string {
+	var messages []string
+	valueType := jsonSchemaTypeOf(value)
+
+	if types := jsonSchemaTypes(jsonSchema["type"]); len(types) > 0 && !jsonSchemaTypeAllowed(types, valueType) {
+		return []string{fmt.Sprintf("%s: expected %s, got %s", path, strings.Join(types, " or "), valueType)}
+	}
+
+	if enum, ok := jsonSchema["enum"].([]interface{}); ok && len(enum) > 0 {
+		found := false
+		for _, enumValue := range enum {
+			if jsonValuesEqual(enumValue, value) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			allowed := make([]string, 0, len(enum))
+			for _, enumValue := range enum {
+				allowed = append(allowed, jsonString(enumValue))
+			}
+			messages = append(messages, fmt.Sprintf("%s: %s %s is not one of the allowed values %s", path, valueType, jsonString(value), strings.Join(allowed, ", ")))
+		}
+	}
+
+	switch typedValue := value.(type) {
+	case map[string]interface{}:
+		schemaProperties, _ := jsonSchema["properties"].(map[string]interface{})
+		additional := jsonSchema["additionalProperties"]
+		for _, name := range sortedKeys(typedValue) {
+			propertyPath := path + "." + name
+			if propertySchema, ok := schemaProperties[name].(map[string]interface{}); ok {
+				messages = append(messages, validateJsonSchemaValue(propertySchema, typedValue[name], propertyPath, false)...)
+				continue
+			}
+			if additionalSchema, ok := additional.(map[string]interface{}); ok && !strict {
+				messages = append(messages, validateJsonSchemaValue(additionalSchema, typedValue[name], propertyPath, false)...)
+				continue
+			}
+			if (strict && len(schemaProperties) > 0) || additional == false {
+				messages = append(messages, fmt.Sprintf("%s: unknown property%s", propertyPath, didYouMean(name, sortedKeys(schemaProperties))))
+			}
+		}
+	case []interface{}:
+		if itemSchema, ok := jsonSchema["items"].(map[string]interface{}); ok {
+			for i, item := range typedValue {
+				messages = append(messages, validateJsonSchemaValue(itemSchema, item, fmt.Sprintf("%s[%d]", path, i), false)...)
+			}
+		}
+	case float64:
+		if minimum, ok := jsonSchema["minimum"].(float64); ok && typedValue < minimum {
+			messages = append(messages, fmt.Sprintf("%s: %s %v is less than the minimum %v", path, valueType, typedValue, minimum))
+		}
+		if maximum, ok := jsonSchema["maximum"].(float64); ok && typedValue > maximum {
+			messages = append(messages, fmt.Sprintf("%s: %s %v is greater than the maximum %v", path, valueType, typedValue, maximum))
+		}
+	case string:
+		length := float64(len([]rune(typedValue)))
+		if minLength, ok := jsonSchema["minLength"].(float64); ok && length < minLength {
+			messages = append(messages, fmt.Sprintf("%s: string %q is shorter than %v characters", path, typedValue, minLength))
+		}
+		if maxLength, ok := jsonSchema["maxLength"].(float64); ok && length > maxLength {
+			messages = append(messages, fmt.Sprintf("%s: string %q is longer than %v characters", path, typedValue, maxLength))
+		}
+		if pattern, ok := jsonSchema["pattern"].(string); ok {
+			if re, err := regexp.Compile(pattern); err == nil && !re.MatchString(typedValue) {
+				messages = append(messages, fmt.Sprintf("%s: string %q does not match the pattern %s", path, typedValue, pattern))
+			}
+		}
+	}
+	return messages
+}
+
+// jsonSchemaTypes returns the types of a schema type keyword, which is a type name or a list of type names
+func jsonSchemaTypes(schemaType interface{}) []string {
+	switch typedValue := schemaType.(type) {
+	case string:
+		return []string{typedValue}
+	case []interface{}:
+		var types []string
+		for _, item := range typedValue {
+			if typeName, ok := item.(string); ok {
+				types = append(types, typeName)
+			}
+		}
+		sort.Strings(types)
+		return types
+	}
+	return nil
+}
+
+func jsonSchemaTypeAllowed(types []string, valueType string) bool {
+	for _, typeName := range types {
+		if typeName == valueType || (typeName == "number" && valueType == "integer") {
+			return true
+		}
+	}
+	return false
+}
+
+// jsonSchemaTypeOf returns the JSON schema type name of a decoded JSON value
+func jsonSchemaTypeOf(value interface{}) string {
+	switch typedValue := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case float64:
+		if typedValue == math.Trunc(typedValue) {
+			return "integer"
+		}
+		return "number"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+func jsonValuesEqual(a interface{}, b interface{}) bool {
+	return jsonString(a) == jsonString(b)
+}
+
+func jsonString(value interface{}) string {
+	valueBytes, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(valueBytes)
+}
@@ -0,0 +1,174 @@
+package genesyscloud
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v109/platformclientv2"
+)
+
+// e911Objects holds the objects read for an E911 compliance check. Locations and IVRs that were not found are
+// stored as nil.
+type e911Objects struct {
+	sites           []platformclientv2.Site
+	locations       map[string]*platformclientv2.Locationdefinition
+	siteIvrs        map[string][]string
+	ivrs            map[string]*platformclientv2.Ivr
+	emergencyGroups []platformclientv2.Emergencygroup
+}
+
+// e911Coverage is how an IVR is covered by emergency groups
+type e911Coverage struct {
+	covered     bool
+	disabled    []string
+	withoutFlow []string
+}
+
+// checkE911Compliance returns the E911 coverage gaps of each site, sorted by site. A site must have a location with a
+// valid E.164 emergency number and a complete address, and every IVR of the site must be covered by an enabled
+// emergency group with an emergency flow.
+func checkE911Compliance(objects e911Objects, requireVerifiedAddress bool) []string {
+	coverage := getE911Coverage(objects.emergencyGroups)
+
+	sites := make([]platformclientv2.Site, len(objects.sites))
+	copy(sites, objects.sites)
+	sort.SliceStable(sites, func(i, j int) bool {
+		return strings.ToLower(getE911Name(sites[i].Name)) < strings.ToLower(getE911Name(sites[j].Name))
+	})
+
+	var issues []string
+	for _, site := range sites {
+		siteLabel := fmt.Sprintf("Site %q (%s)", getE911Name(site.Name), *site.Id)
+		for _, issue := range checkE911Location(site, objects.locations, requireVerifiedAddress) {
+			issues = append(issues, siteLabel+": "+issue)
+		}
+		for _, ivrId := range objects.siteIvrs[*site.Id] {
+			ivr := objects.ivrs[ivrId]
+			if ivr == nil {
+				issues = append(issues, fmt.Sprintf("%s: IVR %s not found", siteLabel, ivrId))
+				continue
+			}
+			if issue := checkE911IvrCoverage(coverage[ivrId]); issue != "" {
+				issues = append(issues, fmt.Sprintf("%s: IVR %q (%s) %s", siteLabel, getE911Name(ivr.Name), ivrId, issue))
+			}
+		}
+	}
+	return issues
+}
+
+func checkE911Location(site platformclientv2.Site, locations map[string]*platformclientv2.Locationdefinition, requireVerifiedAddress bool) []string {
+	if site.Location == nil || site.Location.Id == nil {
+		return []string{"no location"}
+	}
+	location := locations[*site.Location.Id]
+	if location == nil {
+		return []string{fmt.Sprintf("location %s not found", *site.Location.Id)}
+	}
+	if location.State != nil && *location.State == "deleted" {
+		return []string{fmt.Sprintf("location %q is deleted", getE911Name(location.Name))}
+	}
+
+	locationLabel := fmt.Sprintf("location %q", getE911Name(location.Name))
+	var issues []string
+	if number := getE911EmergencyNumber(location); number == "" {
+		issues = append(issues, locationLabel+" has no emergency number")
+	} else if diagErr := ValidatePhoneNumber(number, nil); diagErr.HasError() {
+		issues = append(issues, fmt.Sprintf("%s emergency number %s is not a valid E.164 number", locationLabel, number))
+	}
+
+	var missing []string
+	address := location.Address
+	if address == nil {
+		address = &platformclientv2.Locationaddress{}
+	}
+	for _, field := range []struct {
+		name  string
+		value *string
+	}{
+		{"street1", address.Street1},
+		{"city", address.City},
+		{"zipcode", address.Zipcode},
+		{"country", address.Country},
+	} {
+		if field.value == nil || strings.TrimSpace(*field.value) == "" {
+			missing = append(missing, field.name)
+		}
+	}
+	if len(missing) > 0 {
+		issues = append(issues, fmt.Sprintf("%s address is missing %s", locationLabel, strings.Join(missing, ", ")))
+	} else if requireVerifiedAddress && (location.AddressVerified == nil || !*location.AddressVerified) {
+		issues = append(issues, locationLabel+" address is not verified")
+	}
+	return issues
+}
+
+func checkE911IvrCoverage(coverage *e911Coverage) string {
+	switch {
+	case coverage == nil:
+		return "is not in an emergency group"
+	case coverage.covered:
+		return ""
+	case len(coverage.withoutFlow) > 0:
+		return fmt.Sprintf("is in emergency group %s without an emergency flow", strings.Join(coverage.withoutFlow, ", "))
+	}
+	return fmt.Sprintf("is only in disabled emergency group %s", strings.Join(coverage.disabled, ", "))
+}
+
+// getE911Coverage maps the ID of each IVR of an emergency group to its coverage
+func getE911Coverage(emergencyGroups []platformclientv2.Emergencygroup) map[string]*e911Coverage {
+	coverage := make(map[string]*e911Coverage)
+	for _, group := range emergencyGroups {
+		if (group.State != nil && *group.State == "deleted") || group.EmergencyCallFlows == nil {
+			continue
+		}
+		enabled := group.Enabled != nil && *group.Enabled
+		groupName := fmt.Sprintf("%q", getE911Name(group.Name))
+		for _, callFlow := range *group.EmergencyCallFlows {
+			if callFlow.Ivrs == nil {
+				continue
+			}
+			hasFlow := callFlow.EmergencyFlow != nil && callFlow.EmergencyFlow.Id != nil
+			for _, ivr := range *callFlow.Ivrs {
+				if ivr.Id == nil {
+					continue
+				}
+				c := coverage[*ivr.Id]
+				if c == nil {
+					c = &e911Coverage{}
+					coverage[*ivr.Id] = c
+				}
+				switch {
+				case !enabled:
+					c.disabled = append(c.disabled, groupName)
+				case !hasFlow:
+					c.withoutFlow = append(c.withoutFlow, groupName)
+				default:
+					c.covered = true
+				}
+			}
+		}
+	}
+	return coverage
+}
+
+// getE911EmergencyNumber returns the E.164 form of the emergency number of a location, or the number as entered
+func getE911EmergencyNumber(location *platformclientv2.Locationdefinition) string {
+	if location.EmergencyNumber == nil {
+		return ""
+	}
+	if location.EmergencyNumber.E164 != nil && *location.EmergencyNumber.E164 != "" {
+		return *location.EmergencyNumber.E164
+	}
+	if location.EmergencyNumber.Number != nil {
+		return *location.EmergencyNumber.Number
+	}
+	return ""
+}
+
+func getE911Name(name *string) string {
+	if name == nil {
+		return ""
+	}
+	return *name
+}
@@ -0,0 +1,100 @@
+package genesyscloud
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v109/platformclientv2"
+)
+
+func TestCheckE911Compliance(t *testing.T) {
+	site := func(id string, name string, locationId string) platformclientv2.Site {
+		s := platformclientv2.Site{Id: &id, Name: &name}
+		if locationId != "" {
+			s.Location = &platformclientv2.Locationdefinition{Id: &locationId}
+		}
+		return s
+	}
+	location := func(name string, number string, verified bool, street1 string, city string, zipcode string, country string) *platformclientv2.Locationdefinition {
+		l := &platformclientv2.Locationdefinition{
+			Name:            &name,
+			AddressVerified: &verified,
+			Address:         &platformclientv2.Locationaddress{Street1: &street1, City: &city, Zipcode: &zipcode, Country: &country},
+		}
+		if number != "" {
+			l.EmergencyNumber = &platformclientv2.Locationemergencynumber{Number: &number}
+		}
+		return l
+	}
+	ivr := func(name string) *platformclientv2.Ivr {
+		return &platformclientv2.Ivr{Name: &name}
+	}
+	ref := func(id string) platformclientv2.Domainentityref {
+		return platformclientv2.Domainentityref{Id: &id}
+	}
+	emergencyGroup := func(name string, enabled bool, flowId string, ivrIds ...string) platformclientv2.Emergencygroup {
+		callFlow := platformclientv2.Emergencycallflow{Ivrs: &[]platformclientv2.Domainentityref{}}
+		if flowId != "" {
+			flow := ref(flowId)
+			callFlow.EmergencyFlow = &flow
+		}
+		for _, ivrId := range ivrIds {
+			*callFlow.Ivrs = append(*callFlow.Ivrs, ref(ivrId))
+		}
+		return platformclientv2.Emergencygroup{Name: &name, Enabled: &enabled, EmergencyCallFlows: &[]platformclientv2.Emergencycallflow{callFlow}}
+	}
+
+	objects := e911Objects{
+		sites: []platformclientv2.Site{
+			site("site-4", "Remote", ""),
+			site("site-1", "Indianapolis", "loc-1"),
+			site("site-2", "Denver", "loc-2"),
+			site("site-3", "Chicago", "loc-3"),
+			site("site-5", "Austin", "loc-4"),
+		},
+		locations: map[string]*platformclientv2.Locationdefinition{
+			"loc-1": location("HQ", "+13175550100", true, "7601 Interactive Way", "Indianapolis", "46278", "US"),
+			"loc-2": location("Denver office", "", false, "1 Main St", "Denver", "", "US"),
+			"loc-3": location("Chicago office", "911", false, "2 Main St", "Chicago", "60601", "US"),
+			"loc-4": nil,
+		},
+		siteIvrs: map[string][]string{
+			"site-1": {"ivr-1", "ivr-2", "ivr-3", "ivr-4", "ivr-5", "ivr-6"},
+		},
+		ivrs: map[string]*platformclientv2.Ivr{
+			"ivr-1": ivr("Main line"),
+			"ivr-2": ivr("Support line"),
+			"ivr-3": ivr("Sales line"),
+			"ivr-4": ivr("Billing line"),
+			"ivr-5": nil,
+			"ivr-6": ivr("Backup line"),
+		},
+		emergencyGroups: []platformclientv2.Emergencygroup{
+			emergencyGroup("Evacuation", true, "flow-1", "ivr-1", "ivr-6"),
+			emergencyGroup("Drill", false, "flow-2", "ivr-2", "ivr-6"),
+			emergencyGroup("Draft", true, "", "ivr-3"),
+		},
+	}
+
+	expected := []string{
+		`Site "Austin" (site-5): location loc-4 not found`,
+		`Site "Chicago" (site-3): location "Chicago office" emergency number 911 is not a valid E.164 number`,
+		`Site "Denver" (site-2): location "Denver office" has no emergency number`,
+		`Site "Denver" (site-2): location "Denver office" address is missing zipcode`,
+		`Site "Indianapolis" (site-1): IVR "Support line" (ivr-2) is only in disabled emergency group "Drill"`,
+		`Site "Indianapolis" (site-1): IVR "Sales line" (ivr-3) is in emergency group "Draft" without an emergency flow`,
+		`Site "Indianapolis" (site-1): IVR "Billing line" (ivr-4) is not in an emergency group`,
+		`Site "Indianapolis" (site-1): IVR ivr-5 not found`,
+		`Site "Remote" (site-4): no location`,
+	}
+	issues := checkE911Compliance(objects, false)
+	if strings.Join(issues, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("expected issues:\n%s\ngot:\n%s", strings.Join(expected, "\n"), strings.Join(issues, "\n"))
+	}
+
+	// Only Chicago's address is unverified among the locations with a complete address
+	verifiedIssues := checkE911Compliance(objects, true)
+	if len(verifiedIssues) != len(expected)+1 || verifiedIssues[2] != `Site "Chicago" (site-3): location "Chicago office" address is not verified` {
+		t.Errorf("expected the unverified address of Chicago to be reported, got:\n%s", strings.Join(verifiedIssues, "\n"))
+	}
+}
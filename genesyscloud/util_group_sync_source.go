@@ -0,0 +1,259 @@
+package genesyscloud
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	lists "terraform-provider-genesyscloud/genesyscloud/util/lists"
+)
+
+const (
+	groupSyncRoleMember = "member"
+	groupSyncRoleOwner  = "owner"
+)
+
+// groupSyncIdentity is one member or owner of the external group, identified by email
+type groupSyncIdentity struct {
+	Email string `json:"email"`
+	Role  string `json:"role,omitempty"`
+}
+
+var groupSyncColumns = []string{"email", "role"}
+
+// groupSyncScimSettings is where the membership of a group is read from an SCIM 2.0 service
+type groupSyncScimSettings struct {
+	BaseUrl string
+	GroupId string
+	Token   string
+}
+
+// readGroupSyncFile reads the identities of a CSV or JSON export, chosen by the file extension
+func readGroupSyncFile(path string) ([]groupSyncIdentity, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read group export %s: %v", path, err)
+	}
+
+	var identities []groupSyncIdentity
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		identities, err = parseGroupSyncJson(content)
+	case ".csv":
+		identities, err = parseGroupSyncCsv(content)
+	default:
+		return nil, fmt.Errorf("group export %s must be a .csv or .json file", path)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return identities, validateGroupSyncIdentities(identities)
+}
+
+func parseGroupSyncJson(content []byte) ([]groupSyncIdentity, error) {
+	var identities []groupSyncIdentity
+	decoder := json.NewDecoder(strings.NewReader(string(content)))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&identities); err != nil {
+		return nil, fmt.Errorf("failed to parse group export JSON: %v", err)
+	}
+	return identities, nil
+}
+
+// parseGroupSyncCsv reads a CSV export with a header row and an email column. The role column is optional.
+func parseGroupSyncCsv(content []byte) ([]groupSyncIdentity, error) {
+	reader := csv.NewReader(strings.NewReader(string(content)))
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read group export CSV header: %v", err)
+	}
+	columns := make(map[string]int)
+	for i, column := range header {
+		column = strings.ToLower(strings.TrimSpace(column))
+		if !lists.ItemInSlice(column, groupSyncColumns) {
+			return nil, fmt.Errorf("unknown group export column %q. Valid columns are %s", column, strings.Join(groupSyncColumns, ", "))
+		}
+		columns[column] = i
+	}
+	if _, ok := columns["email"]; !ok {
+		return nil, fmt.Errorf("group export CSV must have an email column")
+	}
+
+	var identities []groupSyncIdentity
+	for rowNum := 1; ; rowNum++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("group export row %d: %v", rowNum, err)
+		}
+		value := func(column string) string {
+			if i, ok := columns[column]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+		identities = append(identities, groupSyncIdentity{
+			Email: value("email"),
+			Role:  value("role"),
+		})
+	}
+	return identities, nil
+}
+
+// validateGroupSyncIdentities checks every identity has an email and a known role. Roles default to member.
+func validateGroupSyncIdentities(identities []groupSyncIdentity) error {
+	var messages []string
+	seen := make(map[groupSyncIdentity]int)
+	for i := range identities {
+		identity := &identities[i]
+		identity.Email = strings.TrimSpace(identity.Email)
+		identity.Role = strings.ToLower(strings.TrimSpace(identity.Role))
+		if identity.Role == "" {
+			identity.Role = groupSyncRoleMember
+		}
+
+		if !strings.Contains(identity.Email, "@") {
+			messages = append(messages, fmt.Sprintf("row %d: %q is not an email", i+1, identity.Email))
+			continue
+		}
+		if identity.Role != groupSyncRoleMember && identity.Role != groupSyncRoleOwner {
+			messages = append(messages, fmt.Sprintf("row %d: role must be %s or %s", i+1, groupSyncRoleMember, groupSyncRoleOwner))
+			continue
+		}
+		key := groupSyncIdentity{Email: groupSyncKey(identity.Email), Role: identity.Role}
+		if other, ok := seen[key]; ok {
+			messages = append(messages, fmt.Sprintf("row %d: %s is already listed as %s in row %d", i+1, identity.Email, identity.Role, other))
+		}
+		seen[key] = i + 1
+	}
+	if len(messages) > 0 {
+		return fmt.Errorf("invalid group export:\n%s", strings.Join(messages, "\n"))
+	}
+	return nil
+}
+
+// groupSyncKey is the key of an email in the resource's maps
+func groupSyncKey(email string) string {
+	return strings.ToLower(email)
+}
+
+// groupSyncEmails returns the sorted keys of the identities with a role, or of all identities if role is empty
+func groupSyncEmails(identities []groupSyncIdentity, role string) []string {
+	var emails []string
+	for _, identity := range identities {
+		if (role == "" || identity.Role == role) && !lists.ItemInSlice(groupSyncKey(identity.Email), emails) {
+			emails = append(emails, groupSyncKey(identity.Email))
+		}
+	}
+	sort.Strings(emails)
+	return emails
+}
+
+type scimGroup struct {
+	Members []struct {
+		Value string `json:"value"`
+		Type  string `json:"type"`
+	} `json:"members"`
+}
+
+type scimUser struct {
+	UserName string `json:"userName"`
+	Active   *bool  `json:"active"`
+	Emails   []struct {
+		Value   string `json:"value"`
+		Primary bool   `json:"primary"`
+	} `json:"emails"`
+}
+
+// getScimGroupIdentities reads the members of an SCIM group. Members of nested groups are included and inactive users
+// are skipped. Users are identified by their primary email, or their user name if they have no email.
+func getScimGroupIdentities(ctx context.Context, settings groupSyncScimSettings) ([]groupSyncIdentity, error) {
+	client := &http.Client{Timeout: 30 * time.Second}
+	baseUrl := strings.TrimSuffix(settings.BaseUrl, "/")
+
+	var identities []groupSyncIdentity
+	visitedGroups := make(map[string]bool)
+	groupIds := []string{settings.GroupId}
+	for len(groupIds) > 0 {
+		groupId := groupIds[0]
+		groupIds = groupIds[1:]
+		if visitedGroups[groupId] {
+			continue
+		}
+		visitedGroups[groupId] = true
+
+		group := &scimGroup{}
+		if err := getScimResource(ctx, client, baseUrl+"/Groups/"+url.PathEscape(groupId), settings.Token, group); err != nil {
+			return nil, err
+		}
+		for _, member := range group.Members {
+			if strings.EqualFold(member.Type, "Group") {
+				groupIds = append(groupIds, member.Value)
+				continue
+			}
+
+			user := &scimUser{}
+			if err := getScimResource(ctx, client, baseUrl+"/Users/"+url.PathEscape(member.Value), settings.Token, user); err != nil {
+				return nil, err
+			}
+			if user.Active != nil && !*user.Active {
+				continue
+			}
+			email := user.UserName
+			for i, scimEmail := range user.Emails {
+				if scimEmail.Primary || i == 0 {
+					email = scimEmail.Value
+				}
+			}
+			identities = append(identities, groupSyncIdentity{Email: email, Role: groupSyncRoleMember})
+		}
+	}
+
+	// Users can be members of more than one nested group
+	var uniqueIdentities []groupSyncIdentity
+	seen := make(map[string]bool)
+	for _, identity := range identities {
+		if !seen[groupSyncKey(identity.Email)] {
+			seen[groupSyncKey(identity.Email)] = true
+			uniqueIdentities = append(uniqueIdentities, identity)
+		}
+	}
+	return uniqueIdentities, validateGroupSyncIdentities(uniqueIdentities)
+}
+
+func getScimResource(ctx context.Context, client *http.Client, resourceUrl string, token string, result interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, resourceUrl, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/scim+json, application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to get %s: %v", resourceUrl, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to get %s: %s", resourceUrl, resp.Status)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return fmt.Errorf("failed to parse %s: %v", resourceUrl, err)
+	}
+	return nil
+}
@@ -0,0 +1,287 @@
+package genesyscloud
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
+)
+
+const (
+	holidayObservedNone             = "none"
+	holidayObservedNearestWeekday   = "nearest_weekday"
+	holidayObservedNextWeekday      = "next_weekday"
+	holidayObservedSaturdayIfSunday = "saturday_if_sunday"
+)
+
+// holidays.json holds the public holidays of each country and its subdivisions as rules, so any year can be generated.
+// Subdivisions add to the holidays of their country.
+//
+//go:embed holiday_data/holidays.json
+var holidayDatasetJson []byte
+
+var (
+	holidayDataset     map[string]holidayCountry
+	holidayDatasetErr  error
+	holidayDatasetOnce sync.Once
+)
+
+type holidayCountry struct {
+	Name         string                        `json:"name"`
+	Observed     string                        `json:"observed"`
+	Holidays     []holidayRule                 `json:"holidays"`
+	Subdivisions map[string]holidaySubdivision `json:"subdivisions"`
+}
+
+type holidaySubdivision struct {
+	Name     string        `json:"name"`
+	Holidays []holidayRule `json:"holidays"`
+}
+
+// holidayRule is a holiday that falls on a fixed day, the nth weekday of a month, the first weekday on or after a day,
+// a number of days after Easter Sunday, or a list of dates. Observed overrides the rule of the country for holidays
+// that fall on a weekend.
+type holidayRule struct {
+	Name        string   `json:"name"`
+	Month       int      `json:"month,omitempty"`
+	Day         int      `json:"day,omitempty"`
+	DayWeekdays []string `json:"day_weekdays,omitempty"`
+	Weekday     string   `json:"weekday,omitempty"`
+	OnOrAfter   int      `json:"on_or_after,omitempty"`
+	Easter      *int     `json:"easter,omitempty"`
+	Offset      int      `json:"offset,omitempty"`
+	Dates       []string `json:"dates,omitempty"`
+	From        int      `json:"from,omitempty"`
+	To          int      `json:"to,omitempty"`
+	Observed    string   `json:"observed,omitempty"`
+}
+
+// holiday is a generated holiday. Region is the country or subdivision code of the holiday, or empty for additions.
+type holiday struct {
+	Region string
+	Name   string
+	Date   time.Time
+}
+
+func getHolidayDataset() (map[string]holidayCountry, error) {
+	holidayDatasetOnce.Do(func() {
+		holidayDatasetErr = json.Unmarshal(holidayDatasetJson, &holidayDataset)
+	})
+	return holidayDataset, holidayDatasetErr
+}
+
+// getHolidayRegionCodes returns the country and subdivision codes of the dataset, such as US and GB-SCT
+func getHolidayRegionCodes() []string {
+	dataset, _ := getHolidayDataset()
+	var codes []string
+	for countryCode, country := range dataset {
+		codes = append(codes, countryCode)
+		for subdivisionCode := range country.Subdivisions {
+			codes = append(codes, subdivisionCode)
+		}
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+// getRegionHolidays generates the holidays of a country or subdivision code for a range of years. Holidays of the
+// country keep the country code so they are shared by its subdivisions.
+func getRegionHolidays(region string, startYear int, endYear int) ([]holiday, error) {
+	dataset, err := getHolidayDataset()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load holiday dataset: %v", err)
+	}
+
+	countryCode, _, _ := strings.Cut(region, "-")
+	country, ok := dataset[countryCode]
+	if !ok {
+		return nil, fmt.Errorf("no holidays for %s. Supported regions are %s", region, strings.Join(getHolidayRegionCodes(), ", "))
+	}
+	type regionRule struct {
+		region string
+		rule   holidayRule
+	}
+	var rules []regionRule
+	for _, rule := range country.Holidays {
+		rules = append(rules, regionRule{countryCode, rule})
+	}
+	if region != countryCode {
+		subdivision, ok := country.Subdivisions[region]
+		if !ok {
+			return nil, fmt.Errorf("no holidays for %s. Supported regions are %s", region, strings.Join(getHolidayRegionCodes(), ", "))
+		}
+		for _, rule := range subdivision.Holidays {
+			rules = append(rules, regionRule{region, rule})
+		}
+	}
+
+	var holidays []holiday
+	for year := startYear; year <= endYear; year++ {
+		var yearHolidays []holiday
+		var observedRules []string
+		for _, r := range rules {
+			dates, err := r.rule.getDates(year)
+			if err != nil {
+				return nil, fmt.Errorf("holiday %s of %s: %v", r.rule.Name, r.region, err)
+			}
+			for _, date := range dates {
+				yearHolidays = append(yearHolidays, holiday{Region: r.region, Name: r.rule.Name, Date: date})
+				observed := r.rule.Observed
+				if observed == "" {
+					observed = country.Observed
+				}
+				observedRules = append(observedRules, observed)
+			}
+		}
+		holidays = append(holidays, observeHolidays(yearHolidays, observedRules)...)
+	}
+	return holidays, nil
+}
+
+// getDates returns the dates of a holiday in a year
+func (r holidayRule) getDates(year int) ([]time.Time, error) {
+	if (r.From != 0 && year < r.From) || (r.To != 0 && year > r.To) {
+		return nil, nil
+	}
+
+	var date time.Time
+	switch {
+	case len(r.Dates) > 0:
+		var dates []time.Time
+		for _, value := range r.Dates {
+			date, err := time.Parse(resourcedata.DateParseFormat, value)
+			if err != nil {
+				return nil, err
+			}
+			if date.Year() == year {
+				dates = append(dates, date)
+			}
+		}
+		return dates, nil
+	case r.Easter != nil:
+		date = getEasterSunday(year).AddDate(0, 0, *r.Easter)
+	case r.Day != 0 && (r.Weekday == "" || dayFallsOn(time.Date(year, time.Month(r.Month), r.Day, 0, 0, 0, 0, time.UTC), r.DayWeekdays)):
+		date = time.Date(year, time.Month(r.Month), r.Day, 0, 0, 0, 0, time.UTC)
+	case r.Weekday != "":
+		weekdays, err := parseRecurrenceByDay(r.Weekday)
+		if err != nil || len(weekdays) != 1 {
+			return nil, fmt.Errorf("invalid weekday %s", r.Weekday)
+		}
+		if date, err = getNthWeekday(year, time.Month(r.Month), weekdays[0], r.OnOrAfter); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("no date rule")
+	}
+	return []time.Time{date.AddDate(0, 0, r.Offset)}, nil
+}
+
+// getNthWeekday returns the nth (or for negative n, the nth from last) weekday of a month. Without an ordinal the
+// first weekday on or after a day of the month is returned.
+func getNthWeekday(year int, month time.Month, weekday recurrenceWeekday, onOrAfter int) (time.Time, error) {
+	switch {
+	case weekday.Ordinal > 0:
+		first := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+		offset := (int(weekday.Weekday) - int(first.Weekday()) + 7) % 7
+		return first.AddDate(0, 0, offset+7*(weekday.Ordinal-1)), nil
+	case weekday.Ordinal < 0:
+		last := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC)
+		offset := (int(last.Weekday()) - int(weekday.Weekday) + 7) % 7
+		return last.AddDate(0, 0, -offset+7*(weekday.Ordinal+1)), nil
+	case onOrAfter > 0:
+		start := time.Date(year, month, onOrAfter, 0, 0, 0, 0, time.UTC)
+		offset := (int(weekday.Weekday) - int(start.Weekday()) + 7) % 7
+		return start.AddDate(0, 0, offset), nil
+	}
+	return time.Time{}, fmt.Errorf("weekday %s needs an ordinal or on_or_after", weekdayCode(weekday.Weekday))
+}
+
+// getEasterSunday returns the date of Easter Sunday in the Gregorian calendar
+func getEasterSunday(year int) time.Time {
+	a := year % 19
+	b := year / 100
+	c := year % 100
+	d := b / 4
+	e := b % 4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i := c / 4
+	k := c % 4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+}
+
+// observeHolidays moves holidays that fall on a weekend to the day they are observed. Holidays moved to the next
+// weekday skip days that are already holidays, so Christmas on a Saturday and Boxing Day on a Sunday are observed on
+// Monday and Tuesday.
+func observeHolidays(holidays []holiday, observedRules []string) []holiday {
+	taken := make(map[time.Time]bool)
+	for _, h := range holidays {
+		if !isWeekend(h.Date) {
+			taken[h.Date] = true
+		}
+	}
+
+	order := make([]int, len(holidays))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool { return holidays[order[i]].Date.Before(holidays[order[j]].Date) })
+
+	observed := make([]holiday, len(holidays))
+	copy(observed, holidays)
+	for _, i := range order {
+		date := holidays[i].Date
+		switch observedRules[i] {
+		case holidayObservedNearestWeekday:
+			if date.Weekday() == time.Saturday {
+				date = date.AddDate(0, 0, -1)
+			} else if date.Weekday() == time.Sunday {
+				date = date.AddDate(0, 0, 1)
+			}
+		case holidayObservedNextWeekday:
+			if isWeekend(date) {
+				for isWeekend(date) || taken[date] {
+					date = date.AddDate(0, 0, 1)
+				}
+				taken[date] = true
+			}
+		case holidayObservedSaturdayIfSunday:
+			if date.Weekday() == time.Sunday {
+				date = date.AddDate(0, 0, -1)
+			}
+		}
+		observed[i].Date = date
+	}
+	return observed
+}
+
+func isWeekend(date time.Time) bool {
+	return date.Weekday() == time.Saturday || date.Weekday() == time.Sunday
+}
+
+func dayFallsOn(date time.Time, weekdays []string) bool {
+	for _, weekday := range weekdays {
+		if weekdayCode(date.Weekday()) == weekday {
+			return true
+		}
+	}
+	return false
+}
+
+// getHolidayKey identifies a holiday, for example "2024-12-25 GB Christmas Day". Keys sort by date.
+func getHolidayKey(h holiday) string {
+	if h.Region == "" {
+		return fmt.Sprintf("%s %s", h.Date.Format(resourcedata.DateParseFormat), h.Name)
+	}
+	return fmt.Sprintf("%s %s %s", h.Date.Format(resourcedata.DateParseFormat), h.Region, h.Name)
+}
@@ -0,0 +1,187 @@
+package genesyscloud
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestGetEasterSunday(t *testing.T) {
+	for year, expected := range map[int]string{2000: "2000-04-23", 2019: "2019-04-21", 2024: "2024-03-31", 2025: "2025-04-20", 2038: "2038-04-25"} {
+		if easter := getEasterSunday(year).Format("2006-01-02"); easter != expected {
+			t.Errorf("expected Easter %d on %s, got %s", year, expected, easter)
+		}
+	}
+}
+
+func TestGetRegionHolidays(t *testing.T) {
+	testCases := []struct {
+		region   string
+		year     int
+		expected map[string]bool
+	}{
+		{
+			region: "US",
+			year:   2022,
+			expected: map[string]bool{
+				// New Year's Day and Juneteenth fall on a weekend and are observed on the nearest weekday
+				"2021-12-31 US New Year's Day":   true,
+				"2022-06-20 US Juneteenth":       true,
+				"2022-05-30 US Memorial Day":     true,
+				"2022-11-24 US Thanksgiving Day": true,
+			},
+		},
+		{
+			region: "GB-SCT",
+			year:   2022,
+			expected: map[string]bool{
+				// Substitute days skip days that are already holidays
+				"2022-01-03 GB New Year's Day":          true,
+				"2022-01-04 GB-SCT 2nd January":         true,
+				"2022-12-26 GB Boxing Day":              true,
+				"2022-12-27 GB Christmas Day":           true,
+				"2022-08-01 GB-SCT Summer Bank Holiday": true,
+				"2022-04-18 GB-ENG Easter Monday":       false,
+			},
+		},
+		{
+			region: "CA-QC",
+			year:   2024,
+			expected: map[string]bool{
+				"2024-03-29 CA Good Friday":                true,
+				"2024-05-20 CA Victoria Day":               true,
+				"2024-06-24 CA-QC Saint-Jean-Baptiste Day": true,
+			},
+		},
+		{
+			region: "IE",
+			year:   2030,
+			expected: map[string]bool{
+				// St. Brigid's Day is on 1 February when it is a Friday
+				"2030-02-01 IE St. Brigid's Day": true,
+			},
+		},
+		{
+			region: "IE",
+			year:   2024,
+			expected: map[string]bool{
+				"2024-02-05 IE St. Brigid's Day": true,
+			},
+		},
+		{
+			region: "NL",
+			year:   2025,
+			expected: map[string]bool{
+				"2025-04-26 NL King's Day":    true,
+				"2025-05-29 NL Ascension Day": true,
+				"2025-06-09 NL Whit Monday":   true,
+				"2025-04-27 NL King's Day":    false,
+				"2025-04-30 NL Queen's Day":   false,
+			},
+		},
+		{
+			region: "DE-SN",
+			year:   2024,
+			expected: map[string]bool{
+				"2024-11-20 DE-SN Day of Repentance and Prayer": true,
+				"2024-05-30 DE-BY Corpus Christi":               false,
+			},
+		},
+		{
+			region: "NZ",
+			year:   2024,
+			expected: map[string]bool{
+				"2024-06-28 NZ Matariki":        true,
+				"2024-06-03 NZ King's Birthday": true,
+				"2024-10-28 NZ Labour Day":      true,
+				"2024-04-25 NZ Anzac Day":       true,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		holidays, err := getRegionHolidays(tc.region, tc.year, tc.year)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.region, err)
+		}
+		keys := make(map[string]bool)
+		for _, h := range holidays {
+			keys[getHolidayKey(h)] = true
+		}
+		for key, expected := range tc.expected {
+			if keys[key] != expected {
+				t.Errorf("%s %d: expected %s to be generated: %v, got %v", tc.region, tc.year, key, expected, keys)
+			}
+		}
+	}
+
+	if _, err := getRegionHolidays("GB-XYZ", 2024, 2024); err == nil {
+		t.Error("expected an unknown subdivision to be rejected")
+	}
+}
+
+func TestHolidayDatasetRules(t *testing.T) {
+	// Every rule of the dataset can be evaluated in every supported year
+	for _, region := range getHolidayRegionCodes() {
+		holidays, err := getRegionHolidays(region, 2000, 2099)
+		if err != nil {
+			t.Fatalf("%s: %v", region, err)
+		}
+		for _, h := range holidays {
+			if h.Date.Year() < 1999 || h.Date.Year() > 2100 {
+				t.Errorf("%s: unexpected date for %s", region, getHolidayKey(h))
+			}
+		}
+	}
+}
+
+func TestGetConfiguredHolidays(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ResourceArchitectHolidaySchedules().Schema, map[string]interface{}{
+		"schedule_group_id": "group-1",
+		"regions":           []interface{}{"GB-ENG", "GB-WLS"},
+		"start_year":        2024,
+		"end_year":          2024,
+		"exclusions":        []interface{}{"boxing day", "2024-05-06"},
+		"additions": []interface{}{
+			map[string]interface{}{"name": "Company Day", "date": "2024-09-13"},
+		},
+	})
+
+	holidays, err := getConfiguredHolidays(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var keys []string
+	for _, h := range holidays {
+		keys = append(keys, getHolidayKey(h))
+	}
+	expected := []string{
+		"2024-01-01 GB New Year's Day",
+		"2024-03-29 GB Good Friday",
+		"2024-04-01 GB-ENG Easter Monday",
+		"2024-04-01 GB-WLS Easter Monday",
+		"2024-05-27 GB Spring Bank Holiday",
+		"2024-08-26 GB-ENG Summer Bank Holiday",
+		"2024-08-26 GB-WLS Summer Bank Holiday",
+		"2024-09-13 Company Day",
+		"2024-12-25 GB Christmas Day",
+	}
+	if len(keys) != len(expected) {
+		t.Fatalf("expected holidays %v, got %v", expected, keys)
+	}
+	for i := range expected {
+		if keys[i] != expected[i] {
+			t.Errorf("expected holidays %v, got %v", expected, keys)
+			break
+		}
+	}
+
+	d.Set("end_year", 2034)
+	if _, err := getConfiguredHolidays(d); err == nil || err.Error() != "at most 10 years of holidays can be generated, got 2024 to 2034" {
+		t.Errorf("unexpected error for too many years: %v", err)
+	}
+	if holidays[0].Date != time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC) {
+		t.Errorf("expected holidays to start at midnight, got %v", holidays[0].Date)
+	}
+}
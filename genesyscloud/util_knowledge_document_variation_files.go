@@ -0,0 +1,204 @@
+package genesyscloud
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"regexp"
+	"strings"
+
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	lists "terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v109/platformclientv2"
+	"golang.org/x/net/html"
+)
+
+var (
+	markdownSpecialChars = strings.NewReplacer(`\`, `\\`, "`", "\\`", `*`, `\*`, `_`, `\_`, `[`, `\[`, `]`, `\]`, `<`, `\<`, `>`, `\>`)
+	markdownLineStart    = regexp.MustCompile(`^(#|\+|-|\d+\.)`)
+)
+
+// KnowledgeDocumentVariationBodyResolver writes the body of an exported variation to a Markdown file under
+// <subDirectory>/<knowledge base>/<category>/ and replaces the inline body in the config with the file path and hash
+func KnowledgeDocumentVariationBodyResolver(resourceId, exportDirectory, subDirectory string, configMap map[string]interface{}, meta interface{}) error {
+	variations, ok := configMap["knowledge_document_variation"].([]interface{})
+	if !ok || len(variations) == 0 {
+		return nil
+	}
+	variation, ok := variations[0].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	bodies, ok := variation["body"].([]interface{})
+	if !ok || len(bodies) == 0 {
+		return nil
+	}
+	body, _ := bodies[0].(map[string]interface{})
+
+	id := strings.Split(resourceId, " ")
+	if len(id) < 3 {
+		return fmt.Errorf("unexpected knowledge document variation ID %s", resourceId)
+	}
+	variationId := id[0]
+	knowledgeBaseId := id[1]
+	knowledgeDocumentId := strings.Split(id[2], ",")[0]
+
+	sdkConfig := meta.(*ProviderMeta).ClientConfig
+	knowledgeAPI := platformclientv2.NewKnowledgeApiWithConfig(sdkConfig)
+
+	knowledgeBase, _, err := knowledgeAPI.GetKnowledgeKnowledgebase(knowledgeBaseId)
+	if err != nil {
+		return fmt.Errorf("failed to read knowledge base %s: %v", knowledgeBaseId, err)
+	}
+	knowledgeDocument, _, err := knowledgeAPI.GetKnowledgeKnowledgebaseDocument(knowledgeBaseId, knowledgeDocumentId, nil, "")
+	if err != nil {
+		return fmt.Errorf("failed to read knowledge document %s: %v", knowledgeDocumentId, err)
+	}
+
+	knowledgeBaseName := knowledgeBaseId
+	if knowledgeBase.Name != nil {
+		knowledgeBaseName = *knowledgeBase.Name
+	}
+	categoryName := "uncategorized"
+	if knowledgeDocument.Category != nil && knowledgeDocument.Category.Name != nil {
+		categoryName = *knowledgeDocument.Category.Name
+	}
+	documentTitle := knowledgeDocumentId
+	if knowledgeDocument.Title != nil {
+		documentTitle = *knowledgeDocument.Title
+	}
+
+	fileDirectory := path.Join(subDirectory, resourceExporter.SanitizeResourceName(knowledgeBaseName), resourceExporter.SanitizeResourceName(categoryName))
+	if err := os.MkdirAll(path.Join(exportDirectory, fileDirectory), os.ModePerm); err != nil {
+		return err
+	}
+	fileName := fmt.Sprintf("%s-%s.md", resourceExporter.SanitizeResourceName(documentTitle), variationId)
+	filePath := path.Join(fileDirectory, fileName)
+
+	content := documentBodyToMarkdown(configList(body, "blocks"))
+	if err := os.WriteFile(path.Join(exportDirectory, filePath), []byte(content), 0644); err != nil {
+		return err
+	}
+
+	delete(variation, "body")
+	variation["body_filepath"] = filePath
+	variation["body_file_content_hash"] = fmt.Sprintf(`${filesha256("%s")}`, filePath)
+	return nil
+}
+
+// documentBodyToMarkdown renders document body blocks as Markdown. Underlined text and videos have no Markdown
+// syntax and are written as inline HTML, which documentBodyFromContent reads back the same way.
+func documentBodyToMarkdown(blocks []interface{}) string {
+	paragraphs := make([]string, 0)
+	for _, block := range blocks {
+		blockMap, _ := block.(map[string]interface{})
+		switch configString(blockMap, "type") {
+		case "Paragraph":
+			for _, paragraph := range configList(blockMap, "paragraph") {
+				text := contentBlocksToMarkdown(configList(paragraph.(map[string]interface{}), "blocks"))
+				paragraphs = append(paragraphs, markdownLineStart.ReplaceAllString(text, `\$1`))
+			}
+		case "Image":
+			for _, image := range configList(blockMap, "image") {
+				paragraphs = append(paragraphs, imageToMarkdown(image.(map[string]interface{})))
+			}
+		case "Video":
+			for _, video := range configList(blockMap, "video") {
+				paragraphs = append(paragraphs, fmt.Sprintf(`<video src="%s"></video>`, html.EscapeString(configString(video.(map[string]interface{}), "url"))))
+			}
+		case "OrderedList", "UnorderedList":
+			items := make([]string, 0)
+			for _, list := range configList(blockMap, "list") {
+				for i, item := range configList(list.(map[string]interface{}), "blocks") {
+					bullet := "-"
+					if configString(blockMap, "type") == "OrderedList" {
+						bullet = fmt.Sprintf("%d.", i+1)
+					}
+					items = append(items, bullet+" "+contentBlocksToMarkdown(configList(item.(map[string]interface{}), "blocks")))
+				}
+			}
+			paragraphs = append(paragraphs, strings.Join(items, "\n"))
+		}
+	}
+	return strings.Join(paragraphs, "\n\n") + "\n"
+}
+
+func contentBlocksToMarkdown(contentBlocks []interface{}) string {
+	var markdown strings.Builder
+	for _, contentBlock := range contentBlocks {
+		contentBlockMap, _ := contentBlock.(map[string]interface{})
+		switch configString(contentBlockMap, "type") {
+		case "Text":
+			for _, text := range configList(contentBlockMap, "text") {
+				markdown.WriteString(textToMarkdown(text.(map[string]interface{})))
+			}
+		case "Image":
+			for _, image := range configList(contentBlockMap, "image") {
+				markdown.WriteString(imageToMarkdown(image.(map[string]interface{})))
+			}
+		}
+	}
+	return markdown.String()
+}
+
+func textToMarkdown(text map[string]interface{}) string {
+	value := configString(text, "text")
+	// Emphasis markers must touch the text they wrap, so surrounding whitespace is moved outside of them
+	trimmed := strings.TrimSpace(value)
+	if trimmed == "" {
+		return strings.ReplaceAll(value, "\n", "  \n")
+	}
+	leading := value[:strings.Index(value, trimmed)]
+	trailing := value[len(leading)+len(trimmed):]
+
+	markdown := strings.ReplaceAll(markdownSpecialChars.Replace(trimmed), "\n", "  \n")
+	marks := configStrings(text, "marks")
+	if lists.ItemInSlice("Underline", marks) {
+		markdown = "<u>" + markdown + "</u>"
+	}
+	if lists.ItemInSlice("Italic", marks) {
+		markdown = "*" + markdown + "*"
+	}
+	if lists.ItemInSlice("Bold", marks) {
+		markdown = "**" + markdown + "**"
+	}
+	if hyperlink := configString(text, "hyperlink"); hyperlink != "" {
+		markdown = fmt.Sprintf("[%s](%s)", markdown, hyperlink)
+	}
+	return leading + markdown + trailing
+}
+
+func imageToMarkdown(image map[string]interface{}) string {
+	markdown := fmt.Sprintf("![](%s)", configString(image, "url"))
+	if hyperlink := configString(image, "hyperlink"); hyperlink != "" {
+		markdown = fmt.Sprintf("[%s](%s)", markdown, hyperlink)
+	}
+	return markdown
+}
+
+// configList returns a nested block list from either resource data or an exported config map
+func configList(m map[string]interface{}, key string) []interface{} {
+	if list, ok := m[key].([]interface{}); ok {
+		return list
+	}
+	return nil
+}
+
+func configString(m map[string]interface{}, key string) string {
+	if value, ok := m[key].(string); ok {
+		return value
+	}
+	return ""
+}
+
+func configStrings(m map[string]interface{}, key string) []string {
+	switch values := m[key].(type) {
+	case *schema.Set:
+		return *lists.SetToStringList(values)
+	case []interface{}:
+		return lists.InterfaceListToStrings(values)
+	}
+	return nil
+}
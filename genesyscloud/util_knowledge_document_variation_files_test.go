@@ -0,0 +1,84 @@
+package genesyscloud
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestDocumentBodyMarkdownRoundTrip(t *testing.T) {
+	source := `Plain text with **bold**, *italic*, <u>underlined</u> and a [link](https://example.com).
+
+Characters like \*, \_ and \[brackets\] are kept as written, as are "quotes" -- and dashes.
+
+\# Not a heading
+
+1. First
+2. Second
+
+- Bullet
+
+[![](https://example.com/image.png)](https://example.com)
+
+<video src="https://example.com/video.mp4"></video>
+`
+	_, blocks, err := documentBodyFromContent("article.md", []byte(source), false)
+	if err != nil {
+		t.Fatalf("failed to parse markdown: %v", err)
+	}
+
+	markdown := documentBodyToMarkdown(blocks)
+	_, roundTripped, err := documentBodyFromContent("article.md", []byte(markdown), false)
+	if err != nil {
+		t.Fatalf("failed to parse generated markdown: %v", err)
+	}
+	if expected, actual := bodyBlocksToJson(t, blocks), bodyBlocksToJson(t, roundTripped); expected != actual {
+		t.Errorf("body changed after writing markdown\n%s\nexpected: %s\nactual:   %s", markdown, expected, actual)
+	}
+
+	blockTypes := make([]string, 0)
+	for _, block := range blocks {
+		blockTypes = append(blockTypes, block.(map[string]interface{})["type"].(string))
+	}
+	if strings.Join(blockTypes, ",") != "Paragraph,Paragraph,Paragraph,OrderedList,UnorderedList,Image,Video" {
+		t.Errorf("unexpected block types %v", blockTypes)
+	}
+	if !strings.Contains(markdown, `"quotes" -- and dashes`) {
+		t.Errorf("expected quotes and dashes to be kept as written, got\n%s", markdown)
+	}
+}
+
+func TestDocumentBodyToMarkdownFromExportConfig(t *testing.T) {
+	// Exported config maps hold marks as plain lists and drop empty attributes
+	blocks := []interface{}{
+		map[string]interface{}{
+			"type": "Paragraph",
+			"paragraph": []interface{}{map[string]interface{}{
+				"blocks": []interface{}{
+					map[string]interface{}{"type": "Text", "text": []interface{}{map[string]interface{}{"text": "Hello "}}},
+					map[string]interface{}{"type": "Text", "text": []interface{}{map[string]interface{}{"text": "world ", "marks": []interface{}{"Bold", "Italic"}}}},
+				},
+			}},
+		},
+		map[string]interface{}{
+			"type":  "Image",
+			"image": []interface{}{map[string]interface{}{"url": "https://example.com/image.png"}},
+		},
+	}
+
+	expected := "Hello ***world*** \n\n![](https://example.com/image.png)\n"
+	if markdown := documentBodyToMarkdown(blocks); markdown != expected {
+		t.Errorf("expected %q, got %q", expected, markdown)
+	}
+}
+
+func bodyBlocksToJson(t *testing.T, blocks []interface{}) string {
+	body := buildVariationBody(map[string]interface{}{
+		"body": []interface{}{map[string]interface{}{"blocks": blocks}},
+	})
+	content, err := json.Marshal(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(content)
+}
@@ -0,0 +1,490 @@
+package genesyscloud
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	lists "terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v109/platformclientv2"
+	"github.com/russross/blackfriday"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	knowledgeImportExtensions = []string{".md", ".markdown", ".html", ".htm"}
+	frontMatterRegex          = regexp.MustCompile(`(?s)^\x{FEFF}?---\r?\n(.*?)\r?\n---\r?\n?`)
+	whitespaceRegex           = regexp.MustCompile(`\s+`)
+	markdownExtensions        = blackfriday.EXTENSION_NO_INTRA_EMPHASIS | blackfriday.EXTENSION_TABLES | blackfriday.EXTENSION_FENCED_CODE |
+		blackfriday.EXTENSION_AUTOLINK | blackfriday.EXTENSION_STRIKETHROUGH | blackfriday.EXTENSION_SPACE_HEADERS | blackfriday.EXTENSION_BACKSLASH_LINE_BREAK
+)
+
+// knowledgeArticleFrontMatter is the optional YAML header of an article file
+type knowledgeArticleFrontMatter struct {
+	Title        string                        `yaml:"title"`
+	Category     string                        `yaml:"category"`
+	Labels       []string                      `yaml:"labels"`
+	Alternatives []knowledgeArticleAlternative `yaml:"alternatives"`
+	Visible      *bool                         `yaml:"visible"`
+}
+
+type knowledgeArticleAlternative struct {
+	Phrase       string `yaml:"phrase"`
+	Autocomplete bool   `yaml:"autocomplete"`
+}
+
+// knowledgeArticle is a parsed article file. Path is relative to the source directory and is used as the document external ID.
+type knowledgeArticle struct {
+	Path         string
+	ContentHash  string
+	Title        string
+	Category     string
+	Labels       []string
+	Alternatives []knowledgeArticleAlternative
+	Visible      bool
+	Body         []interface{}
+}
+
+// knowledgeImportFile is the JSON document uploaded for a knowledge import job
+type knowledgeImportFile struct {
+	KnowledgeBase knowledgeImportEntity     `json:"knowledgeBase"`
+	Documents     []knowledgeImportDocument `json:"documents"`
+}
+
+type knowledgeImportEntity struct {
+	Id   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+type knowledgeImportDocument struct {
+	Id           string                                          `json:"id,omitempty"`
+	ExternalId   string                                          `json:"externalId"`
+	Title        string                                          `json:"title"`
+	Visible      bool                                            `json:"visible"`
+	Alternatives []platformclientv2.Knowledgedocumentalternative `json:"alternatives,omitempty"`
+	Category     *knowledgeImportEntity                          `json:"category,omitempty"`
+	Labels       []knowledgeImportEntity                         `json:"labels,omitempty"`
+	Variations   []knowledgeImportVariation                      `json:"variations"`
+}
+
+type knowledgeImportVariation struct {
+	Body *platformclientv2.Documentbody `json:"body"`
+}
+
+// readKnowledgeArticles parses every Markdown and HTML file below the directory, sorted by path
+func readKnowledgeArticles(directory string) ([]knowledgeArticle, error) {
+	articles := make([]knowledgeArticle, 0)
+	err := filepath.WalkDir(directory, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if strings.HasPrefix(entry.Name(), ".") && path != directory {
+			if entry.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if entry.IsDir() || !lists.ItemInSlice(strings.ToLower(filepath.Ext(path)), knowledgeImportExtensions) {
+			return nil
+		}
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		relativePath, err := filepath.Rel(directory, path)
+		if err != nil {
+			return err
+		}
+		article, err := parseKnowledgeArticle(filepath.ToSlash(relativePath), content)
+		if err != nil {
+			return err
+		}
+		articles = append(articles, *article)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(articles, func(i, j int) bool { return articles[i].Path < articles[j].Path })
+	return articles, validateKnowledgeArticles(articles)
+}
+
+// validateKnowledgeArticles checks for titles used by more than one article
+func validateKnowledgeArticles(articles []knowledgeArticle) error {
+	titles := make(map[string]string)
+	for _, article := range articles {
+		if other, ok := titles[strings.ToLower(article.Title)]; ok {
+			return fmt.Errorf("%s and %s have the same title %q", other, article.Path, article.Title)
+		}
+		titles[strings.ToLower(article.Title)] = article.Path
+	}
+	return nil
+}
+
+// parseKnowledgeArticle splits off the front-matter and converts the Markdown or HTML content to document body blocks
+func parseKnowledgeArticle(path string, content []byte) (*knowledgeArticle, error) {
+	hash := sha256.Sum256(content)
+	article := &knowledgeArticle{
+		Path:        path,
+		ContentHash: hex.EncodeToString(hash[:]),
+		Visible:     true,
+	}
+
+	if match := frontMatterRegex.FindSubmatchIndex(content); match != nil {
+		var frontMatter knowledgeArticleFrontMatter
+		decoder := yaml.NewDecoder(bytes.NewReader(content[match[2]:match[3]]))
+		decoder.KnownFields(true)
+		if err := decoder.Decode(&frontMatter); err != nil {
+			return nil, fmt.Errorf("%s: invalid front-matter: %v", path, err)
+		}
+		for i, alternative := range frontMatter.Alternatives {
+			if strings.TrimSpace(alternative.Phrase) == "" {
+				return nil, fmt.Errorf("%s: alternatives[%d] has no phrase", path, i)
+			}
+		}
+		article.Title = strings.TrimSpace(frontMatter.Title)
+		article.Category = frontMatter.Category
+		article.Labels = frontMatter.Labels
+		article.Alternatives = frontMatter.Alternatives
+		if frontMatter.Visible != nil {
+			article.Visible = *frontMatter.Visible
+		}
+		content = content[match[1]:]
+	}
+
+	title, body, err := documentBodyFromContent(path, content, article.Title == "")
+	if err != nil {
+		return nil, err
+	}
+	if article.Title == "" {
+		article.Title = title
+	}
+	if article.Title == "" {
+		article.Title = titleFromPath(path)
+	}
+	article.Body = body
+	return article, nil
+}
+
+// documentBodyFromContent converts Markdown or HTML content, chosen by the file extension, to document body blocks
+func documentBodyFromContent(path string, content []byte, takeTitle bool) (string, []interface{}, error) {
+	htmlContent := content
+	if extension := strings.ToLower(filepath.Ext(path)); extension == ".md" || extension == ".markdown" {
+		// Smartypants is left out so quotes and dashes are kept as written
+		htmlContent = blackfriday.Markdown(content, blackfriday.HtmlRenderer(blackfriday.HTML_USE_XHTML, "", ""), markdownExtensions)
+	}
+
+	title, body, err := htmlToDocumentBody(htmlContent, takeTitle)
+	if err != nil {
+		return "", nil, fmt.Errorf("%s: %v", path, err)
+	}
+	if len(body) == 0 {
+		return "", nil, fmt.Errorf("%s: no content found", path)
+	}
+	return title, body, nil
+}
+
+func titleFromPath(path string) string {
+	name := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	return strings.TrimSpace(strings.NewReplacer("-", " ", "_", " ").Replace(name))
+}
+
+// htmlToDocumentBody converts HTML to the blocks of a knowledge_document_variation body.
+// If takeTitle is set, the first h1 is returned as the title instead of being added to the body.
+func htmlToDocumentBody(content []byte, takeTitle bool) (string, []interface{}, error) {
+	nodes, err := html.ParseFragment(bytes.NewReader(content), &html.Node{Type: html.ElementNode, Data: "body", DataAtom: atom.Body})
+	if err != nil {
+		return "", nil, err
+	}
+
+	title := ""
+	blocks := make([]interface{}, 0)
+	var walk func(node *html.Node)
+	walk = func(node *html.Node) {
+		switch node.DataAtom {
+		case atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6:
+			if takeTitle && title == "" && node.DataAtom == atom.H1 {
+				title = strings.TrimSpace(whitespaceRegex.ReplaceAllString(nodeText(node), " "))
+				return
+			}
+			blocks = appendParagraphBlock(blocks, inlineContentBlocks(node, []string{"Bold"}, ""))
+		case atom.P, atom.Pre, atom.Blockquote:
+			contentBlocks := inlineContentBlocks(node, nil, "")
+			// A paragraph holding nothing but an image is an image block
+			if len(contentBlocks) == 1 && contentBlocks[0].(map[string]interface{})["type"] == "Image" {
+				image := contentBlocks[0].(map[string]interface{})["image"].([]interface{})[0].(map[string]interface{})
+				blocks = append(blocks, imageBodyBlock(image["url"].(string), image["hyperlink"].(string)))
+				return
+			}
+			blocks = appendParagraphBlock(blocks, contentBlocks)
+		case atom.Ul, atom.Ol:
+			blocks = append(blocks, listBodyBlock(node))
+		case atom.Img:
+			if src := attribute(node, "src"); src != "" {
+				blocks = append(blocks, imageBodyBlock(src, ""))
+			}
+		case atom.Video, atom.Iframe:
+			if src := attribute(node, "src"); src != "" {
+				blocks = append(blocks, videoBodyBlock(src))
+			} else if source := firstChild(node, atom.Source); source != nil {
+				blocks = append(blocks, videoBodyBlock(attribute(source, "src")))
+			}
+		case atom.Html, atom.Head, atom.Body, atom.Div, atom.Section, atom.Article, atom.Main:
+			for child := node.FirstChild; child != nil; child = child.NextSibling {
+				walk(child)
+			}
+		case atom.Script, atom.Style, atom.Title, atom.Meta, atom.Link, atom.Hr, atom.Br:
+			return
+		default:
+			if node.Type == html.TextNode {
+				if text := strings.TrimSpace(node.Data); text != "" {
+					blocks = appendParagraphBlock(blocks, []interface{}{textContentBlock(whitespaceRegex.ReplaceAllString(text, " "), nil, "")})
+				}
+				return
+			}
+			if node.Type == html.ElementNode {
+				blocks = appendParagraphBlock(blocks, inlineContentBlocks(node, nil, ""))
+			}
+		}
+	}
+	for _, node := range nodes {
+		walk(node)
+	}
+	return title, blocks, nil
+}
+
+// inlineContentBlocks flattens inline markup to text and image content blocks, carrying bold, italic, underline and links
+func inlineContentBlocks(node *html.Node, marks []string, hyperlink string) []interface{} {
+	contentBlocks := make([]interface{}, 0)
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		switch {
+		case child.Type == html.TextNode:
+			contentBlocks = append(contentBlocks, textContentBlock(whitespaceRegex.ReplaceAllString(child.Data, " "), marks, hyperlink))
+		case child.DataAtom == atom.Img:
+			if src := attribute(child, "src"); src != "" {
+				contentBlocks = append(contentBlocks, imageContentBlock(src, hyperlink))
+			}
+		case child.DataAtom == atom.Br:
+			contentBlocks = append(contentBlocks, textContentBlock("\n", marks, hyperlink))
+		case child.DataAtom == atom.Strong || child.DataAtom == atom.B:
+			contentBlocks = append(contentBlocks, inlineContentBlocks(child, appendMark(marks, "Bold"), hyperlink)...)
+		case child.DataAtom == atom.Em || child.DataAtom == atom.I:
+			contentBlocks = append(contentBlocks, inlineContentBlocks(child, appendMark(marks, "Italic"), hyperlink)...)
+		case child.DataAtom == atom.U:
+			contentBlocks = append(contentBlocks, inlineContentBlocks(child, appendMark(marks, "Underline"), hyperlink)...)
+		case child.DataAtom == atom.A:
+			contentBlocks = append(contentBlocks, inlineContentBlocks(child, marks, attribute(child, "href"))...)
+		case child.Type == html.ElementNode:
+			contentBlocks = append(contentBlocks, inlineContentBlocks(child, marks, hyperlink)...)
+		}
+	}
+	return trimContentBlocks(contentBlocks)
+}
+
+// trimContentBlocks removes the leading and trailing whitespace of a run of content blocks and drops text blocks left empty
+func trimContentBlocks(contentBlocks []interface{}) []interface{} {
+	textOf := func(block interface{}) map[string]interface{} {
+		if texts := block.(map[string]interface{})["text"].([]interface{}); len(texts) > 0 {
+			return texts[0].(map[string]interface{})
+		}
+		return nil
+	}
+
+	trimmed := make([]interface{}, 0)
+	for i, block := range contentBlocks {
+		if text := textOf(block); text != nil {
+			value := text["text"].(string)
+			if len(trimmed) == 0 {
+				value = strings.TrimLeft(value, " \n")
+			}
+			if i == len(contentBlocks)-1 {
+				value = strings.TrimRight(value, " \n")
+			}
+			if value == "" {
+				continue
+			}
+			text["text"] = value
+		}
+		trimmed = append(trimmed, block)
+	}
+	if len(trimmed) > 0 {
+		if text := textOf(trimmed[len(trimmed)-1]); text != nil {
+			text["text"] = strings.TrimRight(text["text"].(string), " \n")
+		}
+	}
+	return trimmed
+}
+
+func appendMark(marks []string, mark string) []string {
+	if lists.ItemInSlice(mark, marks) {
+		return marks
+	}
+	return append(append([]string{}, marks...), mark)
+}
+
+func appendParagraphBlock(blocks []interface{}, contentBlocks []interface{}) []interface{} {
+	if len(contentBlocks) == 0 {
+		return blocks
+	}
+	return append(blocks, map[string]interface{}{
+		"type":      "Paragraph",
+		"paragraph": []interface{}{map[string]interface{}{"blocks": contentBlocks}},
+		"image":     []interface{}{},
+		"video":     []interface{}{},
+		"list":      []interface{}{},
+	})
+}
+
+func listBodyBlock(node *html.Node) map[string]interface{} {
+	listType := "UnorderedList"
+	if node.DataAtom == atom.Ol {
+		listType = "OrderedList"
+	}
+
+	items := make([]interface{}, 0)
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		if child.DataAtom != atom.Li {
+			continue
+		}
+		items = append(items, map[string]interface{}{
+			"type":   "ListItem",
+			"blocks": inlineContentBlocks(child, nil, ""),
+		})
+	}
+
+	return map[string]interface{}{
+		"type":      listType,
+		"paragraph": []interface{}{},
+		"image":     []interface{}{},
+		"video":     []interface{}{},
+		"list":      []interface{}{map[string]interface{}{"blocks": items}},
+	}
+}
+
+func imageBodyBlock(url string, hyperlink string) map[string]interface{} {
+	return map[string]interface{}{
+		"type":      "Image",
+		"paragraph": []interface{}{},
+		"image":     []interface{}{map[string]interface{}{"url": url, "hyperlink": hyperlink}},
+		"video":     []interface{}{},
+		"list":      []interface{}{},
+	}
+}
+
+func videoBodyBlock(url string) map[string]interface{} {
+	return map[string]interface{}{
+		"type":      "Video",
+		"paragraph": []interface{}{},
+		"image":     []interface{}{},
+		"video":     []interface{}{map[string]interface{}{"url": url}},
+		"list":      []interface{}{},
+	}
+}
+
+func textContentBlock(text string, marks []string, hyperlink string) map[string]interface{} {
+	return map[string]interface{}{
+		"type": "Text",
+		"text": []interface{}{map[string]interface{}{
+			"text":      text,
+			"marks":     lists.StringListToSet(marks),
+			"hyperlink": hyperlink,
+		}},
+		"image": []interface{}{},
+	}
+}
+
+func imageContentBlock(url string, hyperlink string) map[string]interface{} {
+	return map[string]interface{}{
+		"type":  "Image",
+		"text":  []interface{}{},
+		"image": []interface{}{map[string]interface{}{"url": url, "hyperlink": hyperlink}},
+	}
+}
+
+func nodeText(node *html.Node) string {
+	if node.Type == html.TextNode {
+		return node.Data
+	}
+	var text strings.Builder
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		text.WriteString(nodeText(child))
+	}
+	return text.String()
+}
+
+func attribute(node *html.Node, key string) string {
+	for _, attr := range node.Attr {
+		if attr.Key == key {
+			return attr.Val
+		}
+	}
+	return ""
+}
+
+func firstChild(node *html.Node, childAtom atom.Atom) *html.Node {
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		if child.DataAtom == childAtom {
+			return child
+		}
+	}
+	return nil
+}
+
+// buildKnowledgeImportFile builds the import job document for the articles. existingIds maps article paths to document IDs to update.
+func buildKnowledgeImportFile(knowledgeBaseId string, articles []knowledgeArticle, existingIds map[string]string) knowledgeImportFile {
+	importFile := knowledgeImportFile{
+		KnowledgeBase: knowledgeImportEntity{Id: knowledgeBaseId},
+		Documents:     make([]knowledgeImportDocument, 0),
+	}
+
+	for _, article := range articles {
+		document := knowledgeImportDocument{
+			Id:         existingIds[article.Path],
+			ExternalId: article.Path,
+			Title:      article.Title,
+			Visible:    article.Visible,
+			Variations: []knowledgeImportVariation{{
+				Body: buildVariationBody(map[string]interface{}{
+					"body": []interface{}{map[string]interface{}{"blocks": article.Body}},
+				}),
+			}},
+		}
+		for _, alternative := range article.Alternatives {
+			phrase := alternative.Phrase
+			autocomplete := alternative.Autocomplete
+			document.Alternatives = append(document.Alternatives, platformclientv2.Knowledgedocumentalternative{
+				Phrase:       &phrase,
+				Autocomplete: &autocomplete,
+			})
+		}
+		if article.Category != "" {
+			document.Category = &knowledgeImportEntity{Name: article.Category}
+		}
+		for _, label := range article.Labels {
+			document.Labels = append(document.Labels, knowledgeImportEntity{Name: label})
+		}
+		importFile.Documents = append(importFile.Documents, document)
+	}
+	return importFile
+}
+
+// getKnowledgeArticleHashes maps article paths to their content hashes
+func getKnowledgeArticleHashes(articles []knowledgeArticle) map[string]interface{} {
+	hashes := make(map[string]interface{})
+	for _, article := range articles {
+		hashes[article.Path] = article.ContentHash
+	}
+	return hashes
+}
@@ -0,0 +1,84 @@
+package genesyscloud
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestReadKnowledgeArticles(t *testing.T) {
+	articles, err := readKnowledgeArticles(filepath.Join("..", "test", "data", "resource", "genesyscloud_knowledge_import", "articles"))
+	if err != nil {
+		t.Fatalf("failed to read articles: %v", err)
+	}
+	if len(articles) != 2 {
+		t.Fatalf("expected 2 articles, got %d", len(articles))
+	}
+
+	invoices := articles[0]
+	if invoices.Path != "billing/invoices.html" || invoices.Title != "Where to find invoices" {
+		t.Errorf("unexpected article %s with title %s", invoices.Path, invoices.Title)
+	}
+	if len(invoices.Body) != 2 {
+		t.Fatalf("expected a paragraph and a list, got %d blocks", len(invoices.Body))
+	}
+	paragraph := invoices.Body[0].(map[string]interface{})["paragraph"].([]interface{})[0].(map[string]interface{})["blocks"].([]interface{})
+	link := paragraph[1].(map[string]interface{})["text"].([]interface{})[0].(map[string]interface{})
+	if link["text"] != "Billing" || link["hyperlink"] != "https://example.com/billing" {
+		t.Errorf("expected a link to billing, got %v", link)
+	}
+	if listType := invoices.Body[1].(map[string]interface{})["type"]; listType != "UnorderedList" {
+		t.Errorf("expected an unordered list, got %v", listType)
+	}
+
+	password := articles[1]
+	if password.Title != "Reset your password" || password.Category != "Accounts" {
+		t.Errorf("unexpected title %s or category %s", password.Title, password.Category)
+	}
+	if len(password.Alternatives) != 1 || !password.Alternatives[0].Autocomplete {
+		t.Errorf("expected one autocomplete alternative, got %v", password.Alternatives)
+	}
+	blockTypes := make([]string, 0)
+	for _, block := range password.Body {
+		blockTypes = append(blockTypes, block.(map[string]interface{})["type"].(string))
+	}
+	if strings.Join(blockTypes, ",") != "Paragraph,OrderedList,Image" {
+		t.Errorf("unexpected block types %v", blockTypes)
+	}
+
+	importFile := buildKnowledgeImportFile("kb-id", articles, map[string]string{"reset-password.md": "doc-id"})
+	if importFile.Documents[0].Id != "" || importFile.Documents[1].Id != "doc-id" {
+		t.Errorf("expected only the existing document to have an ID")
+	}
+	if importFile.Documents[1].Category.Name != "Accounts" || importFile.Documents[1].Labels[0].Name != "self-service" {
+		t.Errorf("expected category and label names to be carried over")
+	}
+	if importFile.Documents[1].Variations[0].Body == nil || len(*importFile.Documents[1].Variations[0].Body.Blocks) != 3 {
+		t.Errorf("expected the variation body to have 3 blocks")
+	}
+}
+
+func TestParseKnowledgeArticle(t *testing.T) {
+	article, err := parseKnowledgeArticle("guides/getting_started.md", []byte("Some **bold** text"))
+	if err != nil {
+		t.Fatalf("failed to parse article: %v", err)
+	}
+	if article.Title != "getting started" {
+		t.Errorf("expected the title to come from the file name, got %s", article.Title)
+	}
+	blocks := article.Body[0].(map[string]interface{})["paragraph"].([]interface{})[0].(map[string]interface{})["blocks"].([]interface{})
+	bold := blocks[1].(map[string]interface{})["text"].([]interface{})[0].(map[string]interface{})
+	if bold["text"] != "bold" || bold["marks"].(interface{ Len() int }).Len() != 1 {
+		t.Errorf("expected a bold text block, got %v", bold)
+	}
+
+	if _, err := parseKnowledgeArticle("bad.md", []byte("---\ntitel: Typo\n---\nBody")); err == nil {
+		t.Errorf("expected an error for an unknown front-matter key")
+	}
+	if _, err := parseKnowledgeArticle("empty.html", []byte("<h1>Only a title</h1>")); err == nil {
+		t.Errorf("expected an error for an article without content")
+	}
+	if err := validateKnowledgeArticles([]knowledgeArticle{{Path: "a.md", Title: "Same"}, {Path: "b.md", Title: "same"}}); err == nil {
+		t.Errorf("expected an error for duplicate titles")
+	}
+}
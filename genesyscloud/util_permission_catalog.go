@@ -0,0 +1,189 @@
+package genesyscloud
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v109/platformclientv2"
+)
+
+const permissionWildcard = "*"
+
+// permissionCatalog maps each domain to its entities, and each entity to its actions and whether the action
+// allows conditions
+type permissionCatalog map[string]map[string]map[string]bool
+
+// The permission catalog is loaded once during a provider run. Failed loads are not cached so they are retried.
+var (
+	permissionCatalogMutex sync.Mutex
+	permissionCatalogCache permissionCatalog
+)
+
+func getPermissionCatalog(sdkConfig *platformclientv2.Configuration) (permissionCatalog, error) {
+	permissionCatalogMutex.Lock()
+	defer permissionCatalogMutex.Unlock()
+	if permissionCatalogCache != nil {
+		return permissionCatalogCache, nil
+	}
+
+	catalog, err := loadPermissionCatalog(platformclientv2.NewAuthorizationApiWithConfig(sdkConfig))
+	if err != nil {
+		return nil, err
+	}
+	permissionCatalogCache = catalog
+	return catalog, nil
+}
+
+func loadPermissionCatalog(authAPI *platformclientv2.AuthorizationApi) (permissionCatalog, error) {
+	catalog := make(permissionCatalog)
+	for pageNum := 1; ; pageNum++ {
+		const pageSize = 100
+		permissions, _, err := authAPI.GetAuthorizationPermissions(pageSize, pageNum, "", "")
+		if err != nil {
+			return nil, fmt.Errorf("failed to get page of permissions: %v", err)
+		}
+		if permissions.Entities == nil || len(*permissions.Entities) == 0 {
+			break
+		}
+		for _, collection := range *permissions.Entities {
+			if collection.Domain == nil || collection.PermissionMap == nil {
+				continue
+			}
+			for entity, entityPermissions := range *collection.PermissionMap {
+				for _, permission := range entityPermissions {
+					if permission.Action != nil {
+						catalog.add(*collection.Domain, entity, *permission.Action, permission.AllowsConditions != nil && *permission.AllowsConditions)
+					}
+				}
+			}
+		}
+		if permissions.PageCount != nil && pageNum >= *permissions.PageCount {
+			break
+		}
+	}
+	log.Printf("Loaded permission catalog with %d domains", len(catalog))
+	return catalog, nil
+}
+
+func (c permissionCatalog) add(domain string, entity string, action string, allowsConditions bool) {
+	if c[domain] == nil {
+		c[domain] = make(map[string]map[string]bool)
+	}
+	if c[domain][entity] == nil {
+		c[domain][entity] = make(map[string]bool)
+	}
+	c[domain][entity][action] = allowsConditions
+}
+
+// validatePolicy checks the domain, entity and actions of a permission policy exist, and that the permissions allow
+// conditions if the policy has them
+func (c permissionCatalog) validatePolicy(domain string, entity string, actions []string, hasConditions bool) error {
+	entities, ok := c[domain]
+	if !ok {
+		return fmt.Errorf("unknown permission domain %q%s", domain, didYouMean(domain, sortedKeys(c)))
+	}
+
+	var entityNames []string
+	if entity == permissionWildcard {
+		entityNames = sortedKeys(entities)
+	} else if _, ok := entities[entity]; ok {
+		entityNames = []string{entity}
+	} else {
+		return fmt.Errorf("unknown entity %q in permission domain %s%s", entity, domain, didYouMean(entity, sortedKeys(entities)))
+	}
+
+	for _, action := range actions {
+		if action == permissionWildcard {
+			continue
+		}
+		found := false
+		var validActions []string
+		for _, entityName := range entityNames {
+			allowsConditions, ok := entities[entityName][action]
+			validActions = append(validActions, sortedKeys(entities[entityName])...)
+			if !ok {
+				continue
+			}
+			found = true
+			if hasConditions && !allowsConditions {
+				return fmt.Errorf("permission %s:%s:%s does not allow conditions", domain, entityName, action)
+			}
+		}
+		if !found {
+			return fmt.Errorf("unknown action %q for %s:%s%s", action, domain, entity, didYouMean(action, validActions))
+		}
+	}
+	return nil
+}
+
+// expand returns the permissions a policy grants as domain:entity:action strings. Wildcards are expanded to the
+// permissions in the catalog, or kept if the domain is not in the catalog.
+func (c permissionCatalog) expand(domain string, entity string, actions []string) []string {
+	var permissions []string
+	entities, ok := c[domain]
+	if !ok {
+		for _, action := range actions {
+			permissions = append(permissions, strings.Join([]string{domain, entity, action}, ":"))
+		}
+		return permissions
+	}
+
+	entityNames := []string{entity}
+	if entity == permissionWildcard {
+		entityNames = sortedKeys(entities)
+	}
+	for _, entityName := range entityNames {
+		for _, action := range actions {
+			if action != permissionWildcard {
+				permissions = append(permissions, strings.Join([]string{domain, entityName, action}, ":"))
+				continue
+			}
+			for _, entityAction := range sortedKeys(entities[entityName]) {
+				permissions = append(permissions, strings.Join([]string{domain, entityName, entityAction}, ":"))
+			}
+		}
+	}
+	return permissions
+}
+
+// didYouMean suggests the closest valid value to a typo
+func didYouMean(value string, validValues []string) string {
+	best, bestDistance := "", 3
+	for _, validValue := range validValues {
+		if distance := levenshteinDistance(strings.ToLower(value), strings.ToLower(validValue)); distance < bestDistance {
+			best, bestDistance = validValue, distance
+		}
+	}
+	if best == "" {
+		return ""
+	}
+	return fmt.Sprintf(". Did you mean %q?", best)
+}
+
+func levenshteinDistance(a string, b string) int {
+	previous := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current := make([]int, len(b)+1)
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = minInt(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous = current
+	}
+	return previous[len(b)]
+}
+
+func minInt(values ...int) int {
+	sort.Ints(values)
+	return values[0]
+}
@@ -0,0 +1,189 @@
+package genesyscloud
+
+import (
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	lists "terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// phoneInventoryRow is one phone of a genesyscloud_telephony_phone_bulk inventory. The user is referenced by email or
+// ID and the phone base settings by name or ID.
+type phoneInventoryRow struct {
+	Row               int      `json:"-"`
+	Mac               string   `json:"mac"`
+	Name              string   `json:"name,omitempty"`
+	Extension         string   `json:"extension,omitempty"`
+	User              string   `json:"user,omitempty"`
+	LineAddresses     []string `json:"line_addresses,omitempty"`
+	PhoneBaseSettings string   `json:"phone_base_settings,omitempty"`
+}
+
+var (
+	phoneInventoryColumns = []string{"mac", "name", "extension", "user", "line_addresses", "phone_base_settings"}
+
+	phoneInventoryMacSeparators = strings.NewReplacer(":", "", "-", "", ".", "")
+	phoneInventoryMacPattern    = regexp.MustCompile(`^[0-9a-f]{12}$`)
+	phoneInventoryExtPattern    = regexp.MustCompile(`^[0-9]{2,9}$`)
+)
+
+// readPhoneInventory reads a CSV hardware inventory with a header row. Problems with individual rows are returned as
+// one diagnostic per row.
+func readPhoneInventory(path string) ([]phoneInventoryRow, diag.Diagnostics) {
+	if strings.ToLower(filepath.Ext(path)) != ".csv" {
+		return nil, diag.Errorf("Phone inventory %s must be a .csv file", path)
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, diag.Errorf("Failed to read phone inventory %s: %v", path, err)
+	}
+
+	rows, diagErr := parsePhoneInventoryCsv(content)
+	if diagErr.HasError() {
+		return nil, diagErr
+	}
+	if diagErr = validatePhoneInventory(rows); diagErr.HasError() {
+		return nil, diagErr
+	}
+	return rows, nil
+}
+
+// parsePhoneInventoryCsv reads the rows of an inventory. Line addresses are separated by ';' and MAC addresses are
+// normalized to 12 lower case hex digits.
+func parsePhoneInventoryCsv(content []byte) ([]phoneInventoryRow, diag.Diagnostics) {
+	reader := csv.NewReader(strings.NewReader(string(content)))
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, diag.Errorf("Failed to read phone inventory CSV header: %v", err)
+	}
+	columns := make(map[string]int)
+	for i, column := range header {
+		column = strings.ToLower(strings.TrimSpace(column))
+		if !lists.ItemInSlice(column, phoneInventoryColumns) {
+			return nil, diag.Errorf("Unknown phone inventory column %q. Valid columns are %s", column, strings.Join(phoneInventoryColumns, ", "))
+		}
+		columns[column] = i
+	}
+	if _, ok := columns["mac"]; !ok {
+		return nil, diag.Errorf("Phone inventory CSV must have a mac column")
+	}
+
+	var rows []phoneInventoryRow
+	var diagErr diag.Diagnostics
+	for rowNum := 1; ; rowNum++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			diagErr = append(diagErr, phoneInventoryRowError(rowNum, "", err.Error()))
+			continue
+		}
+
+		value := func(column string) string {
+			if i, ok := columns[column]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+		rows = append(rows, phoneInventoryRow{
+			Row:               rowNum,
+			Mac:               normalizePhoneMac(value("mac")),
+			Name:              value("name"),
+			Extension:         value("extension"),
+			User:              value("user"),
+			LineAddresses:     splitRosterList(value("line_addresses")),
+			PhoneBaseSettings: value("phone_base_settings"),
+		})
+	}
+	return rows, diagErr
+}
+
+// validatePhoneInventory checks each row and reports MAC addresses, line addresses, extensions and users that are
+// used by more than one row
+func validatePhoneInventory(rows []phoneInventoryRow) diag.Diagnostics {
+	var diagErr diag.Diagnostics
+	macs := make(map[string]int)
+	lineAddresses := make(map[string]int)
+	extensions := make(map[string]int)
+	users := make(map[string]int)
+
+	checkUnique := func(row phoneInventoryRow, used map[string]int, kind string, value string) {
+		if other, ok := used[strings.ToLower(value)]; ok {
+			diagErr = append(diagErr, phoneInventoryRowError(row.Row, row.Mac, fmt.Sprintf("%s %s is already used in row %d", kind, value, other)))
+			return
+		}
+		used[strings.ToLower(value)] = row.Row
+	}
+
+	for _, row := range rows {
+		if row.Mac == "" {
+			diagErr = append(diagErr, phoneInventoryRowError(row.Row, "", "mac is required"))
+			continue
+		}
+		if !phoneInventoryMacPattern.MatchString(row.Mac) {
+			diagErr = append(diagErr, phoneInventoryRowError(row.Row, row.Mac, "mac must be 12 hex digits, optionally separated by ':', '-' or '.'"))
+		}
+		checkUnique(row, macs, "MAC", row.Mac)
+
+		for _, lineAddress := range row.LineAddresses {
+			if validationErr := ValidatePhoneNumber(lineAddress, nil); validationErr.HasError() {
+				diagErr = append(diagErr, phoneInventoryRowError(row.Row, row.Mac, fmt.Sprintf("line address %s: %s", lineAddress, validationErr[0].Summary)))
+			}
+			checkUnique(row, lineAddresses, "line address", lineAddress)
+		}
+
+		if row.Extension != "" {
+			if !phoneInventoryExtPattern.MatchString(row.Extension) {
+				diagErr = append(diagErr, phoneInventoryRowError(row.Row, row.Mac, fmt.Sprintf("extension %s must be 2-9 digits", row.Extension)))
+			}
+			if row.User == "" {
+				diagErr = append(diagErr, phoneInventoryRowError(row.Row, row.Mac, "extension requires a user"))
+			}
+			checkUnique(row, extensions, "extension", row.Extension)
+		}
+		if row.User != "" {
+			// A user has one default station
+			checkUnique(row, users, "user", row.User)
+		}
+	}
+	return diagErr
+}
+
+func phoneInventoryRowError(row int, mac string, message string) diag.Diagnostic {
+	summary := fmt.Sprintf("Phone inventory row %d", row)
+	if mac != "" {
+		summary = fmt.Sprintf("Phone inventory row %d (%s)", row, mac)
+	}
+	return diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  fmt.Sprintf("%s: %s", summary, message),
+	}
+}
+
+func normalizePhoneMac(mac string) string {
+	return strings.ToLower(phoneInventoryMacSeparators.Replace(mac))
+}
+
+// getPhoneInventoryHashes maps the MAC address of every row to a hash of its content
+func getPhoneInventoryHashes(rows []phoneInventoryRow) map[string]interface{} {
+	hashes := make(map[string]interface{})
+	for _, row := range rows {
+		content, _ := json.Marshal(row)
+		hash := sha256.Sum256(content)
+		hashes[row.Mac] = hex.EncodeToString(hash[:])
+	}
+	return hashes
+}
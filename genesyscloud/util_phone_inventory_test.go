@@ -0,0 +1,139 @@
+package genesyscloud
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v109/platformclientv2"
+)
+
+func TestReadPhoneInventory(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "phones.csv")
+	content := `MAC,Name,Extension,User,Line_Addresses
+00:04:F2:AB:CD:01,Front Desk,1001,ada@example.com,+13175550101;+13175550102
+00-04-f2-ab-cd-02,,,,
+`
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	rows, diagErr := readPhoneInventory(path)
+	if diagErr.HasError() {
+		t.Fatalf("failed to read inventory: %v", diagErr)
+	}
+	expected := []phoneInventoryRow{
+		{Row: 1, Mac: "0004f2abcd01", Name: "Front Desk", Extension: "1001", User: "ada@example.com", LineAddresses: []string{"+13175550101", "+13175550102"}},
+		{Row: 2, Mac: "0004f2abcd02"},
+	}
+	if !reflect.DeepEqual(rows, expected) {
+		t.Errorf("expected rows %+v, got %+v", expected, rows)
+	}
+
+	hashes := getPhoneInventoryHashes(rows)
+	if len(hashes) != 2 || hashes["0004f2abcd01"] == hashes["0004f2abcd02"] {
+		t.Errorf("unexpected hashes %v", hashes)
+	}
+}
+
+func TestReadPhoneInventoryRowErrors(t *testing.T) {
+	if _, diagErr := parsePhoneInventoryCsv([]byte("mac,color\n0004f2abcd01,red\n")); !diagErr.HasError() || diagErr[0].Summary != `Unknown phone inventory column "color". Valid columns are mac, name, extension, user, line_addresses, phone_base_settings` {
+		t.Errorf("unexpected error for unknown column: %v", diagErr)
+	}
+
+	rows, diagErr := parsePhoneInventoryCsv([]byte(`mac,extension,user,line_addresses
+0004f2abcd01,1001,ada@example.com,+13175550101
+0004F2ABCD01,1001,bob@example.com,
+0004f2abcd0z,,ADA@example.com,3175550101
+,1002,,
+0004f2abcd03,1x,,+13175550101
+`))
+	if diagErr.HasError() {
+		t.Fatalf("failed to parse inventory: %v", diagErr)
+	}
+
+	var summaries []string
+	for _, d := range validatePhoneInventory(rows) {
+		summaries = append(summaries, d.Summary)
+	}
+	expected := []string{
+		`Phone inventory row 2 (0004f2abcd01): MAC 0004f2abcd01 is already used in row 1`,
+		`Phone inventory row 2 (0004f2abcd01): extension 1001 is already used in row 1`,
+		`Phone inventory row 3 (0004f2abcd0z): mac must be 12 hex digits, optionally separated by ':', '-' or '.'`,
+		`Phone inventory row 3 (0004f2abcd0z): line address 3175550101: Failed to parse number in an E.164 format.  Passed 3175550101 and expected: +13175550101`,
+		`Phone inventory row 3 (0004f2abcd0z): user ADA@example.com is already used in row 1`,
+		`Phone inventory row 4: mac is required`,
+		`Phone inventory row 5 (0004f2abcd03): line address +13175550101 is already used in row 1`,
+		`Phone inventory row 5 (0004f2abcd03): extension 1x must be 2-9 digits`,
+		`Phone inventory row 5 (0004f2abcd03): extension requires a user`,
+	}
+	if !reflect.DeepEqual(summaries, expected) {
+		t.Errorf("expected validation errors %q, got %q", expected, summaries)
+	}
+}
+
+func TestBuildPhoneInventoryConfig(t *testing.T) {
+	row := phoneInventoryRow{Row: 1, Mac: "0004f2abcd01", User: "user-1", LineAddresses: []string{"+13175550101"}}
+
+	config := buildPhoneInventoryConfig(row, "site-1", "pbs-1", "generic_sip.json", "user-1", false)
+	expected := map[string]interface{}{
+		"name":                   "0004f2abcd01",
+		"site_id":                "site-1",
+		"phone_base_settings_id": "pbs-1",
+		"phone_meta_base_id":     "generic_sip.json",
+		"hardware_id":            "0004f2abcd01",
+		"line_addresses":         []interface{}{"+13175550101"},
+	}
+	if !reflect.DeepEqual(config, expected) {
+		t.Errorf("expected config %v, got %v", expected, config)
+	}
+
+	row.Name = "Softphone"
+	config = buildPhoneInventoryConfig(row, "site-1", "pbs-1", "inin_webrtc_softphone.json", "user-1", true)
+	if config["name"] != "Softphone" || config["web_rtc_user_id"] != "user-1" {
+		t.Errorf("expected a named WebRTC phone for the user, got %v", config)
+	}
+	if !isWebRtcPhoneMetaBase("inin_webrtc_softphone.json") || isWebRtcPhoneMetaBase("generic_sip.json") {
+		t.Error("unexpected WebRTC phone meta base detection")
+	}
+}
+
+func TestSetUserPhoneExtension(t *testing.T) {
+	email := "EMAIL"
+	phone := "PHONE"
+	work := "WORK"
+	address := "+13175550101"
+	addresses := []platformclientv2.Contact{
+		{MediaType: &email, VarType: &work},
+		{MediaType: &phone, VarType: &work, Address: &address},
+	}
+
+	addresses = setUserPhoneExtension(addresses, "1001")
+	if len(addresses) != 2 || addresses[1].Extension == nil || *addresses[1].Extension != "1001" || *addresses[1].Address != address {
+		t.Errorf("expected the work phone address to get the extension, got %+v", addresses)
+	}
+
+	addresses = setUserPhoneExtension(addresses[:1], "1002")
+	if len(addresses) != 2 || *addresses[1].MediaType != phone || *addresses[1].VarType != work || *addresses[1].Extension != "1002" {
+		t.Errorf("expected a work phone address to be added, got %+v", addresses)
+	}
+}
+
+func TestPhoneInventorySettingsResolve(t *testing.T) {
+	settings := phoneInventorySettings{ids: map[string]string{"desk phones": "pbs-1", "pbs-1": "pbs-1"}}
+	if id, err := settings.resolve("Desk Phones"); err != nil || id != "pbs-1" {
+		t.Errorf("expected pbs-1, got %s %v", id, err)
+	}
+	if _, err := settings.resolve(""); err == nil {
+		t.Error("expected an error without default phone base settings")
+	}
+	if _, err := settings.resolve("Softphones"); err == nil || err.Error() != `phone base settings "Softphones" not found` {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	settings.defaultId = "pbs-2"
+	if id, _ := settings.resolve(""); id != "pbs-2" {
+		t.Errorf("expected the default phone base settings, got %s", id)
+	}
+}
@@ -0,0 +1,427 @@
+package genesyscloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+	lists "terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gopkg.in/yaml.v3"
+)
+
+// qualityFormDefinition describes the question groups of an evaluation or survey form in a YAML or JSON file.
+// Keys mirror the question_groups attributes of the quality form resources.
+type qualityFormDefinition struct {
+	QuestionGroups []qualityFormQuestionGroupDefinition `json:"question_groups" yaml:"question_groups"`
+}
+
+type qualityFormQuestionGroupDefinition struct {
+	Name                    string                                    `json:"name" yaml:"name"`
+	DefaultAnswersToHighest bool                                      `json:"default_answers_to_highest" yaml:"default_answers_to_highest"`
+	DefaultAnswersToNA      bool                                      `json:"default_answers_to_na" yaml:"default_answers_to_na"`
+	NaEnabled               bool                                      `json:"na_enabled" yaml:"na_enabled"`
+	Weight                  *float64                                  `json:"weight" yaml:"weight"`
+	ManualWeight            *bool                                     `json:"manual_weight" yaml:"manual_weight"`
+	Questions               []qualityFormQuestionDefinition           `json:"questions" yaml:"questions"`
+	VisibilityCondition     *qualityFormVisibilityConditionDefinition `json:"visibility_condition" yaml:"visibility_condition"`
+}
+
+type qualityFormQuestionDefinition struct {
+	Text                  string                                    `json:"text" yaml:"text"`
+	HelpText              string                                    `json:"help_text" yaml:"help_text"`
+	Type                  string                                    `json:"type" yaml:"type"`
+	NaEnabled             bool                                      `json:"na_enabled" yaml:"na_enabled"`
+	CommentsRequired      bool                                      `json:"comments_required" yaml:"comments_required"`
+	IsKill                bool                                      `json:"is_kill" yaml:"is_kill"`
+	IsCritical            bool                                      `json:"is_critical" yaml:"is_critical"`
+	MaxResponseCharacters int                                       `json:"max_response_characters" yaml:"max_response_characters"`
+	ExplanationPrompt     string                                    `json:"explanation_prompt" yaml:"explanation_prompt"`
+	AnswerOptions         []qualityFormAnswerOptionDefinition       `json:"answer_options" yaml:"answer_options"`
+	VisibilityCondition   *qualityFormVisibilityConditionDefinition `json:"visibility_condition" yaml:"visibility_condition"`
+}
+
+type qualityFormAnswerOptionDefinition struct {
+	Text  string `json:"text" yaml:"text"`
+	Value *int   `json:"value" yaml:"value"`
+}
+
+type qualityFormVisibilityConditionDefinition struct {
+	CombiningOperation string   `json:"combining_operation" yaml:"combining_operation"`
+	Predicates         []string `json:"predicates" yaml:"predicates"`
+}
+
+var (
+	absoluteFormPredicateRegex = regexp.MustCompile(`^/form/questionGroup/(\d+)/question/(\d+)/answer/(\d+)$`)
+	relativeFormPredicateRegex = regexp.MustCompile(`^\.\./question/(\d+)/answer/(\d+)$`)
+
+	surveyQuestionTypes = []string{"multipleChoiceQuestion", "freeTextQuestion", "npsQuestion", "readOnlyTextBlockQuestion"}
+)
+
+// readQualityFormDefinition loads a form definition from a local path or URL. Files ending in .json are decoded
+// as JSON, anything else is decoded as YAML.
+func readQualityFormDefinition(path string) (*qualityFormDefinition, error) {
+	reader, file, err := files.DownloadOrOpenFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if file != nil {
+		defer file.Close()
+	}
+
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read form definition %s: %v", path, err)
+	}
+
+	return parseQualityFormDefinition(content, strings.EqualFold(filepath.Ext(path), ".json"))
+}
+
+func parseQualityFormDefinition(content []byte, isJson bool) (*qualityFormDefinition, error) {
+	var definition qualityFormDefinition
+	if isJson {
+		decoder := json.NewDecoder(strings.NewReader(string(content)))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&definition); err != nil {
+			return nil, fmt.Errorf("failed to parse JSON form definition: %v", err)
+		}
+	} else {
+		decoder := yaml.NewDecoder(strings.NewReader(string(content)))
+		decoder.KnownFields(true)
+		if err := decoder.Decode(&definition); err != nil {
+			return nil, fmt.Errorf("failed to parse YAML form definition: %v", err)
+		}
+	}
+	if len(definition.QuestionGroups) == 0 {
+		return nil, fmt.Errorf("form definition must contain at least one question group")
+	}
+	return &definition, nil
+}
+
+// validateEvaluationFormDefinition checks the weights, answers, kill/critical flags and visibility predicates of
+// an evaluation form definition and returns every problem found.
+func validateEvaluationFormDefinition(definition *qualityFormDefinition) []error {
+	var errs []error
+	var totalWeight float64
+	for g, group := range definition.QuestionGroups {
+		groupPath := fmt.Sprintf("question_groups[%d]", g)
+		if group.Name == "" {
+			errs = append(errs, fmt.Errorf("%s: name is required", groupPath))
+		}
+		if group.Weight == nil {
+			errs = append(errs, fmt.Errorf("%s: weight is required", groupPath))
+		} else if *group.Weight < 0 {
+			errs = append(errs, fmt.Errorf("%s: weight must not be negative, got %v", groupPath, *group.Weight))
+		} else {
+			totalWeight += *group.Weight
+		}
+		if len(group.Questions) == 0 {
+			errs = append(errs, fmt.Errorf("%s: at least one question is required", groupPath))
+		}
+
+		for q, question := range group.Questions {
+			questionPath := fmt.Sprintf("%s.questions[%d]", groupPath, q)
+			if question.Type != "" && question.Type != "multipleChoiceQuestion" {
+				errs = append(errs, fmt.Errorf("%s: evaluation form questions must be of type multipleChoiceQuestion, got %s", questionPath, question.Type))
+			}
+			if question.MaxResponseCharacters != 0 || question.ExplanationPrompt != "" {
+				errs = append(errs, fmt.Errorf("%s: max_response_characters and explanation_prompt are only supported on survey forms", questionPath))
+			}
+			errs = append(errs, validateQualityFormQuestionText(questionPath, question)...)
+			errs = append(errs, validateQualityFormAnswerOptions(questionPath, question.AnswerOptions, 2)...)
+			if (question.IsKill || question.IsCritical) && !hasDistinctAnswerValues(question.AnswerOptions) {
+				errs = append(errs, fmt.Errorf("%s: kill and critical questions need answer options with different values so that a failing answer can be identified", questionPath))
+			}
+			errs = append(errs, validateQualityFormVisibilityCondition(questionPath, question.VisibilityCondition, definition, g)...)
+		}
+		errs = append(errs, validateQualityFormVisibilityCondition(groupPath, group.VisibilityCondition, definition, g)...)
+	}
+	if len(errs) == 0 && totalWeight == 0 {
+		errs = append(errs, fmt.Errorf("at least one question group must have a weight greater than zero"))
+	}
+	return errs
+}
+
+// validateSurveyFormDefinition checks the question types, answers and visibility predicates of a survey form definition
+// and returns every problem found.
+func validateSurveyFormDefinition(definition *qualityFormDefinition) []error {
+	var errs []error
+	for g, group := range definition.QuestionGroups {
+		groupPath := fmt.Sprintf("question_groups[%d]", g)
+		if group.Name == "" {
+			errs = append(errs, fmt.Errorf("%s: name is required", groupPath))
+		}
+		if group.Weight != nil || group.ManualWeight != nil || group.DefaultAnswersToHighest || group.DefaultAnswersToNA {
+			errs = append(errs, fmt.Errorf("%s: weights and default answers are only supported on evaluation forms", groupPath))
+		}
+		if len(group.Questions) == 0 {
+			errs = append(errs, fmt.Errorf("%s: at least one question is required", groupPath))
+		}
+
+		for q, question := range group.Questions {
+			questionPath := fmt.Sprintf("%s.questions[%d]", groupPath, q)
+			questionType := surveyQuestionType(question)
+			if !lists.ItemInSlice(questionType, surveyQuestionTypes) {
+				errs = append(errs, fmt.Errorf("%s: type must be one of %s, got %s", questionPath, strings.Join(surveyQuestionTypes, ", "), questionType))
+			}
+			if question.IsKill || question.IsCritical || question.CommentsRequired {
+				errs = append(errs, fmt.Errorf("%s: is_kill, is_critical and comments_required are only supported on evaluation forms", questionPath))
+			}
+			errs = append(errs, validateQualityFormQuestionText(questionPath, question)...)
+			switch questionType {
+			case "multipleChoiceQuestion":
+				errs = append(errs, validateQualityFormAnswerOptions(questionPath, question.AnswerOptions, 2)...)
+			case "npsQuestion":
+				errs = append(errs, validateQualityFormAnswerOptions(questionPath, question.AnswerOptions, 0)...)
+			default:
+				if len(question.AnswerOptions) > 0 {
+					errs = append(errs, fmt.Errorf("%s: answer_options are not supported on %s", questionPath, questionType))
+				}
+			}
+			if question.MaxResponseCharacters < 0 {
+				errs = append(errs, fmt.Errorf("%s: max_response_characters must not be negative", questionPath))
+			}
+			errs = append(errs, validateQualityFormVisibilityCondition(questionPath, question.VisibilityCondition, definition, g)...)
+		}
+		errs = append(errs, validateQualityFormVisibilityCondition(groupPath, group.VisibilityCondition, definition, g)...)
+	}
+	return errs
+}
+
+func validateQualityFormQuestionText(questionPath string, question qualityFormQuestionDefinition) []error {
+	if question.Text == "" {
+		return []error{fmt.Errorf("%s: text is required", questionPath)}
+	}
+	return nil
+}
+
+func validateQualityFormAnswerOptions(questionPath string, answerOptions []qualityFormAnswerOptionDefinition, minOptions int) []error {
+	var errs []error
+	if len(answerOptions) < minOptions {
+		errs = append(errs, fmt.Errorf("%s: at least %d answer options are required, got %d", questionPath, minOptions, len(answerOptions)))
+	}
+	seenText := make(map[string]bool)
+	for a, answer := range answerOptions {
+		answerPath := fmt.Sprintf("%s.answer_options[%d]", questionPath, a)
+		if answer.Text == "" {
+			errs = append(errs, fmt.Errorf("%s: text is required", answerPath))
+		} else if seenText[answer.Text] {
+			errs = append(errs, fmt.Errorf("%s: duplicate answer text %q", answerPath, answer.Text))
+		}
+		seenText[answer.Text] = true
+		if answer.Value == nil {
+			errs = append(errs, fmt.Errorf("%s: value is required", answerPath))
+		} else if *answer.Value < 0 {
+			errs = append(errs, fmt.Errorf("%s: value must not be negative, got %d", answerPath, *answer.Value))
+		}
+	}
+	return errs
+}
+
+func hasDistinctAnswerValues(answerOptions []qualityFormAnswerOptionDefinition) bool {
+	var first *int
+	for _, answer := range answerOptions {
+		if answer.Value == nil {
+			continue
+		}
+		if first == nil {
+			first = answer.Value
+		} else if *first != *answer.Value {
+			return true
+		}
+	}
+	return false
+}
+
+// validateQualityFormVisibilityCondition makes sure every predicate points at an answer option that exists in the form.
+func validateQualityFormVisibilityCondition(path string, condition *qualityFormVisibilityConditionDefinition, definition *qualityFormDefinition, currentGroup int) []error {
+	if condition == nil {
+		return nil
+	}
+
+	var errs []error
+	conditionPath := path + ".visibility_condition"
+	if condition.CombiningOperation != "AND" && condition.CombiningOperation != "OR" {
+		errs = append(errs, fmt.Errorf("%s: combining_operation must be AND or OR, got %q", conditionPath, condition.CombiningOperation))
+	}
+	if len(condition.Predicates) == 0 {
+		errs = append(errs, fmt.Errorf("%s: at least one predicate is required", conditionPath))
+	}
+	for _, predicate := range condition.Predicates {
+		groupIndex, questionIndex, answerIndex := currentGroup, 0, 0
+		if match := absoluteFormPredicateRegex.FindStringSubmatch(predicate); match != nil {
+			groupIndex, _ = strconv.Atoi(match[1])
+			questionIndex, _ = strconv.Atoi(match[2])
+			answerIndex, _ = strconv.Atoi(match[3])
+		} else if match := relativeFormPredicateRegex.FindStringSubmatch(predicate); match != nil {
+			questionIndex, _ = strconv.Atoi(match[1])
+			answerIndex, _ = strconv.Atoi(match[2])
+		} else {
+			errs = append(errs, fmt.Errorf("%s: predicate %q is not in the format /form/questionGroup/{g}/question/{q}/answer/{a} or ../question/{q}/answer/{a}", conditionPath, predicate))
+			continue
+		}
+
+		if groupIndex >= len(definition.QuestionGroups) {
+			errs = append(errs, fmt.Errorf("%s: predicate %q references question group %d which does not exist", conditionPath, predicate, groupIndex))
+			continue
+		}
+		questions := definition.QuestionGroups[groupIndex].Questions
+		if questionIndex >= len(questions) {
+			errs = append(errs, fmt.Errorf("%s: predicate %q references question %d which does not exist in question group %d", conditionPath, predicate, questionIndex, groupIndex))
+			continue
+		}
+		if answerIndex >= len(questions[questionIndex].AnswerOptions) {
+			errs = append(errs, fmt.Errorf("%s: predicate %q references answer option %d which does not exist", conditionPath, predicate, answerIndex))
+		}
+	}
+	return errs
+}
+
+func surveyQuestionType(question qualityFormQuestionDefinition) string {
+	if question.Type == "" {
+		return "multipleChoiceQuestion"
+	}
+	return question.Type
+}
+
+// evaluationFormDefinitionToQuestionGroups maps a definition onto the question_groups schema of
+// genesyscloud_quality_forms_evaluation so the existing build functions can be reused.
+func evaluationFormDefinitionToQuestionGroups(definition *qualityFormDefinition) []interface{} {
+	questionGroups := make([]interface{}, 0, len(definition.QuestionGroups))
+	for _, group := range definition.QuestionGroups {
+		manualWeight := true
+		if group.ManualWeight != nil {
+			manualWeight = *group.ManualWeight
+		}
+		var weight float64
+		if group.Weight != nil {
+			weight = *group.Weight
+		}
+
+		questions := make([]interface{}, 0, len(group.Questions))
+		for _, question := range group.Questions {
+			questions = append(questions, map[string]interface{}{
+				"text":                 question.Text,
+				"help_text":            question.HelpText,
+				"na_enabled":           question.NaEnabled,
+				"comments_required":    question.CommentsRequired,
+				"is_kill":              question.IsKill,
+				"is_critical":          question.IsCritical,
+				"answer_options":       qualityFormAnswerOptionsToList(question.AnswerOptions),
+				"visibility_condition": qualityFormVisibilityConditionToList(question.VisibilityCondition),
+			})
+		}
+
+		questionGroups = append(questionGroups, map[string]interface{}{
+			"name":                       group.Name,
+			"default_answers_to_highest": group.DefaultAnswersToHighest,
+			"default_answers_to_na":      group.DefaultAnswersToNA,
+			"na_enabled":                 group.NaEnabled,
+			"weight":                     weight,
+			"manual_weight":              manualWeight,
+			"questions":                  questions,
+			"visibility_condition":       qualityFormVisibilityConditionToList(group.VisibilityCondition),
+		})
+	}
+	return questionGroups
+}
+
+// surveyFormDefinitionToQuestionGroups maps a definition onto the question_groups schema of
+// genesyscloud_quality_forms_survey so the existing build functions can be reused.
+func surveyFormDefinitionToQuestionGroups(definition *qualityFormDefinition) []interface{} {
+	questionGroups := make([]interface{}, 0, len(definition.QuestionGroups))
+	for _, group := range definition.QuestionGroups {
+		questions := make([]interface{}, 0, len(group.Questions))
+		for _, question := range group.Questions {
+			questions = append(questions, map[string]interface{}{
+				"text":                    question.Text,
+				"help_text":               question.HelpText,
+				"type":                    surveyQuestionType(question),
+				"na_enabled":              question.NaEnabled,
+				"max_response_characters": question.MaxResponseCharacters,
+				"explanation_prompt":      question.ExplanationPrompt,
+				"answer_options":          qualityFormAnswerOptionsToList(question.AnswerOptions),
+				"visibility_condition":    qualityFormVisibilityConditionToList(question.VisibilityCondition),
+			})
+		}
+
+		questionGroups = append(questionGroups, map[string]interface{}{
+			"name":                 group.Name,
+			"na_enabled":           group.NaEnabled,
+			"questions":            questions,
+			"visibility_condition": qualityFormVisibilityConditionToList(group.VisibilityCondition),
+		})
+	}
+	return questionGroups
+}
+
+func qualityFormAnswerOptionsToList(answerOptions []qualityFormAnswerOptionDefinition) []interface{} {
+	answerOptionList := make([]interface{}, 0, len(answerOptions))
+	for _, answer := range answerOptions {
+		var value int
+		if answer.Value != nil {
+			value = *answer.Value
+		}
+		answerOptionList = append(answerOptionList, map[string]interface{}{
+			"text":  answer.Text,
+			"value": value,
+		})
+	}
+	return answerOptionList
+}
+
+func qualityFormVisibilityConditionToList(condition *qualityFormVisibilityConditionDefinition) []interface{} {
+	if condition == nil {
+		return []interface{}{}
+	}
+	predicates := make([]interface{}, 0, len(condition.Predicates))
+	for _, predicate := range condition.Predicates {
+		predicates = append(predicates, predicate)
+	}
+	return []interface{}{map[string]interface{}{
+		"combining_operation": condition.CombiningOperation,
+		"predicates":          predicates,
+	}}
+}
+
+// formatQualityFormDefinitionErrors joins validation errors into a single message naming the definition file.
+func formatQualityFormDefinitionErrors(path string, errs []error) error {
+	messages := make([]string, 0, len(errs))
+	for _, err := range errs {
+		messages = append(messages, "  - "+err.Error())
+	}
+	return fmt.Errorf("invalid form definition %s:\n%s", path, strings.Join(messages, "\n"))
+}
+
+// validateEvaluationFormDefinitionDiff reports errors in an evaluation form definition file at plan time
+func validateEvaluationFormDefinitionDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	return validateQualityFormDefinitionDiff(diff, validateEvaluationFormDefinition)
+}
+
+// validateSurveyFormDefinitionDiff reports errors in a survey form definition file at plan time
+func validateSurveyFormDefinitionDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	return validateQualityFormDefinitionDiff(diff, validateSurveyFormDefinition)
+}
+
+func validateQualityFormDefinitionDiff(diff *schema.ResourceDiff, validate func(*qualityFormDefinition) []error) error {
+	definitionPath, ok := diff.GetOk("definition_filepath")
+	if !ok {
+		return nil
+	}
+
+	definition, err := readQualityFormDefinition(definitionPath.(string))
+	if err != nil {
+		return err
+	}
+	if errs := validate(definition); len(errs) > 0 {
+		return formatQualityFormDefinitionErrors(definitionPath.(string), errs)
+	}
+	return nil
+}
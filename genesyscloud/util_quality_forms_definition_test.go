@@ -0,0 +1,159 @@
+package genesyscloud
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestParseEvaluationFormDefinition(t *testing.T) {
+	definitionPath := filepath.Join("..", "test", "data", "resource", "genesyscloud_quality_forms_evaluation", "definition.yaml")
+	definition, err := readQualityFormDefinition(definitionPath)
+	if err != nil {
+		t.Fatalf("failed to read evaluation form definition: %v", err)
+	}
+	if errs := validateEvaluationFormDefinition(definition); len(errs) > 0 {
+		t.Fatalf("expected definition to be valid, got %v", errs)
+	}
+
+	questionGroups := evaluationFormDefinitionToQuestionGroups(definition)
+	if len(questionGroups) != 2 {
+		t.Fatalf("expected 2 question groups, got %d", len(questionGroups))
+	}
+
+	group := questionGroups[1].(map[string]interface{})
+	if group["weight"].(float64) != 2 {
+		t.Errorf("expected weight 2, got %v", group["weight"])
+	}
+	if group["manual_weight"].(bool) != true {
+		t.Errorf("expected manual_weight to default to true")
+	}
+	question := group["questions"].([]interface{})[0].(map[string]interface{})
+	if question["is_kill"].(bool) != true {
+		t.Errorf("expected is_kill to be true")
+	}
+
+	sdkQuestions := buildSdkQuestions(group["questions"].([]interface{}))
+	if len(*(*sdkQuestions)[0].AnswerOptions) != 3 {
+		t.Errorf("expected 3 answer options, got %d", len(*(*sdkQuestions)[0].AnswerOptions))
+	}
+}
+
+func TestParseSurveyFormDefinition(t *testing.T) {
+	definitionPath := filepath.Join("..", "test", "data", "resource", "genesyscloud_quality_forms_survey", "definition.json")
+	definition, err := readQualityFormDefinition(definitionPath)
+	if err != nil {
+		t.Fatalf("failed to read survey form definition: %v", err)
+	}
+	if errs := validateSurveyFormDefinition(definition); len(errs) > 0 {
+		t.Fatalf("expected definition to be valid, got %v", errs)
+	}
+
+	questions := surveyFormDefinitionToQuestionGroups(definition)[0].(map[string]interface{})["questions"].([]interface{})
+	if questionType := questions[1].(map[string]interface{})["type"]; questionType != "multipleChoiceQuestion" {
+		t.Errorf("expected question type to default to multipleChoiceQuestion, got %v", questionType)
+	}
+
+	sdkQuestions := buildSurveyQuestions(questions)
+	if *(*sdkQuestions)[0].ExplanationPrompt != "Why did you choose that score?" {
+		t.Errorf("unexpected explanation prompt %s", *(*sdkQuestions)[0].ExplanationPrompt)
+	}
+}
+
+func TestValidateEvaluationFormDefinition(t *testing.T) {
+	definition, err := parseQualityFormDefinition([]byte(`
+question_groups:
+  - name: Group
+    weight: -1
+    questions:
+      - text: Single answer
+        is_kill: true
+        answer_options:
+          - text: "Yes"
+            value: 1
+      - text: Same values
+        is_critical: true
+        visibility_condition:
+          combining_operation: XOR
+          predicates:
+            - /form/questionGroup/3/question/0/answer/0
+            - ../question/0/answer/5
+            - not-a-predicate
+        answer_options:
+          - text: "Yes"
+            value: 1
+          - text: "Yes"
+            value: 1
+`), false)
+	if err != nil {
+		t.Fatalf("failed to parse definition: %v", err)
+	}
+
+	errs := validateEvaluationFormDefinition(definition)
+	expected := []string{
+		"question_groups[0]: weight must not be negative",
+		"question_groups[0].questions[0]: at least 2 answer options are required",
+		"question_groups[0].questions[0]: kill and critical questions need answer options with different values",
+		"question_groups[0].questions[1].answer_options[1]: duplicate answer text",
+		"question_groups[0].questions[1]: kill and critical questions need answer options with different values",
+		"question_groups[0].questions[1].visibility_condition: combining_operation must be AND or OR",
+		"references question group 3 which does not exist",
+		"references answer option 5 which does not exist",
+		"predicate \"not-a-predicate\" is not in the format",
+	}
+	for _, message := range expected {
+		if !containsError(errs, message) {
+			t.Errorf("expected an error containing %q, got %v", message, errs)
+		}
+	}
+}
+
+func TestValidateSurveyFormDefinition(t *testing.T) {
+	definition, err := parseQualityFormDefinition([]byte(`{
+  "question_groups": [
+    {
+      "name": "Group",
+      "weight": 1,
+      "questions": [
+        { "text": "Bad type", "type": "rankingQuestion" },
+        { "text": "Free text", "type": "freeTextQuestion", "answer_options": [{ "text": "Yes", "value": 1 }] },
+        { "text": "Kill", "is_kill": true, "answer_options": [{ "text": "Yes", "value": 1 }, { "text": "No", "value": 0 }] }
+      ]
+    }
+  ]
+}`), true)
+	if err != nil {
+		t.Fatalf("failed to parse definition: %v", err)
+	}
+
+	errs := validateSurveyFormDefinition(definition)
+	expected := []string{
+		"question_groups[0]: weights and default answers are only supported on evaluation forms",
+		"question_groups[0].questions[0]: type must be one of",
+		"question_groups[0].questions[1]: answer_options are not supported on freeTextQuestion",
+		"question_groups[0].questions[2]: is_kill, is_critical and comments_required are only supported on evaluation forms",
+	}
+	for _, message := range expected {
+		if !containsError(errs, message) {
+			t.Errorf("expected an error containing %q, got %v", message, errs)
+		}
+	}
+}
+
+func TestParseQualityFormDefinitionUnknownField(t *testing.T) {
+	if _, err := parseQualityFormDefinition([]byte("question_groups:\n  - name: Group\n    wieght: 1\n"), false); err == nil {
+		t.Errorf("expected an error for a misspelled key")
+	}
+	if _, err := parseQualityFormDefinition([]byte(`{"question_groups": []}`), true); err == nil {
+		t.Errorf("expected an error for a definition without question groups")
+	}
+}
+
+func containsError(errs []error, message string) bool {
+	for _, err := range errs {
+		if strings.Contains(err.Error(), message) {
+			return true
+		}
+	}
+	return false
+}
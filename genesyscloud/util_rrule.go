@@ -0,0 +1,483 @@
+package genesyscloud
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	// Time zones are embedded so they can be validated on hosts without a time zone database
+	_ "time/tzdata"
+
+	lists "terraform-provider-genesyscloud/genesyscloud/util/lists"
+	"terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	rruleFreqDaily   = "DAILY"
+	rruleFreqWeekly  = "WEEKLY"
+	rruleFreqMonthly = "MONTHLY"
+	rruleFreqYearly  = "YEARLY"
+
+	// Number of windows listed in next_occurrences
+	nextOccurrencesCount = 5
+
+	// Occurrences are searched for up to 100 years after the start of a rule
+	rruleSearchDays = 100 * 366
+
+	// Number of windows from the start of a rule that are checked for overlaps
+	rruleOverlapCheckCount = 100
+)
+
+var rruleWeekdays = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+// recurrenceRule is a parsed iCal RRULE. Dates and times are local to the time zone of the rule.
+type recurrenceRule struct {
+	Freq       string
+	Interval   int
+	Count      int
+	Until      *time.Time
+	WeekStart  time.Weekday
+	ByDay      []recurrenceWeekday
+	ByMonthDay []int
+	ByMonth    []time.Month
+}
+
+// recurrenceWeekday is a BYDAY entry. A non-zero ordinal selects e.g. the 2nd (2) or last (-1) weekday of the month.
+type recurrenceWeekday struct {
+	Weekday time.Weekday
+	Ordinal int
+}
+
+// recurrenceWindow is one occurrence of a recurring window
+type recurrenceWindow struct {
+	Start time.Time
+	End   time.Time
+}
+
+// parseRecurrenceFreq reads the frequency of an RRULE and checks it is one of the given frequencies. This is the only
+// part of a rule that is validated, other parts are left for the API to check.
+func parseRecurrenceFreq(value string, supportedFreqs ...string) (string, error) {
+	value = strings.TrimPrefix(strings.TrimSpace(value), "RRULE:")
+	if value == "" {
+		return "", fmt.Errorf("RRULE is empty")
+	}
+	freq := ""
+	for _, part := range strings.Split(value, ";") {
+		key, partValue, _ := strings.Cut(part, "=")
+		if strings.EqualFold(strings.TrimSpace(key), "FREQ") {
+			freq = strings.ToUpper(strings.TrimSpace(partValue))
+			break
+		}
+	}
+	if freq == "" {
+		return "", fmt.Errorf("RRULE must set FREQ")
+	}
+	if !lists.ItemInSlice(freq, supportedFreqs) {
+		return "", fmt.Errorf("RRULE frequency %s is not supported. Supported frequencies are %s", freq, strings.Join(supportedFreqs, ", "))
+	}
+	return freq, nil
+}
+
+// parseRecurrenceRule parses an RRULE such as FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE so its windows can be computed. Only
+// the given frequencies are accepted. BYSETPOS, the BY rules for hours, minutes, seconds, weeks and days of the year,
+// and BYMONTHDAY on WEEKLY rules are not supported. Callers skip the windows of rules that cannot be parsed.
+func parseRecurrenceRule(value string, supportedFreqs ...string) (*recurrenceRule, error) {
+	freq, err := parseRecurrenceFreq(value, supportedFreqs...)
+	if err != nil {
+		return nil, err
+	}
+	rule := &recurrenceRule{Freq: freq, Interval: 1, WeekStart: time.Monday}
+	value = strings.TrimPrefix(strings.TrimSpace(value), "RRULE:")
+
+	seen := make(map[string]bool)
+	for _, part := range strings.Split(value, ";") {
+		if part == "" {
+			continue
+		}
+		key, partValue, ok := strings.Cut(part, "=")
+		key = strings.ToUpper(strings.TrimSpace(key))
+		partValue = strings.ToUpper(strings.TrimSpace(partValue))
+		if !ok || partValue == "" {
+			return nil, fmt.Errorf("RRULE part %q must be of the form NAME=VALUE", part)
+		}
+		if seen[key] {
+			return nil, fmt.Errorf("RRULE part %s is set more than once", key)
+		}
+		seen[key] = true
+
+		switch key {
+		case "FREQ":
+			// Read by parseRecurrenceFreq
+		case "INTERVAL":
+			rule.Interval, err = parseRecurrencePositiveInt(key, partValue)
+		case "COUNT":
+			rule.Count, err = parseRecurrencePositiveInt(key, partValue)
+		case "UNTIL":
+			rule.Until, err = parseRecurrenceUntil(partValue)
+		case "WKST":
+			weekday, ok := rruleWeekdays[partValue]
+			if !ok {
+				return nil, fmt.Errorf("WKST %s is not a weekday", partValue)
+			}
+			rule.WeekStart = weekday
+		case "BYDAY":
+			rule.ByDay, err = parseRecurrenceByDay(partValue)
+		case "BYMONTHDAY":
+			rule.ByMonthDay, err = parseRecurrenceIntList(key, partValue, -31, 31)
+		case "BYMONTH":
+			var months []int
+			months, err = parseRecurrenceIntList(key, partValue, 1, 12)
+			for _, month := range months {
+				rule.ByMonth = append(rule.ByMonth, time.Month(month))
+			}
+		default:
+			return nil, fmt.Errorf("RRULE part %s is not supported", key)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if rule.Count > 0 && rule.Until != nil {
+		return nil, fmt.Errorf("RRULE cannot set both COUNT and UNTIL")
+	}
+	for _, weekday := range rule.ByDay {
+		if weekday.Ordinal != 0 && rule.Freq != rruleFreqMonthly && rule.Freq != rruleFreqYearly {
+			return nil, fmt.Errorf("BYDAY ordinals such as %d%s are only supported by MONTHLY and YEARLY rules", weekday.Ordinal, weekdayCode(weekday.Weekday))
+		}
+	}
+	if rule.Freq == rruleFreqWeekly && len(rule.ByMonthDay) > 0 {
+		return nil, fmt.Errorf("BYMONTHDAY is not supported by WEEKLY rules")
+	}
+	return rule, nil
+}
+
+func parseRecurrencePositiveInt(key string, value string) (int, error) {
+	number, err := strconv.Atoi(value)
+	if err != nil || number < 1 {
+		return 0, fmt.Errorf("%s %s must be a positive whole number", key, value)
+	}
+	return number, nil
+}
+
+func parseRecurrenceIntList(key string, value string, min int, max int) ([]int, error) {
+	var numbers []int
+	for _, item := range strings.Split(value, ",") {
+		number, err := strconv.Atoi(strings.TrimSpace(item))
+		if err != nil || number == 0 || number < min || number > max {
+			return nil, fmt.Errorf("%s value %s must be a whole number from %d to %d other than 0", key, item, min, max)
+		}
+		numbers = append(numbers, number)
+	}
+	return numbers, nil
+}
+
+func parseRecurrenceByDay(value string) ([]recurrenceWeekday, error) {
+	var weekdays []recurrenceWeekday
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if len(item) < 2 {
+			return nil, fmt.Errorf("BYDAY value %q is not a weekday", item)
+		}
+		weekday, ok := rruleWeekdays[item[len(item)-2:]]
+		if !ok {
+			return nil, fmt.Errorf("BYDAY value %q is not a weekday", item)
+		}
+		ordinal := 0
+		if prefix := item[:len(item)-2]; prefix != "" {
+			var err error
+			if ordinal, err = strconv.Atoi(prefix); err != nil || ordinal == 0 || ordinal < -53 || ordinal > 53 {
+				return nil, fmt.Errorf("BYDAY value %q has an invalid ordinal", item)
+			}
+		}
+		weekdays = append(weekdays, recurrenceWeekday{Weekday: weekday, Ordinal: ordinal})
+	}
+	return weekdays, nil
+}
+
+// parseRecurrenceUntil reads an UNTIL date or date time. UTC date times are compared as if they were local.
+func parseRecurrenceUntil(value string) (*time.Time, error) {
+	for _, layout := range []string{"20060102T150405Z", "20060102T150405", "20060102"} {
+		if until, err := time.Parse(layout, value); err == nil {
+			if layout == "20060102" {
+				// The whole day is included
+				until = until.Add(24*time.Hour - time.Nanosecond)
+			}
+			return &until, nil
+		}
+	}
+	return nil, fmt.Errorf("UNTIL %s must be a date such as 20240131 or a date time such as 20240131T235959Z", value)
+}
+
+// forEachOccurrence calls yield with the start of every occurrence from the start of the rule until yield returns false
+func (r *recurrenceRule) forEachOccurrence(start time.Time, yield func(occurrence time.Time) bool) {
+	startDate := truncateToDate(start)
+	timeOfDay := start.Sub(startDate)
+	count := 0
+	for i := 0; i < rruleSearchDays; i++ {
+		day := startDate.AddDate(0, 0, i)
+		if !r.matches(day, startDate) {
+			continue
+		}
+		occurrence := day.Add(timeOfDay)
+		if r.Until != nil && occurrence.After(*r.Until) {
+			return
+		}
+		count++
+		if !yield(occurrence) {
+			return
+		}
+		if r.Count > 0 && count >= r.Count {
+			return
+		}
+	}
+}
+
+// matches checks if a rule occurs on a day
+func (r *recurrenceRule) matches(day time.Time, startDate time.Time) bool {
+	switch r.Freq {
+	case rruleFreqDaily:
+		if daysBetween(startDate, day)%r.Interval != 0 {
+			return false
+		}
+	case rruleFreqWeekly:
+		weeks := daysBetween(r.weekStartDate(startDate), r.weekStartDate(day)) / 7
+		if weeks%r.Interval != 0 {
+			return false
+		}
+	case rruleFreqMonthly:
+		months := (day.Year()-startDate.Year())*12 + int(day.Month()) - int(startDate.Month())
+		if months%r.Interval != 0 {
+			return false
+		}
+	case rruleFreqYearly:
+		if (day.Year()-startDate.Year())%r.Interval != 0 {
+			return false
+		}
+	}
+
+	if len(r.ByMonth) > 0 && !monthInList(day.Month(), r.ByMonth) {
+		return false
+	}
+	if len(r.ByMonthDay) > 0 && !r.matchesMonthDay(day) {
+		return false
+	}
+	if len(r.ByDay) > 0 && !r.matchesWeekday(day) {
+		return false
+	}
+
+	// Without BY rules, the start of the rule selects the day
+	hasDay := len(r.ByDay) > 0 || len(r.ByMonthDay) > 0
+	switch r.Freq {
+	case rruleFreqWeekly:
+		return hasDay || day.Weekday() == startDate.Weekday()
+	case rruleFreqMonthly:
+		return hasDay || day.Day() == startDate.Day()
+	case rruleFreqYearly:
+		if len(r.ByMonth) == 0 && !hasDay {
+			return day.Month() == startDate.Month() && day.Day() == startDate.Day()
+		}
+		return hasDay || day.Day() == startDate.Day()
+	}
+	return true
+}
+
+func (r *recurrenceRule) matchesMonthDay(day time.Time) bool {
+	daysInMonth := time.Date(day.Year(), day.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	for _, monthDay := range r.ByMonthDay {
+		if monthDay == day.Day() || (monthDay < 0 && daysInMonth+monthDay+1 == day.Day()) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesWeekday checks the BYDAY rule. Ordinals count within the month, or within the year for YEARLY rules
+// without BYMONTH.
+func (r *recurrenceRule) matchesWeekday(day time.Time) bool {
+	for _, weekday := range r.ByDay {
+		if weekday.Weekday != day.Weekday() {
+			continue
+		}
+		if weekday.Ordinal == 0 {
+			return true
+		}
+
+		periodStart := time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, time.UTC)
+		periodEnd := periodStart.AddDate(0, 1, -1)
+		if r.Freq == rruleFreqYearly && len(r.ByMonth) == 0 {
+			periodStart = time.Date(day.Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+			periodEnd = time.Date(day.Year(), time.December, 31, 0, 0, 0, 0, time.UTC)
+		}
+		if weekday.Ordinal > 0 && daysBetween(periodStart, day)/7+1 == weekday.Ordinal {
+			return true
+		}
+		if weekday.Ordinal < 0 && -(daysBetween(day, periodEnd)/7+1) == weekday.Ordinal {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *recurrenceRule) weekStartDate(day time.Time) time.Time {
+	offset := (int(day.Weekday()) - int(r.WeekStart) + 7) % 7
+	return day.AddDate(0, 0, -offset)
+}
+
+// getRecurrenceWindows returns up to count windows that end after a time. Without a rule there is a single window.
+func getRecurrenceWindows(rule *recurrenceRule, start time.Time, end time.Time, after time.Time, count int) []recurrenceWindow {
+	duration := end.Sub(start)
+	windows := make([]recurrenceWindow, 0, count)
+	if rule == nil {
+		if end.After(after) && count > 0 {
+			windows = append(windows, recurrenceWindow{Start: start, End: end})
+		}
+		return windows
+	}
+
+	rule.forEachOccurrence(start, func(occurrence time.Time) bool {
+		if occurrence.Add(duration).After(after) {
+			windows = append(windows, recurrenceWindow{Start: occurrence, End: occurrence.Add(duration)})
+		}
+		return len(windows) < count
+	})
+	return windows
+}
+
+// validateRecurrenceWindows checks a window ends after it starts and does not overlap the next occurrence of the rule
+func validateRecurrenceWindows(rule *recurrenceRule, start time.Time, end time.Time) error {
+	if !end.After(start) {
+		return fmt.Errorf("end %s must be after start %s", formatLocalDateTime(end), formatLocalDateTime(start))
+	}
+	if rule == nil {
+		return nil
+	}
+
+	windows := getRecurrenceWindows(rule, start, end, start, rruleOverlapCheckCount)
+	if len(windows) == 0 {
+		return fmt.Errorf("RRULE has no occurrences after %s", formatLocalDateTime(start))
+	}
+	for i := 1; i < len(windows); i++ {
+		if windows[i].Start.Before(windows[i-1].End) {
+			return fmt.Errorf("the window from %s to %s overlaps the next window starting %s", formatLocalDateTime(windows[i-1].Start), formatLocalDateTime(windows[i-1].End), formatLocalDateTime(windows[i].Start))
+		}
+	}
+	return nil
+}
+
+// localNow returns the current wall clock time of a time zone as a time without a time zone
+func localNow(location *time.Location) time.Time {
+	now := time.Now().In(location)
+	return time.Date(now.Year(), now.Month(), now.Day(), now.Hour(), now.Minute(), now.Second(), 0, time.UTC)
+}
+
+func parseLocalDateTime(value string) (time.Time, error) {
+	return time.Parse(resourcedata.TimeParseFormat, value)
+}
+
+func formatLocalDateTime(value time.Time) string {
+	return value.Format(resourcedata.TimeParseFormat)
+}
+
+func truncateToDate(value time.Time) time.Time {
+	return time.Date(value.Year(), value.Month(), value.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func daysBetween(from time.Time, to time.Time) int {
+	return int(truncateToDate(to).Sub(truncateToDate(from)).Hours() / 24)
+}
+
+func monthInList(month time.Month, months []time.Month) bool {
+	for _, m := range months {
+		if m == month {
+			return true
+		}
+	}
+	return false
+}
+
+func weekdayCode(weekday time.Weekday) string {
+	for code, w := range rruleWeekdays {
+		if w == weekday {
+			return code
+		}
+	}
+	return ""
+}
+
+// validateRrule returns a validation function for RRULE strings with the given frequencies. Only the frequency is
+// validated, as the API accepts parts that the windows of a rule cannot be computed for.
+func validateRrule(supportedFreqs ...string) schema.SchemaValidateDiagFunc {
+	return func(value interface{}, _ cty.Path) diag.Diagnostics {
+		rrule, ok := value.(string)
+		if !ok {
+			return diag.Errorf("RRULE %v is not a string", value)
+		}
+		if rrule == "" {
+			return nil
+		}
+		if _, err := parseRecurrenceFreq(rrule, supportedFreqs...); err != nil {
+			return diag.Errorf("Invalid RRULE %s: %s", rrule, err)
+		}
+		return nil
+	}
+}
+
+// validateTimeZone validates an IANA time zone such as America/Indianapolis
+func validateTimeZone(value interface{}, _ cty.Path) diag.Diagnostics {
+	timeZone, ok := value.(string)
+	if !ok {
+		return diag.Errorf("Time zone %v is not a string", value)
+	}
+	if _, err := time.LoadLocation(timeZone); err != nil || timeZone == "" || strings.EqualFold(timeZone, "local") {
+		return diag.Errorf("Time zone %s is not a valid IANA time zone such as America/Indianapolis", timeZone)
+	}
+	return nil
+}
+
+// nextOccurrencesSchema is the computed list of upcoming windows of a recurring resource
+func nextOccurrencesSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Description: description,
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"start": {
+					Description: "Start of the window. Date time is represented as an ISO-8601 string without a timezone.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"end": {
+					Description: "End of the window. Date time is represented as an ISO-8601 string without a timezone.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+func flattenRecurrenceWindows(windows []recurrenceWindow) []interface{} {
+	flattened := make([]interface{}, 0, len(windows))
+	for _, window := range windows {
+		flattened = append(flattened, map[string]interface{}{
+			"start": formatLocalDateTime(window.Start),
+			"end":   formatLocalDateTime(window.End),
+		})
+	}
+	return flattened
+}
@@ -0,0 +1,184 @@
+package genesyscloud
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseRecurrenceRuleErrors(t *testing.T) {
+	allFreqs := []string{rruleFreqDaily, rruleFreqWeekly, rruleFreqMonthly, rruleFreqYearly}
+	testCases := []struct {
+		rrule    string
+		freqs    []string
+		expected string
+	}{
+		{"", allFreqs, "RRULE is empty"},
+		{"INTERVAL=2", allFreqs, "RRULE must set FREQ"},
+		{"FREQ=MONTHLY", []string{rruleFreqDaily, rruleFreqWeekly}, "RRULE frequency MONTHLY is not supported. Supported frequencies are DAILY, WEEKLY"},
+		{"FREQ=HOURLY", allFreqs, "RRULE frequency HOURLY is not supported. Supported frequencies are DAILY, WEEKLY, MONTHLY, YEARLY"},
+		{"FREQ=DAILY;BYHOUR=3", allFreqs, "RRULE part BYHOUR is not supported"},
+		{"FREQ=DAILY;FREQ=WEEKLY", allFreqs, "RRULE part FREQ is set more than once"},
+		{"FREQ=DAILY;INTERVAL=0", allFreqs, "INTERVAL 0 must be a positive whole number"},
+		{"FREQ=DAILY;COUNT=3;UNTIL=20240101", allFreqs, "RRULE cannot set both COUNT and UNTIL"},
+		{"FREQ=WEEKLY;BYDAY=2MO", allFreqs, "BYDAY ordinals such as 2MO are only supported by MONTHLY and YEARLY rules"},
+		{"FREQ=WEEKLY;BYDAY=XX", allFreqs, `BYDAY value "XX" is not a weekday`},
+		{"FREQ=MONTHLY;BYMONTHDAY=32", allFreqs, "BYMONTHDAY value 32 must be a whole number from -31 to 31 other than 0"},
+		{"FREQ=DAILY;UNTIL=tomorrow", allFreqs, "UNTIL TOMORROW must be a date such as 20240131 or a date time such as 20240131T235959Z"},
+		{"FREQ", allFreqs, "RRULE must set FREQ"},
+		{"FREQ=DAILY;INTERVAL", allFreqs, `RRULE part "INTERVAL" must be of the form NAME=VALUE`},
+	}
+
+	for _, tc := range testCases {
+		_, err := parseRecurrenceRule(tc.rrule, tc.freqs...)
+		if err == nil || err.Error() != tc.expected {
+			t.Errorf("%s: expected error %q, got %v", tc.rrule, tc.expected, err)
+		}
+	}
+
+	if _, err := parseRecurrenceRule("RRULE:freq=weekly;byday=MO,WE;wkst=SU", allFreqs...); err != nil {
+		t.Errorf("expected prefixed lower case rule to parse, got %v", err)
+	}
+}
+
+func TestValidateRrule(t *testing.T) {
+	validate := validateRrule(rruleFreqDaily, rruleFreqWeekly, rruleFreqMonthly, rruleFreqYearly)
+	// Parts that windows cannot be computed for are left for the API to validate
+	for _, rrule := range []string{"", "FREQ=DAILY;BYHOUR=3", "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1", "FREQ=WEEKLY;BYMONTHDAY=1", "RRULE:freq=yearly;byyearday=100"} {
+		if diagErr := validate(rrule, nil); diagErr.HasError() {
+			t.Errorf("expected %q to be valid, got %v", rrule, diagErr)
+		}
+	}
+	for _, rrule := range []string{"INTERVAL=2", "FREQ=HOURLY", "FREQ"} {
+		if diagErr := validate(rrule, nil); !diagErr.HasError() {
+			t.Errorf("expected %q to be invalid", rrule)
+		}
+	}
+}
+
+func TestGetRecurrenceWindows(t *testing.T) {
+	allFreqs := []string{rruleFreqDaily, rruleFreqWeekly, rruleFreqMonthly, rruleFreqYearly}
+	start := time.Date(2021, 8, 8, 2, 0, 0, 0, time.UTC) // Sunday
+	testCases := []struct {
+		rrule    string
+		after    time.Time
+		count    int
+		expected []string
+	}{
+		{
+			rrule:    "FREQ=WEEKLY;BYDAY=SU",
+			after:    start,
+			count:    3,
+			expected: []string{"2021-08-08", "2021-08-15", "2021-08-22"},
+		},
+		{
+			// The start is a Sunday, so with weeks starting on Monday the next week is skipped
+			rrule:    "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE",
+			after:    start,
+			count:    4,
+			expected: []string{"2021-08-16", "2021-08-18", "2021-08-30", "2021-09-01"},
+		},
+		{
+			rrule:    "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE;WKST=SU",
+			after:    start,
+			count:    2,
+			expected: []string{"2021-08-09", "2021-08-11"},
+		},
+		{
+			rrule:    "FREQ=DAILY;INTERVAL=3;COUNT=2",
+			after:    start,
+			count:    5,
+			expected: []string{"2021-08-08", "2021-08-11"},
+		},
+		{
+			rrule:    "FREQ=DAILY;UNTIL=20210810",
+			after:    start,
+			count:    5,
+			expected: []string{"2021-08-08", "2021-08-09", "2021-08-10"},
+		},
+		{
+			rrule:    "FREQ=MONTHLY;BYDAY=-1FR",
+			after:    start,
+			count:    3,
+			expected: []string{"2021-08-27", "2021-09-24", "2021-10-29"},
+		},
+		{
+			rrule:    "FREQ=MONTHLY;BYMONTHDAY=-1",
+			after:    start,
+			count:    3,
+			expected: []string{"2021-08-31", "2021-09-30", "2021-10-31"},
+		},
+		{
+			rrule:    "FREQ=YEARLY;BYMONTH=11;BYDAY=4TH",
+			after:    start,
+			count:    2,
+			expected: []string{"2021-11-25", "2022-11-24"},
+		},
+		{
+			rrule:    "FREQ=YEARLY",
+			after:    time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+			count:    2,
+			expected: []string{"2023-08-08", "2024-08-08"},
+		},
+	}
+
+	for _, tc := range testCases {
+		rule, err := parseRecurrenceRule(tc.rrule, allFreqs...)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.rrule, err)
+		}
+		var days []string
+		for _, window := range getRecurrenceWindows(rule, start, start.Add(3*time.Hour), tc.after, tc.count) {
+			if window.End.Sub(window.Start) != 3*time.Hour || window.Start.Hour() != 2 {
+				t.Errorf("%s: unexpected window %v", tc.rrule, window)
+			}
+			days = append(days, window.Start.Format("2006-01-02"))
+		}
+		if !reflect.DeepEqual(days, tc.expected) {
+			t.Errorf("%s: expected windows on %v, got %v", tc.rrule, tc.expected, days)
+		}
+	}
+
+	// Without a rule there is only the window itself
+	if windows := getRecurrenceWindows(nil, start, start.Add(time.Hour), start.Add(2*time.Hour), 5); len(windows) != 0 {
+		t.Errorf("expected no windows after the end, got %v", windows)
+	}
+}
+
+func TestValidateRecurrenceWindows(t *testing.T) {
+	start := time.Date(2021, 8, 8, 22, 0, 0, 0, time.UTC)
+	daily, _ := parseRecurrenceRule("FREQ=DAILY", rruleFreqDaily)
+	weekly, _ := parseRecurrenceRule("FREQ=WEEKLY;BYDAY=SU,MO", rruleFreqWeekly)
+	ended, _ := parseRecurrenceRule("FREQ=DAILY;UNTIL=20210807", rruleFreqDaily)
+
+	if err := validateRecurrenceWindows(daily, start, start.Add(4*time.Hour)); err != nil {
+		t.Errorf("expected a 4 hour daily window to be valid, got %v", err)
+	}
+	if err := validateRecurrenceWindows(nil, start, start); err == nil || err.Error() != "end 2021-08-08T22:00:00.000000 must be after start 2021-08-08T22:00:00.000000" {
+		t.Errorf("unexpected error for an empty window: %v", err)
+	}
+	if err := validateRecurrenceWindows(daily, start, start.Add(25*time.Hour)); err == nil || err.Error() != "the window from 2021-08-08T22:00:00.000000 to 2021-08-09T23:00:00.000000 overlaps the next window starting 2021-08-09T22:00:00.000000" {
+		t.Errorf("unexpected error for overlapping daily windows: %v", err)
+	}
+	// Sunday and Monday windows overlap while a single day window does not
+	if err := validateRecurrenceWindows(weekly, start, start.Add(30*time.Hour)); err == nil {
+		t.Error("expected overlapping weekly windows to be rejected")
+	}
+	if err := validateRecurrenceWindows(weekly, start, start.Add(24*time.Hour)); err != nil {
+		t.Errorf("expected adjacent weekly windows to be valid, got %v", err)
+	}
+	if err := validateRecurrenceWindows(ended, start, start.Add(time.Hour)); err == nil || err.Error() != "RRULE has no occurrences after 2021-08-08T22:00:00.000000" {
+		t.Errorf("unexpected error for a rule without occurrences: %v", err)
+	}
+}
+
+func TestValidateTimeZone(t *testing.T) {
+	if diagErr := validateTimeZone("America/New_York", nil); diagErr.HasError() {
+		t.Errorf("expected America/New_York to be valid, got %v", diagErr)
+	}
+	for _, timeZone := range []string{"", "Local", "Mars/Olympus_Mons"} {
+		if diagErr := validateTimeZone(timeZone, nil); !diagErr.HasError() {
+			t.Errorf("expected time zone %q to be invalid", timeZone)
+		}
+	}
+}
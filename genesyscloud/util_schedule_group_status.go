@@ -0,0 +1,141 @@
+package genesyscloud
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v109/platformclientv2"
+)
+
+const (
+	scheduleGroupStatusOpen    = "OPEN"
+	scheduleGroupStatusClosed  = "CLOSED"
+	scheduleGroupStatusHoliday = "HOLIDAY"
+)
+
+// Instants without an offset are read as date times in the time zone of the schedule group
+var scheduleGroupInstantLayouts = []string{resourcedata.TimeParseFormat, "2006-01-02T15:04:05", "2006-01-02T15:04"}
+
+// groupSchedule is a schedule of a schedule group. Start and end are local to the time zone of the group. Schedules
+// with a ruleErr have a rule whose windows cannot be computed and are never active.
+type groupSchedule struct {
+	id      string
+	name    string
+	start   time.Time
+	end     time.Time
+	rule    *recurrenceRule
+	ruleErr error
+}
+
+// scheduleGroupCalendar evaluates a schedule group the way Architect does. Holiday schedules take precedence over
+// closed schedules, which take precedence over open schedules. Times outside of every schedule are closed.
+type scheduleGroupCalendar struct {
+	location *time.Location
+	open     []groupSchedule
+	closed   []groupSchedule
+	holiday  []groupSchedule
+}
+
+type scheduleGroupStatusResult struct {
+	instant  string
+	local    time.Time
+	status   string
+	schedule *groupSchedule
+}
+
+func newGroupSchedule(id string, name string, start string, end string, rrule string) (groupSchedule, error) {
+	schedule := groupSchedule{id: id, name: name}
+	var err error
+	if schedule.start, err = parseLocalDateTime(start); err != nil {
+		return schedule, fmt.Errorf("schedule %s start %s must be a date time such as 2006-01-02T15:04:05.000000", name, start)
+	}
+	if schedule.end, err = parseLocalDateTime(end); err != nil {
+		return schedule, fmt.Errorf("schedule %s end %s must be a date time such as 2006-01-02T15:04:05.000000", name, end)
+	}
+	if rrule != "" {
+		schedule.rule, schedule.ruleErr = parseRecurrenceRule(rrule, architectScheduleFreqs...)
+	}
+	return schedule, nil
+}
+
+func newGroupScheduleFromSdk(schedule platformclientv2.Schedule) (groupSchedule, error) {
+	if schedule.Start == nil || schedule.End == nil {
+		return groupSchedule{}, fmt.Errorf("schedule %s has no start or end", *schedule.Id)
+	}
+	result := groupSchedule{id: *schedule.Id, start: *schedule.Start, end: *schedule.End}
+	if schedule.Name != nil {
+		result.name = *schedule.Name
+	}
+	if schedule.Rrule != nil && *schedule.Rrule != "" {
+		result.rule, result.ruleErr = parseRecurrenceRule(*schedule.Rrule, architectScheduleFreqs...)
+	}
+	return result, nil
+}
+
+// isActive checks if a local time is inside one of the windows of the schedule
+func (s groupSchedule) isActive(local time.Time) bool {
+	if s.ruleErr != nil {
+		return false
+	}
+	windows := getRecurrenceWindows(s.rule, s.start, s.end, local, 1)
+	return len(windows) > 0 && !windows[0].Start.After(local)
+}
+
+// evaluate returns the status of the schedule group at an instant
+func (c *scheduleGroupCalendar) evaluate(instant string) (scheduleGroupStatusResult, error) {
+	local, err := parseScheduleGroupInstant(instant, c.location)
+	if err != nil {
+		return scheduleGroupStatusResult{}, err
+	}
+
+	result := scheduleGroupStatusResult{instant: instant, local: local, status: scheduleGroupStatusClosed}
+	for _, group := range []struct {
+		status    string
+		schedules []groupSchedule
+	}{
+		{scheduleGroupStatusHoliday, c.holiday},
+		{scheduleGroupStatusClosed, c.closed},
+		{scheduleGroupStatusOpen, c.open},
+	} {
+		for i := range group.schedules {
+			if group.schedules[i].isActive(local) {
+				result.status = group.status
+				result.schedule = &group.schedules[i]
+				return result, nil
+			}
+		}
+	}
+	return result, nil
+}
+
+// skippedSchedules lists the schedules that are not evaluated because the windows of their rules cannot be computed
+func (c *scheduleGroupCalendar) skippedSchedules() []groupSchedule {
+	var skipped []groupSchedule
+	for _, schedules := range [][]groupSchedule{c.holiday, c.closed, c.open} {
+		for _, schedule := range schedules {
+			if schedule.ruleErr != nil {
+				skipped = append(skipped, schedule)
+			}
+		}
+	}
+	return skipped
+}
+
+// parseScheduleGroupInstant reads an RFC 3339 instant, or a date time in the given time zone, and returns it as a
+// date time in the time zone
+func parseScheduleGroupInstant(value string, location *time.Location) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if instant, err := time.Parse(time.RFC3339Nano, value); err == nil {
+		local := instant.In(location)
+		return time.Date(local.Year(), local.Month(), local.Day(), local.Hour(), local.Minute(), local.Second(), local.Nanosecond(), time.UTC), nil
+	}
+	for _, layout := range scheduleGroupInstantLayouts {
+		if local, err := time.Parse(layout, value); err == nil {
+			return local, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("instant %s must be an RFC 3339 time such as 2023-12-24T17:30:00+11:00 or a date time such as 2023-12-24T17:30:00", value)
+}
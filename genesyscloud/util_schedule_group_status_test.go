@@ -0,0 +1,74 @@
+package genesyscloud
+
+import (
+	"testing"
+)
+
+func TestScheduleGroupCalendarEvaluate(t *testing.T) {
+	schedule := func(name string, start string, end string, rrule string) map[string]interface{} {
+		return map[string]interface{}{"name": name, "start": start, "end": end, "rrule": rrule}
+	}
+	calendar, err := buildScheduleGroupCalendar([]interface{}{map[string]interface{}{
+		"time_zone": "Australia/Sydney",
+		"open_schedules": []interface{}{
+			schedule("Weekdays", "2023-01-02T09:00:00.000000", "2023-01-02T18:00:00.000000", "FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR"),
+		},
+		"closed_schedules": []interface{}{
+			schedule("Staff meeting", "2023-01-06T15:00:00.000000", "2023-01-06T16:00:00.000000", "FREQ=MONTHLY;BYDAY=1FR"),
+			// BYSETPOS is accepted by the API but cannot be evaluated
+			schedule("Stocktake", "2023-01-31T09:00:00.000000", "2023-01-31T18:00:00.000000", "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1"),
+		},
+		"holiday_schedules": []interface{}{
+			schedule("Christmas Eve", "2023-12-24T17:00:00.000000", "2023-12-25T00:00:00.000000", "FREQ=YEARLY"),
+			schedule("Christmas", "2023-12-25T00:00:00.000000", "2023-12-26T00:00:00.000000", "FREQ=YEARLY"),
+			schedule("Office move", "2024-03-04T00:00:00.000000", "2024-03-05T00:00:00.000000", ""),
+		},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		instant  string
+		local    string
+		status   string
+		schedule string
+	}{
+		// Tuesday 24 December 2024 in Sydney, before and after the holiday starts
+		{"2024-12-24T16:59:59", "2024-12-24T16:59:59.000000", scheduleGroupStatusOpen, "Weekdays"},
+		{"2024-12-24T17:30:00", "2024-12-24T17:30:00.000000", scheduleGroupStatusHoliday, "Christmas Eve"},
+		// The same instant in UTC, during daylight saving time
+		{"2024-12-24T06:30:00Z", "2024-12-24T17:30:00.000000", scheduleGroupStatusHoliday, "Christmas Eve"},
+		{"2025-12-25T10:00:00.000000", "2025-12-25T10:00:00.000000", scheduleGroupStatusHoliday, "Christmas"},
+		// Closed schedules take precedence over open schedules
+		{"2024-02-02T15:30:00", "2024-02-02T15:30:00.000000", scheduleGroupStatusClosed, "Staff meeting"},
+		{"2024-02-09T15:30:00", "2024-02-09T15:30:00.000000", scheduleGroupStatusOpen, "Weekdays"},
+		// Schedules without a rule only apply once
+		{"2024-03-04T10:00:00", "2024-03-04T10:00:00.000000", scheduleGroupStatusHoliday, "Office move"},
+		{"2024-03-11T10:00:00", "2024-03-11T10:00:00.000000", scheduleGroupStatusOpen, "Weekdays"},
+		// Times outside of every schedule are closed
+		{"2024-12-21T10:00:00", "2024-12-21T10:00:00.000000", scheduleGroupStatusClosed, ""},
+		{"2024-12-23T18:00:00", "2024-12-23T18:00:00.000000", scheduleGroupStatusClosed, ""},
+		{"2022-12-30T10:00:00", "2022-12-30T10:00:00.000000", scheduleGroupStatusClosed, ""},
+	}
+
+	for _, tc := range testCases {
+		result, err := calendar.evaluate(tc.instant)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.instant, err)
+		}
+		resultMap := flattenScheduleGroupStatusResult(result)
+		scheduleName, _ := resultMap["schedule_name"].(string)
+		if resultMap["local_date_time"] != tc.local || resultMap["status"] != tc.status || scheduleName != tc.schedule {
+			t.Errorf("%s: expected %s %s %q, got %v", tc.instant, tc.local, tc.status, tc.schedule, resultMap)
+		}
+	}
+
+	if skipped := calendar.skippedSchedules(); len(skipped) != 1 || skipped[0].name != "Stocktake" {
+		t.Errorf("expected only Stocktake to be skipped, got %v", skipped)
+	}
+
+	if _, err := calendar.evaluate("Christmas"); err == nil {
+		t.Error("expected an invalid instant to be rejected")
+	}
+}
@@ -0,0 +1,231 @@
+package genesyscloud
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	lists "terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v109/platformclientv2"
+)
+
+// dialPlanFormattingChars are stripped from dialed numbers before they are compared with number lists and digit lengths
+var dialPlanFormattingChars = strings.NewReplacer(" ", "", "-", "", ".", "", "(", "", ")", "")
+
+// dialPlanResult is the outcome of dialing a number on a site
+type dialPlanResult struct {
+	number           string
+	numberPlan       *platformclientv2.Numberplan
+	normalizedNumber string
+	outboundRoute    *platformclientv2.Outboundroutebase
+}
+
+// dialPlan evaluates dialed numbers against the number plans and outbound routes of a site the way an Edge would
+type dialPlan struct {
+	numberPlans    []platformclientv2.Numberplan
+	outboundRoutes []platformclientv2.Outboundroutebase
+	regexes        map[string]*regexp.Regexp
+}
+
+// newDialPlan orders the number plans by priority and compiles their regular expressions. Country code plans depend on
+// the location of the site and are not evaluated.
+func newDialPlan(numberPlans []platformclientv2.Numberplan, outboundRoutes []platformclientv2.Outboundroutebase) (*dialPlan, error) {
+	plan := &dialPlan{
+		outboundRoutes: outboundRoutes,
+		regexes:        make(map[string]*regexp.Regexp),
+	}
+	for _, numberPlan := range numberPlans {
+		if numberPlan.State != nil && *numberPlan.State == "deleted" {
+			continue
+		}
+		plan.numberPlans = append(plan.numberPlans, numberPlan)
+	}
+	sort.SliceStable(plan.numberPlans, func(i, j int) bool {
+		return numberPlanPriority(plan.numberPlans[i]) < numberPlanPriority(plan.numberPlans[j])
+	})
+
+	for _, numberPlan := range plan.numberPlans {
+		if getNumberPlanMatchType(numberPlan) != "regex" || numberPlan.Match == nil {
+			continue
+		}
+		// Number plans must match the whole dialed string
+		re, err := regexp.Compile("^(?:" + *numberPlan.Match + ")$")
+		if err != nil {
+			return nil, fmt.Errorf("number plan %s has a match format that can't be evaluated: %v", getNumberPlanName(numberPlan), err)
+		}
+		plan.regexes[getNumberPlanName(numberPlan)] = re
+	}
+	return plan, nil
+}
+
+// unevaluatedPlans returns the names of the number plans with a match type that can't be evaluated locally
+func (p *dialPlan) unevaluatedPlans() []string {
+	var names []string
+	for _, numberPlan := range p.numberPlans {
+		if matchType := getNumberPlanMatchType(numberPlan); matchType == "interCountryCode" || matchType == "intraCountryCode" {
+			names = append(names, getNumberPlanName(numberPlan))
+		}
+	}
+	return names
+}
+
+// dial returns the first number plan matching a number, and the first enabled outbound route for the plan's
+// classification
+func (p *dialPlan) dial(number string) dialPlanResult {
+	result := dialPlanResult{number: number}
+	for i := range p.numberPlans {
+		numberPlan := &p.numberPlans[i]
+		normalizedNumber, ok := p.matchNumberPlan(*numberPlan, number)
+		if !ok {
+			continue
+		}
+		result.numberPlan = numberPlan
+		result.normalizedNumber = normalizedNumber
+		break
+	}
+	if result.numberPlan == nil || result.numberPlan.Classification == nil {
+		return result
+	}
+
+	for i := range p.outboundRoutes {
+		outboundRoute := &p.outboundRoutes[i]
+		if outboundRoute.Enabled == nil || !*outboundRoute.Enabled || outboundRoute.ClassificationTypes == nil {
+			continue
+		}
+		if lists.ItemInSlice(*result.numberPlan.Classification, *outboundRoute.ClassificationTypes) {
+			result.outboundRoute = outboundRoute
+			break
+		}
+	}
+	return result
+}
+
+// matchNumberPlan returns the normalized number if a number plan matches a dialed number
+func (p *dialPlan) matchNumberPlan(numberPlan platformclientv2.Numberplan, number string) (string, bool) {
+	digits := dialPlanFormattingChars.Replace(number)
+	switch getNumberPlanMatchType(numberPlan) {
+	case "regex":
+		re, ok := p.regexes[getNumberPlanName(numberPlan)]
+		if !ok {
+			return "", false
+		}
+		submatches := re.FindStringSubmatchIndex(number)
+		if submatches == nil {
+			return "", false
+		}
+		if numberPlan.NormalizedFormat == nil || *numberPlan.NormalizedFormat == "" {
+			return number, true
+		}
+		return string(re.ExpandString(nil, *numberPlan.NormalizedFormat, number, submatches)), true
+	case "digitLength":
+		if numberPlan.DigitLength == nil || !isDigits(digits) {
+			return "", false
+		}
+		length := fmt.Sprintf("%d", len(digits))
+		return digits, numberInRange(length, getDigitLengthStart(numberPlan.DigitLength), getDigitLengthEnd(numberPlan.DigitLength))
+	case "numberList", "e164NumberList":
+		if getNumberPlanMatchType(numberPlan) == "e164NumberList" {
+			if !strings.HasPrefix(digits, "+") {
+				return "", false
+			}
+			digits = strings.TrimPrefix(digits, "+")
+		}
+		if numberPlan.Numbers == nil || !isDigits(digits) {
+			return "", false
+		}
+		for _, listNumber := range *numberPlan.Numbers {
+			start := strings.TrimPrefix(getNumberStart(listNumber), "+")
+			end := strings.TrimPrefix(getNumberEnd(listNumber), "+")
+			if numberInRange(digits, start, end) {
+				return dialPlanFormattingChars.Replace(number), true
+			}
+		}
+	}
+	return "", false
+}
+
+// numberInRange compares numbers as integers of any length. A range without an end only matches its start.
+func numberInRange(number string, start string, end string) bool {
+	if start == "" {
+		return false
+	}
+	if end == "" {
+		end = start
+	}
+	return compareDigits(number, start) >= 0 && compareDigits(number, end) <= 0
+}
+
+func compareDigits(a string, b string) int {
+	a, b = strings.TrimLeft(a, "0"), strings.TrimLeft(b, "0")
+	if len(a) != len(b) {
+		if len(a) < len(b) {
+			return -1
+		}
+		return 1
+	}
+	return strings.Compare(a, b)
+}
+
+func isDigits(value string) bool {
+	if value == "" {
+		return false
+	}
+	for _, c := range value {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func numberPlanPriority(numberPlan platformclientv2.Numberplan) int {
+	if numberPlan.Priority == nil {
+		// Plans without a priority are evaluated last
+		return int(^uint(0) >> 1)
+	}
+	return *numberPlan.Priority
+}
+
+func getNumberPlanName(numberPlan platformclientv2.Numberplan) string {
+	if numberPlan.Name == nil {
+		return ""
+	}
+	return *numberPlan.Name
+}
+
+func getNumberPlanMatchType(numberPlan platformclientv2.Numberplan) string {
+	if numberPlan.MatchType == nil {
+		return ""
+	}
+	return *numberPlan.MatchType
+}
+
+func getDigitLengthStart(digitLength *platformclientv2.Digitlength) string {
+	if digitLength.Start == nil {
+		return ""
+	}
+	return *digitLength.Start
+}
+
+func getDigitLengthEnd(digitLength *platformclientv2.Digitlength) string {
+	if digitLength.End == nil {
+		return ""
+	}
+	return *digitLength.End
+}
+
+func getNumberStart(number platformclientv2.Number) string {
+	if number.Start == nil {
+		return ""
+	}
+	return *number.Start
+}
+
+func getNumberEnd(number platformclientv2.Number) string {
+	if number.End == nil {
+		return ""
+	}
+	return *number.End
+}
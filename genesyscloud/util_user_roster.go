@@ -0,0 +1,242 @@
+package genesyscloud
+
+import (
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	lists "terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// userRosterRow is one user of a genesyscloud_user_bulk roster. Skills, languages, locations, queues and the division
+// are referenced by name or ID, the manager by email.
+type userRosterRow struct {
+	Row        int                `json:"-"`
+	Email      string             `json:"email"`
+	Name       string             `json:"name"`
+	Division   string             `json:"division,omitempty"`
+	Manager    string             `json:"manager,omitempty"`
+	Department string             `json:"department,omitempty"`
+	Title      string             `json:"title,omitempty"`
+	Skills     map[string]float64 `json:"skills,omitempty"`
+	Languages  map[string]int     `json:"languages,omitempty"`
+	Locations  []string           `json:"locations,omitempty"`
+	Queues     []string           `json:"queues,omitempty"`
+}
+
+var userRosterColumns = []string{"email", "name", "division", "manager", "department", "title", "skills", "languages", "locations", "queues"}
+
+// readUserRoster reads a CSV or JSON roster, chosen by the file extension. Problems with individual rows are returned
+// as one diagnostic per row.
+func readUserRoster(path string) ([]userRosterRow, diag.Diagnostics) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, diag.Errorf("Failed to read roster %s: %v", path, err)
+	}
+
+	var rows []userRosterRow
+	var diagErr diag.Diagnostics
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		rows, diagErr = parseUserRosterJson(content)
+	case ".csv":
+		rows, diagErr = parseUserRosterCsv(content)
+	default:
+		return nil, diag.Errorf("Roster %s must be a .csv or .json file", path)
+	}
+	if diagErr.HasError() {
+		return nil, diagErr
+	}
+
+	if diagErr = validateUserRoster(rows); diagErr.HasError() {
+		return nil, diagErr
+	}
+	return rows, nil
+}
+
+func parseUserRosterJson(content []byte) ([]userRosterRow, diag.Diagnostics) {
+	var rows []userRosterRow
+	decoder := json.NewDecoder(strings.NewReader(string(content)))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&rows); err != nil {
+		return nil, diag.Errorf("Failed to parse roster JSON: %v", err)
+	}
+	for i := range rows {
+		rows[i].Row = i + 1
+	}
+	return rows, nil
+}
+
+// parseUserRosterCsv reads a CSV roster with a header row. Lists are separated by ';' and proficiencies follow a ':',
+// e.g. "Sales:4;Billing:2".
+func parseUserRosterCsv(content []byte) ([]userRosterRow, diag.Diagnostics) {
+	reader := csv.NewReader(strings.NewReader(string(content)))
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, diag.Errorf("Failed to read roster CSV header: %v", err)
+	}
+	columns := make(map[string]int)
+	for i, column := range header {
+		column = strings.ToLower(strings.TrimSpace(column))
+		if !lists.ItemInSlice(column, userRosterColumns) {
+			return nil, diag.Errorf("Unknown roster column %q. Valid columns are %s", column, strings.Join(userRosterColumns, ", "))
+		}
+		columns[column] = i
+	}
+	if _, ok := columns["email"]; !ok {
+		return nil, diag.Errorf("Roster CSV must have an email column")
+	}
+
+	var rows []userRosterRow
+	var diagErr diag.Diagnostics
+	for rowNum := 1; ; rowNum++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			diagErr = append(diagErr, userRosterRowError(rowNum, "", err.Error()))
+			continue
+		}
+
+		value := func(column string) string {
+			if i, ok := columns[column]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+		row := userRosterRow{
+			Row:        rowNum,
+			Email:      value("email"),
+			Name:       value("name"),
+			Division:   value("division"),
+			Manager:    value("manager"),
+			Department: value("department"),
+			Title:      value("title"),
+			Locations:  splitRosterList(value("locations")),
+			Queues:     splitRosterList(value("queues")),
+		}
+		if skills := splitRosterList(value("skills")); len(skills) > 0 {
+			row.Skills = make(map[string]float64)
+			for _, skill := range skills {
+				name, proficiency, err := splitRosterProficiency(skill)
+				if err != nil {
+					diagErr = append(diagErr, userRosterRowError(rowNum, row.Email, fmt.Sprintf("skill %q: %v", skill, err)))
+					continue
+				}
+				row.Skills[name] = proficiency
+			}
+		}
+		if languages := splitRosterList(value("languages")); len(languages) > 0 {
+			row.Languages = make(map[string]int)
+			for _, language := range languages {
+				name, proficiency, err := splitRosterProficiency(language)
+				if err != nil || proficiency != float64(int(proficiency)) {
+					diagErr = append(diagErr, userRosterRowError(rowNum, row.Email, fmt.Sprintf("language %q needs a whole number proficiency", language)))
+					continue
+				}
+				row.Languages[name] = int(proficiency)
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows, diagErr
+}
+
+func validateUserRoster(rows []userRosterRow) diag.Diagnostics {
+	var diagErr diag.Diagnostics
+	emails := make(map[string]int)
+	for _, row := range rows {
+		if row.Email == "" {
+			diagErr = append(diagErr, userRosterRowError(row.Row, "", "email is required"))
+			continue
+		}
+		if row.Name == "" {
+			diagErr = append(diagErr, userRosterRowError(row.Row, row.Email, "name is required"))
+		}
+		if other, ok := emails[strings.ToLower(row.Email)]; ok {
+			diagErr = append(diagErr, userRosterRowError(row.Row, row.Email, fmt.Sprintf("email is already used in row %d", other)))
+		}
+		emails[strings.ToLower(row.Email)] = row.Row
+		if strings.EqualFold(row.Manager, row.Email) {
+			diagErr = append(diagErr, userRosterRowError(row.Row, row.Email, "a user cannot be their own manager"))
+		}
+		for skill, proficiency := range row.Skills {
+			if proficiency < 0 || proficiency > 5 {
+				diagErr = append(diagErr, userRosterRowError(row.Row, row.Email, fmt.Sprintf("skill %q proficiency must be between 0 and 5", skill)))
+			}
+		}
+		for language, proficiency := range row.Languages {
+			if proficiency < 0 || proficiency > 5 {
+				diagErr = append(diagErr, userRosterRowError(row.Row, row.Email, fmt.Sprintf("language %q proficiency must be between 0 and 5", language)))
+			}
+		}
+	}
+	return diagErr
+}
+
+func userRosterRowError(row int, email string, message string) diag.Diagnostic {
+	summary := fmt.Sprintf("Roster row %d", row)
+	if email != "" {
+		summary = fmt.Sprintf("Roster row %d (%s)", row, email)
+	}
+	return diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  fmt.Sprintf("%s: %s", summary, message),
+	}
+}
+
+func splitRosterList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ";") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func splitRosterProficiency(value string) (string, float64, error) {
+	separator := strings.LastIndex(value, ":")
+	if separator < 0 {
+		return "", 0, fmt.Errorf("expected name:proficiency")
+	}
+	proficiency, err := strconv.ParseFloat(strings.TrimSpace(value[separator+1:]), 64)
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid proficiency: %v", err)
+	}
+	return strings.TrimSpace(value[:separator]), proficiency, nil
+}
+
+// userRosterKey is the key of a row in the resource's maps
+func userRosterKey(email string) string {
+	return strings.ToLower(email)
+}
+
+// getUserRosterHashes maps the lower case email of every row to a hash of its content
+func getUserRosterHashes(rows []userRosterRow) map[string]interface{} {
+	hashes := make(map[string]interface{})
+	for _, row := range rows {
+		row.Locations = append([]string{}, row.Locations...)
+		row.Queues = append([]string{}, row.Queues...)
+		sort.Strings(row.Locations)
+		sort.Strings(row.Queues)
+		// Maps are marshalled with sorted keys, so equal rows give equal hashes
+		content, _ := json.Marshal(row)
+		hash := sha256.Sum256(content)
+		hashes[userRosterKey(row.Email)] = hex.EncodeToString(hash[:])
+	}
+	return hashes
+}
@@ -0,0 +1,122 @@
+package genesyscloud
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestReadUserRosterCsvAndJson(t *testing.T) {
+	dataDir := filepath.Join("..", "test", "data", "resource", "genesyscloud_user_bulk")
+	csvRows, diagErr := readUserRoster(filepath.Join(dataDir, "roster.csv"))
+	if diagErr.HasError() {
+		t.Fatalf("failed to read CSV roster: %v", diagErr)
+	}
+	jsonRows, diagErr := readUserRoster(filepath.Join(dataDir, "roster.json"))
+	if diagErr.HasError() {
+		t.Fatalf("failed to read JSON roster: %v", diagErr)
+	}
+
+	if len(csvRows) != 2 {
+		t.Fatalf("expected 2 rows, got %d", len(csvRows))
+	}
+	if csvRows[0].Skills["Billing"] != 2.5 || csvRows[0].Languages["English"] != 5 {
+		t.Errorf("unexpected proficiencies %v %v", csvRows[0].Skills, csvRows[0].Languages)
+	}
+	if csvRows[1].Manager != "ada@example.com" || !reflect.DeepEqual(csvRows[1].Queues, []string{"Support", "Sales"}) {
+		t.Errorf("unexpected row %+v", csvRows[1])
+	}
+
+	// Both formats describe the same users, with queues in a different order
+	if csvHashes, jsonHashes := getUserRosterHashes(csvRows), getUserRosterHashes(jsonRows); !reflect.DeepEqual(csvHashes, jsonHashes) {
+		t.Errorf("expected equal hashes for CSV and JSON rosters, got %v and %v", csvHashes, jsonHashes)
+	}
+}
+
+func TestReadUserRosterRowErrors(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "roster.csv")
+	content := `email,name,manager,skills,languages
+ada@example.com,Ada,,Sales:7,
+ADA@example.com,Ada Again,,,
+bob@example.com,,bob@example.com,Sales,English:2.5
+`
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	_, diagErr := readUserRoster(path)
+	var summaries []string
+	for _, d := range diagErr {
+		summaries = append(summaries, d.Summary)
+	}
+	expected := []string{
+		`Roster row 3 (bob@example.com): skill "Sales": expected name:proficiency`,
+		`Roster row 3 (bob@example.com): language "English:2.5" needs a whole number proficiency`,
+	}
+	if !reflect.DeepEqual(summaries, expected) {
+		t.Errorf("expected parse errors %q, got %q", expected, summaries)
+	}
+
+	// Rows that parse are validated
+	rows, _ := parseUserRosterCsv([]byte(strings.Replace(content, "Sales,English:2.5", ",", 1)))
+	diagErr = validateUserRoster(rows)
+	summaries = nil
+	for _, d := range diagErr {
+		summaries = append(summaries, d.Summary)
+	}
+	expected = []string{
+		`Roster row 1 (ada@example.com): skill "Sales" proficiency must be between 0 and 5`,
+		`Roster row 2 (ADA@example.com): email is already used in row 1`,
+		`Roster row 3 (bob@example.com): name is required`,
+		`Roster row 3 (bob@example.com): a user cannot be their own manager`,
+	}
+	if !reflect.DeepEqual(summaries, expected) {
+		t.Errorf("expected validation errors %q, got %q", expected, summaries)
+	}
+}
+
+func TestBuildUserRosterConfig(t *testing.T) {
+	catalogs := userRosterCatalogs{
+		"division": {},
+		"skill":    {"sales": "skill-1", "skill-1": "skill-1"},
+		"language": {"english": "language-1"},
+		"location": {},
+		"queue":    {"support": "queue-1"},
+	}
+	row := userRosterRow{
+		Row:       1,
+		Email:     "ada@example.com",
+		Name:      "Ada Lovelace",
+		Skills:    map[string]float64{"SALES": 4},
+		Languages: map[string]int{"English": 5},
+		Queues:    []string{"Support"},
+	}
+
+	config, queueIds, err := buildUserRosterConfig(row, catalogs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(queueIds, []string{"queue-1"}) {
+		t.Errorf("unexpected queue IDs %v", queueIds)
+	}
+
+	d, err := resourceDataFromConfig(context.Background(), ResourceUser(), config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !d.HasChange("routing_skills") || !d.HasChange("routing_languages") {
+		t.Error("expected skills and languages to be changed so they are applied")
+	}
+	if d.HasChange("locations") || d.Get("department").(string) != "" {
+		t.Error("expected unset columns to be left empty")
+	}
+
+	row.Queues = append(row.Queues, "Billing")
+	row.Division = "Home"
+	if _, _, err = buildUserRosterConfig(row, catalogs); err == nil || err.Error() != `not found: division "Home", queue "Billing"` {
+		t.Errorf("unexpected error for unknown names: %v", err)
+	}
+}